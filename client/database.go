@@ -0,0 +1,21 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeDatabaseCheckpoint = "database/checkpoint"
+)
+
+// CreateDatabaseCheckpoint creates a checkpoint of the database of the node and returns its directory on the node.
+func (api *GoShimmerAPI) CreateDatabaseCheckpoint() (string, error) {
+	res := &jsonmodels.DatabaseCheckpointResponse{}
+	if err := api.do(http.MethodPost, routeDatabaseCheckpoint, nil, res); err != nil {
+		return "", err
+	}
+
+	return res.Directory, nil
+}
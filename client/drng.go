@@ -1,15 +1,19 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 )
 
 const (
-	routeCollectiveBeacon = "drng/collectiveBeacon"
-	routeRandomness       = "drng/info/randomness"
-	routeCommittee        = "drng/info/committee"
+	routeCollectiveBeacon  = "drng/collectiveBeacon"
+	routeCommitteeRotation = "drng/committeeRotation"
+	routeRandomness        = "drng/info/randomness"
+	routeCommittee         = "drng/info/committee"
+	routeBeacon            = "drng/info/beacon"
+	routeBeacons           = "drng/info/beacons"
 )
 
 // BroadcastCollectiveBeacon sends the given collective beacon (payload) by creating a message in the backend.
@@ -23,6 +27,17 @@ func (api *GoShimmerAPI) BroadcastCollectiveBeacon(payload []byte) (string, erro
 	return res.ID, nil
 }
 
+// BroadcastCommitteeRotation sends the given committee rotation (payload) by creating a message in the backend.
+func (api *GoShimmerAPI) BroadcastCommitteeRotation(payload []byte) (string, error) {
+	res := &jsonmodels.CommitteeRotationResponse{}
+	if err := api.do(http.MethodPost, routeCommitteeRotation,
+		&jsonmodels.CommitteeRotationRequest{Payload: payload}, res); err != nil {
+		return "", err
+	}
+
+	return res.ID, nil
+}
+
 // GetRandomness gets the current randomness.
 func (api *GoShimmerAPI) GetRandomness() (*jsonmodels.RandomnessResponse, error) {
 	res := &jsonmodels.RandomnessResponse{}
@@ -44,3 +59,31 @@ func (api *GoShimmerAPI) GetCommittee() (*jsonmodels.CommitteeResponse, error) {
 	}
 	return res, nil
 }
+
+// GetRandomnessHistory gets the past randomness values of the given DRNG instance.
+func (api *GoShimmerAPI) GetRandomnessHistory(instanceID uint32) (*jsonmodels.RandomnessHistoryResponse, error) {
+	res := &jsonmodels.RandomnessHistoryResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%d", routeRandomness, instanceID), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetBeacon gets the beacon of the given round of the given DRNG instance. The returned beacon can be verified with
+// drng.VerifyBeacon.
+func (api *GoShimmerAPI) GetBeacon(instanceID uint32, round uint64) (*jsonmodels.BeaconResponse, error) {
+	res := &jsonmodels.BeaconResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%d/%d", routeBeacon, instanceID, round), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetBeacons gets the beacons of the given DRNG instance in the range of rounds [from, to].
+func (api *GoShimmerAPI) GetBeacons(instanceID uint32, from, to uint64) (*jsonmodels.BeaconsResponse, error) {
+	res := &jsonmodels.BeaconsResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%d?from=%d&to=%d", routeBeacons, instanceID, from, to), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
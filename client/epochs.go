@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeEpochs            = "epochs/"
+	routeLatestEpochSuffix = "latest"
+)
+
+// GetEpochCommitment gets the Commitment of the given epoch.
+func (api *GoShimmerAPI) GetEpochCommitment(epochID uint64) (*jsonmodels.EpochCommitment, error) {
+	res := &jsonmodels.EpochCommitment{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s%d", routeEpochs, epochID), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetLatestEpochCommitment gets the Commitment of the latest committed epoch.
+func (api *GoShimmerAPI) GetLatestEpochCommitment() (*jsonmodels.EpochCommitment, error) {
+	res := &jsonmodels.EpochCommitment{}
+	if err := api.do(http.MethodGet, routeEpochs+routeLatestEpochSuffix, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
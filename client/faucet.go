@@ -2,7 +2,9 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/identity"
@@ -16,7 +18,10 @@ import (
 )
 
 const (
-	routeFaucet = "faucet"
+	routeFaucet           = "faucet"
+	routeFaucetDifficulty = "faucet/difficulty"
+	routeFaucetColors     = "faucet/colors"
+	routeFaucetThrottle   = "faucet/throttle"
 )
 
 var (
@@ -26,6 +31,16 @@ var (
 
 // SendFaucetRequest requests funds from faucet nodes by sending a faucet request payload message.
 func (api *GoShimmerAPI) SendFaucetRequest(base58EncodedAddr string, powTarget int, pledgeIDs ...string) (*jsonmodels.FaucetResponse, error) {
+	return api.sendFaucetRequest(base58EncodedAddr, nil, 0, powTarget, pledgeIDs...)
+}
+
+// SendColoredFaucetRequest requests the given amount of tokens of the given color from faucet nodes by sending a
+// faucet request payload message. An amount of zero requests the default amount the faucet dispenses for the color.
+func (api *GoShimmerAPI) SendColoredFaucetRequest(base58EncodedAddr string, color ledgerstate.Color, amount uint64, powTarget int, pledgeIDs ...string) (*jsonmodels.FaucetResponse, error) {
+	return api.sendFaucetRequest(base58EncodedAddr, &color, amount, powTarget, pledgeIDs...)
+}
+
+func (api *GoShimmerAPI) sendFaucetRequest(base58EncodedAddr string, color *ledgerstate.Color, amount uint64, powTarget int, pledgeIDs ...string) (*jsonmodels.FaucetResponse, error) {
 	var aManaPledgeID identity.ID
 	var cManaPledgeID identity.ID
 	if len(pledgeIDs) > 1 {
@@ -44,32 +59,78 @@ func (api *GoShimmerAPI) SendFaucetRequest(base58EncodedAddr string, powTarget i
 		return nil, errors.Errorf("could not decode address from string: %w", err)
 	}
 
-	nonce, err := computeFaucetPoW(address, aManaPledgeID, cManaPledgeID, powTarget)
-	if err != nil {
+	request := &jsonmodels.FaucetRequest{
+		Address:               base58EncodedAddr,
+		AccessManaPledgeID:    base58.Encode(aManaPledgeID.Bytes()),
+		ConsensusManaPledgeID: base58.Encode(cManaPledgeID.Bytes()),
+	}
+	faucetRequest := faucet.NewRequest(address, aManaPledgeID, cManaPledgeID, 0)
+	if color != nil {
+		request.Color = color.Base58()
+		request.Amount = amount
+		faucetRequest = faucet.NewColoredRequest(address, *color, amount, aManaPledgeID, cManaPledgeID, 0)
+	}
+
+	if request.Nonce, err = computeFaucetPoW(faucetRequest, powTarget); err != nil {
 		return nil, errors.Errorf("could not compute faucet PoW: %w", err)
 	}
 
 	res := &jsonmodels.FaucetResponse{}
-	if err := api.do(http.MethodPost, routeFaucet,
-		&jsonmodels.FaucetRequest{
-			Address:               base58EncodedAddr,
-			AccessManaPledgeID:    base58.Encode(aManaPledgeID.Bytes()),
-			ConsensusManaPledgeID: base58.Encode(cManaPledgeID.Bytes()),
-			Nonce:                 nonce,
-		}, res); err != nil {
+	if err := api.do(http.MethodPost, routeFaucet, request, res); err != nil {
 		return nil, err
 	}
 
 	return res, nil
 }
 
-func computeFaucetPoW(address ledgerstate.Address, aManaPledgeID, cManaPledgeID identity.ID, powTarget int) (nonce uint64, err error) {
+// GetFaucetDifficulty gets the PoW difficulty currently required by the faucet node.
+func (api *GoShimmerAPI) GetFaucetDifficulty() (int, error) {
+	res := &jsonmodels.FaucetDifficultyResponse{}
+	if err := api.do(http.MethodGet, routeFaucetDifficulty, nil, res); err != nil {
+		return 0, err
+	}
+	return res.Difficulty, nil
+}
+
+// GetFaucetColors gets the tokens dispensed by the faucet node.
+func (api *GoShimmerAPI) GetFaucetColors() (*jsonmodels.FaucetColorsResponse, error) {
+	res := &jsonmodels.FaucetColorsResponse{}
+	if err := api.do(http.MethodGet, routeFaucetColors, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetFaucetThrottle gets the throttling state of the faucet node. If a kind (address, issuer or pledge) is given, only
+// the entries of that kind are returned.
+func (api *GoShimmerAPI) GetFaucetThrottle(kind ...string) (*jsonmodels.FaucetThrottleResponse, error) {
+	route := routeFaucetThrottle
+	if len(kind) > 0 {
+		route = fmt.Sprintf("%s?kind=%s", routeFaucetThrottle, url.QueryEscape(kind[0]))
+	}
+
+	res := &jsonmodels.FaucetThrottleResponse{}
+	if err := api.do(http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ClearFaucetThrottle clears the throttling state of the given key of the given kind on the faucet node. If the kind
+// is empty, the whole throttling state is cleared.
+func (api *GoShimmerAPI) ClearFaucetThrottle(kind, key string) error {
+	route := routeFaucetThrottle
+	if kind != "" {
+		route = fmt.Sprintf("%s?kind=%s&key=%s", routeFaucetThrottle, url.QueryEscape(kind), url.QueryEscape(key))
+	}
+	return api.do(http.MethodDelete, route, nil, nil)
+}
+
+func computeFaucetPoW(faucetRequest *faucet.Request, powTarget int) (nonce uint64, err error) {
 	if powTarget < 0 {
 		powTarget = defaultPOWTarget
 	}
 
-	faucetRequest := faucet.NewRequest(address, aManaPledgeID, cManaPledgeID, 0)
-
 	objectBytes := faucetRequest.Bytes()
 	powRelevantBytes := objectBytes[:len(objectBytes)-pow.NonceBytes]
 
@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	routeInfo = "info"
+	routeInfo         = "info"
+	routeInfoFinality = "info/finality"
 )
 
 // Info gets the info of the node.
@@ -18,3 +19,12 @@ func (api *GoShimmerAPI) Info() (*jsonmodels.InfoResponse, error) {
 	}
 	return res, nil
 }
+
+// FinalityPolicy gets the policies the node uses to derive the grade of finality of branches and messages.
+func (api *GoShimmerAPI) FinalityPolicy() (*jsonmodels.FinalityPolicyResponse, error) {
+	res := &jsonmodels.FinalityPolicyResponse{}
+	if err := api.do(http.MethodGet, routeInfoFinality, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
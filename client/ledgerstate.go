@@ -12,6 +12,7 @@ const (
 	routeGetAddresses     = "ledgerstate/addresses/"
 	routeGetBranches      = "ledgerstate/branches/"
 	routeGetOutputs       = "ledgerstate/outputs/"
+	routeGetTokens        = "ledgerstate/tokens/"
 	routeGetTransactions  = "ledgerstate/transactions/"
 	routePostTransactions = "ledgerstate/transactions"
 
@@ -23,6 +24,7 @@ const (
 	pathMetadata       = "/metadata"
 	pathSupporters     = "/supporters"
 	pathAttachments    = "/attachments"
+	pathInclusionProof = "/inclusionProof"
 )
 
 // GetAddressOutputs gets the spent and unspent outputs of an address.
@@ -135,6 +137,17 @@ func (api *GoShimmerAPI) GetOutputMetadata(base58EncodedOutputID string) (*jsonm
 	return res, nil
 }
 
+// GetTokenSupply gets the circulating supply of the token with the given Color from its foundry.
+func (api *GoShimmerAPI) GetTokenSupply(base58EncodedColor string) (*jsonmodels.GetTokenSupplyResponse, error) {
+	res := &jsonmodels.GetTokenSupplyResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return strings.Join([]string{routeGetTokens, base58EncodedColor}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetTransaction gets the transaction of the corresponding to TransactionID.
 func (api *GoShimmerAPI) GetTransaction(base58EncodedTransactionID string) (*jsonmodels.Transaction, error) {
 	res := &jsonmodels.Transaction{}
@@ -168,6 +181,17 @@ func (api *GoShimmerAPI) GetTransactionAttachments(base58EncodedTransactionID st
 	return res, nil
 }
 
+// GetTransactionInclusionProof gets a proof that the transaction corresponding to TransactionID is part of the Tangle.
+func (api *GoShimmerAPI) GetTransactionInclusionProof(base58EncodedTransactionID string) (*jsonmodels.GetInclusionProofResponse, error) {
+	res := &jsonmodels.GetInclusionProofResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return strings.Join([]string{routeGetTransactions, base58EncodedTransactionID, pathInclusionProof}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // PostTransaction sends the transaction(bytes) to the Tangle and returns its transaction ID.
 func (api *GoShimmerAPI) PostTransaction(transactionBytes []byte) (*jsonmodels.PostTransactionResponse, error) {
 	res := &jsonmodels.PostTransactionResponse{}
@@ -24,10 +24,17 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrUnauthorized defines the "unauthorized" error.
 	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden defines the "forbidden" error.
+	ErrForbidden = errors.New("forbidden")
+	// ErrTooManyRequests defines the "too many requests" error.
+	ErrTooManyRequests = errors.New("too many requests")
 	// ErrUnknownError defines the "unknown error" error.
 	ErrUnknownError = errors.New("unknown error")
 	// ErrNotImplemented defines the "operation not implemented/supported/available" error.
 	ErrNotImplemented = errors.New("operation not implemented/supported/available")
+	// ErrConflictingAuthorization defines the error returned if both basic-auth and an API token are configured, as
+	// both are sent in the Authorization header.
+	ErrConflictingAuthorization = errors.New("basic-auth and API token can not be used at the same time")
 )
 
 const (
@@ -57,6 +64,13 @@ func WithBasicAuth(username, password string) Option {
 	}
 }
 
+// WithAPIToken sets the API token used to authorize the requests.
+func WithAPIToken(token string) Option {
+	return func(g *GoShimmerAPI) {
+		g.apiToken = token
+	}
+}
+
 // WithHTTPClient sets the http Client.
 func WithHTTPClient(c http.Client) Option {
 	return func(g *GoShimmerAPI) {
@@ -90,6 +104,7 @@ type GoShimmerAPI struct {
 	baseURL    string
 	httpClient http.Client
 	basicAuth  BasicAuth
+	apiToken   string
 }
 
 type errorresponse struct {
@@ -128,6 +143,10 @@ func interpretBody(res *http.Response, decodeTo interface{}) error {
 		return fmt.Errorf("%w: %s", ErrBadRequest, errRes.Error)
 	case http.StatusUnauthorized:
 		return fmt.Errorf("%w: %s", ErrUnauthorized, errRes.Error)
+	case http.StatusForbidden:
+		return fmt.Errorf("%w: %s", ErrForbidden, errRes.Error)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %s", ErrTooManyRequests, errRes.Error)
 	case http.StatusNotImplemented:
 		return fmt.Errorf("%w: %s", ErrNotImplemented, errRes.Error)
 	}
@@ -136,6 +155,10 @@ func interpretBody(res *http.Response, decodeTo interface{}) error {
 }
 
 func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, resObj interface{}) error {
+	if api.basicAuth.IsEnabled() && api.apiToken != "" {
+		return ErrConflictingAuthorization
+	}
+
 	// marshal request object
 	var data []byte
 	if reqObj != nil {
@@ -166,6 +189,11 @@ func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, res
 		req.SetBasicAuth(api.basicAuth.Credentials())
 	}
 
+	// if set, add the API token
+	if api.apiToken != "" {
+		req.Header.Set("Authorization", "Bearer "+api.apiToken)
+	}
+
 	// make the request
 	res, err := api.httpClient.Do(req)
 	if err != nil {
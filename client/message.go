@@ -7,9 +7,10 @@ import (
 )
 
 const (
-	routeMessage         = "messages/"
-	routeMessageMetadata = "/metadata"
-	routeSendPayload     = "messages/payload"
+	routeMessage               = "messages/"
+	routeMessageMetadata       = "/metadata"
+	routeMessageInclusionProof = "/inclusionProof"
+	routeSendPayload           = "messages/payload"
 )
 
 // GetMessage is the handler for the /messages/:messageID endpoint.
@@ -44,6 +45,22 @@ func (api *GoShimmerAPI) GetMessageMetadata(base58EncodedID string) (*jsonmodels
 	return res, nil
 }
 
+// GetMessageInclusionProof is the handler for the /messages/:messageID/inclusionProof endpoint.
+func (api *GoShimmerAPI) GetMessageInclusionProof(base58EncodedID string) (*jsonmodels.GetInclusionProofResponse, error) {
+	res := &jsonmodels.GetInclusionProofResponse{}
+
+	if err := api.do(
+		http.MethodGet,
+		routeMessage+base58EncodedID+routeMessageInclusionProof,
+		nil,
+		res,
+	); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // SendPayload send a message with the given payload.
 func (api *GoShimmerAPI) SendPayload(payload []byte) (string, error) {
 	res := &jsonmodels.PostPayloadResponse{}
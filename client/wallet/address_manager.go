@@ -12,7 +12,7 @@ import (
 // AddressManager is an manager struct that allows us to keep track of the used and spent addresses.
 type AddressManager struct {
 	// state of the wallet
-	seed             *seed.Seed
+	keychain         Keychain
 	lastAddressIndex uint64
 	spentAddresses   []bitmask.BitMask
 
@@ -23,10 +23,15 @@ type AddressManager struct {
 
 // NewAddressManager is the constructor for the AddressManager type.
 func NewAddressManager(seed *seed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.BitMask) (addressManager *AddressManager) {
+	return NewKeychainAddressManager(&seedKeychain{seed: seed}, lastAddressIndex, spentAddresses)
+}
+
+// NewKeychainAddressManager creates an AddressManager that derives its addresses from the given Keychain.
+func NewKeychainAddressManager(keychain Keychain, lastAddressIndex uint64, spentAddresses []bitmask.BitMask) (addressManager *AddressManager) {
 	defer runtime.KeepAlive(spentAddresses)
 
 	addressManager = &AddressManager{
-		seed:             seed,
+		keychain:         keychain,
 		lastAddressIndex: lastAddressIndex,
 		spentAddresses:   spentAddresses,
 	}
@@ -41,7 +46,7 @@ func (addressManager *AddressManager) Address(addressIndex uint64) address.Addre
 	// update lastUnspentAddressIndex if necessary
 	addressManager.spentAddressIndexes(addressIndex)
 
-	return addressManager.seed.Address(addressIndex)
+	return addressManager.keychain.Address(addressIndex)
 }
 
 // Addresses returns a list of all addresses of the wallet.
@@ -110,6 +115,23 @@ func (addressManager *AddressManager) MarkAddressSpent(addressIndex uint64) {
 	}
 }
 
+// MarkAddressUnspent marks the given address as unspent again, e.g. because the transaction spending it was rejected.
+func (addressManager *AddressManager) MarkAddressUnspent(addressIndex uint64) {
+	// determine indexes
+	sliceIndex, bitIndex := addressManager.spentAddressIndexes(addressIndex)
+
+	// mark address as unspent
+	addressManager.spentAddresses[sliceIndex] = addressManager.spentAddresses[sliceIndex].ClearBit(uint(bitIndex))
+
+	// update spent address indexes
+	if addressIndex < addressManager.firstUnspentAddressIndex {
+		addressManager.firstUnspentAddressIndex = addressIndex
+	}
+	if addressIndex > addressManager.lastUnspentAddressIndex {
+		addressManager.lastUnspentAddressIndex = addressIndex
+	}
+}
+
 // IsAddressSpent returns true if the address given by the address index was spent already.
 func (addressManager *AddressManager) IsAddressSpent(addressIndex uint64) bool {
 	sliceIndex, bitIndex := addressManager.spentAddressIndexes(addressIndex)
@@ -12,8 +12,12 @@ import (
 type Connector interface {
 	UnspentOutputs(addresses ...address.Address) (unspentOutputs OutputsByAddressAndOutputID, err error)
 	SendTransaction(transaction *ledgerstate.Transaction) (err error)
-	RequestFaucetFunds(address address.Address, powTarget int) (err error)
+	RequestFaucetFunds(address address.Address, powTarget int, color ledgerstate.Color, amount uint64) (err error)
 	GetAllowedPledgeIDs() (pledgeIDMap map[mana.Type][]string, err error)
 	GetTransactionGoF(txID ledgerstate.TransactionID) (gradeOfFinality gof.GradeOfFinality, err error)
+	GetTransactionStatus(tx *ledgerstate.Transaction, confirmationTargetGoF gof.GradeOfFinality) (status *TransactionStatus, err error)
 	GetUnspentAliasOutput(address *ledgerstate.AliasAddress) (output *ledgerstate.AliasOutput, err error)
+	GetTokenFoundry(color ledgerstate.Color) (foundry *ledgerstate.FoundryOutput, err error)
+	GetRevealedPreimages(outputID ledgerstate.OutputID) (preimages []ledgerstate.Preimage, err error)
+	GetMinimumOutputDeposit() (minimumOutputDeposit uint64, err error)
 }
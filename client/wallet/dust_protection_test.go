@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createhtlcoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_SendFundsDustProtection(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	newWallet := func(balances ...uint64) (*Wallet, *mockConnector) {
+		walletSeed := seed.NewSeed()
+		walletAddress := walletSeed.Address(0)
+		outputs := make([]*Output, len(balances))
+		for i, balance := range balances {
+			outputs[i] = newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(balance, walletAddress.Address()))
+		}
+		connector := newMockConnector(outputs...)
+		connector.minimumOutputDeposit = 100
+
+		return newTestWallet(walletSeed, connector), connector
+	}
+	sendFunds := func(wallet *Wallet, amount uint64) (*ledgerstate.Transaction, error) {
+		return wallet.SendFunds(
+			sendoptions.Destination(seed.NewSeed().Address(0), amount),
+			sendoptions.AccessManaPledgeID(pledgeID),
+			sendoptions.ConsensusManaPledgeID(pledgeID),
+		)
+	}
+
+	// a remainder below the minimum deposit is consolidated with further outputs
+	wallet, connector := newWallet(150, 80)
+	tx, err := sendFunds(wallet, 120)
+	require.NoError(t, err)
+	assert.Len(t, tx.Essence().Inputs(), 2)
+	assert.NoError(t, ledgerstate.DustProtectionValid(tx.Essence().Outputs(), connector.minimumOutputDeposit))
+
+	// transfers creating dust are refused
+	wallet, connector = newWallet(150)
+	_, err = sendFunds(wallet, 50)
+	assert.ErrorIs(t, err, ledgerstate.ErrDustOutput)
+	_, err = sendFunds(wallet, 120)
+	assert.ErrorIs(t, err, ledgerstate.ErrDustOutput)
+	assert.Empty(t, connector.sentTransactions)
+
+	// sending all funds leaves no remainder
+	_, err = sendFunds(wallet, 150)
+	require.NoError(t, err)
+	assert.Len(t, connector.sentTransactions, 1)
+}
+
+func TestWallet_CreateHashTimeLockDustProtection(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	connector := newMockConnector(
+		newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(150, walletAddress.Address())),
+		newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(80, walletAddress.Address())),
+	)
+	connector.minimumOutputDeposit = 100
+	wallet := newTestWallet(walletSeed, connector)
+
+	var preimage ledgerstate.Preimage
+	createHashTimeLock := func(amount uint64) (*ledgerstate.Transaction, error) {
+		tx, _, err := wallet.CreateHashTimeLock(
+			createhtlcoptions.Destination(seed.NewSeed().Address(0).Address(), amount),
+			createhtlcoptions.HashLock(preimage.HashLock()),
+			createhtlcoptions.Deadline(time.Now().Add(time.Hour)),
+			createhtlcoptions.AccessManaPledgeID(pledgeID),
+			createhtlcoptions.ConsensusManaPledgeID(pledgeID),
+		)
+		return tx, err
+	}
+
+	// the remainder below the minimum deposit is consolidated with further outputs
+	tx, err := createHashTimeLock(120)
+	require.NoError(t, err)
+	assert.Len(t, tx.Essence().Inputs(), 2)
+	assert.NoError(t, ledgerstate.DustProtectionValid(tx.Essence().Outputs(), connector.minimumOutputDeposit))
+}
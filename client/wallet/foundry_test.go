@@ -0,0 +1,118 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/burntokensoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createfoundryoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/minttokensoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_TokenFoundry(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+
+	fundingTxID, err := ledgerstate.TransactionIDFromRandomness()
+	require.NoError(t, err)
+	aliasMint, err := ledgerstate.NewAliasOutputMint(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: ledgerstate.DustThresholdAliasOutputIOTA}, walletAddress.Address())
+	require.NoError(t, err)
+	alias := aliasMint.SetID(ledgerstate.NewOutputID(fundingTxID, 0)).UpdateMintingColor().(*ledgerstate.AliasOutput)
+	connector := newMockConnector(&Output{
+		Address:                walletAddress,
+		Object:                 alias,
+		GradeOfFinalityReached: true,
+	}, newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(1000, walletAddress.Address())))
+	wallet := newTestWallet(walletSeed, connector, ReusableAddress(true))
+
+	// the wallet creates a foundry with its alias
+	tx, tokenColor, err := wallet.CreateFoundry(
+		createfoundryoptions.Alias(alias.GetAliasAddress().Base58()),
+		createfoundryoptions.MaximumSupply(1000),
+		createfoundryoptions.TokenMetadata([]byte("TOKEN")),
+		createfoundryoptions.AccessManaPledgeID(pledgeID),
+		createfoundryoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	foundry, err := wallet.TokenFoundry(tokenColor)
+	require.NoError(t, err)
+	assert.True(t, foundry.AliasAddress().Equals(alias.GetAliasAddress()))
+	assert.Equal(t, uint64(0), foundry.CirculatingSupply())
+	assert.Equal(t, uint64(1000), foundry.MaximumSupply())
+	assert.Equal(t, []byte("TOKEN"), foundry.TokenMetadata())
+	bookFoundryTransaction(connector, walletAddress, tx)
+
+	// the wallet mints tokens by recoloring its IOTA
+	tx, err = wallet.MintTokens(
+		minttokensoptions.Color(tokenColor),
+		minttokensoptions.Amount(500),
+		minttokensoptions.AccessManaPledgeID(pledgeID),
+		minttokensoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 400, tokenColor: 500}, walletFunds(tx, walletAddress))
+	bookFoundryTransaction(connector, walletAddress, tx)
+	foundry, err = wallet.TokenFoundry(tokenColor)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500), foundry.CirculatingSupply())
+
+	// the maximum supply can not be exceeded
+	_, err = wallet.MintTokens(minttokensoptions.Color(tokenColor), minttokensoptions.Amount(501), minttokensoptions.AccessManaPledgeID(pledgeID), minttokensoptions.ConsensusManaPledgeID(pledgeID))
+	assert.Error(t, err)
+
+	// the wallet burns tokens by recoloring them back to IOTA
+	tx, err = wallet.BurnTokens(
+		burntokensoptions.Color(tokenColor),
+		burntokensoptions.Amount(200),
+		burntokensoptions.AccessManaPledgeID(pledgeID),
+		burntokensoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 600, tokenColor: 300}, walletFunds(tx, walletAddress))
+	bookFoundryTransaction(connector, walletAddress, tx)
+	foundry, err = wallet.TokenFoundry(tokenColor)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), foundry.CirculatingSupply())
+
+	// more tokens than are circulating can not be burned
+	_, err = wallet.BurnTokens(burntokensoptions.Color(tokenColor), burntokensoptions.Amount(301), burntokensoptions.AccessManaPledgeID(pledgeID), burntokensoptions.ConsensusManaPledgeID(pledgeID))
+	assert.Error(t, err)
+}
+
+// bookFoundryTransaction replaces the unspent outputs of the wallet with the alias and funds created by the transaction.
+func bookFoundryTransaction(connector *mockConnector, walletAddress address.Address, tx *ledgerstate.Transaction) {
+	connector.outputs[walletAddress] = make(map[ledgerstate.OutputID]*Output)
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() == ledgerstate.FoundryOutputType {
+			continue
+		}
+		connector.outputs[walletAddress][output.ID()] = &Output{
+			Address:                walletAddress,
+			Object:                 output,
+			GradeOfFinalityReached: true,
+		}
+	}
+}
+
+// walletFunds returns the funds that the transaction sends to the given address outside of the alias.
+func walletFunds(tx *ledgerstate.Transaction, walletAddress address.Address) map[ledgerstate.Color]uint64 {
+	funds := make(map[ledgerstate.Color]uint64)
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() != ledgerstate.SigLockedColoredOutputType || !output.Address().Equals(walletAddress.Address()) {
+			continue
+		}
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			funds[color] += balance
+			return true
+		})
+	}
+	return funds
+}
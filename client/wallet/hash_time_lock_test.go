@@ -0,0 +1,110 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/claimhtlcoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createhtlcoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/refundhtlcoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_HashTimeLockSwap(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	aliceSeed, bobSeed := seed.NewSeed(), seed.NewSeed()
+	aliceAddress, bobAddress := aliceSeed.Address(0), bobSeed.Address(0)
+
+	connector := newMockConnector(newTestOutput(t, aliceAddress, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 100}), aliceAddress.Address())))
+	alice, bob := newTestWallet(aliceSeed, connector), newTestWallet(bobSeed, connector)
+
+	var preimage ledgerstate.Preimage
+	preimage[0] = 42
+
+	// alice locks funds for bob
+	tx, outputID, err := alice.CreateHashTimeLock(
+		createhtlcoptions.Destination(bobAddress.Address(), 60),
+		createhtlcoptions.HashLock(preimage.HashLock()),
+		createhtlcoptions.Deadline(time.Now().Add(time.Hour)),
+		createhtlcoptions.AccessManaPledgeID(pledgeID),
+		createhtlcoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	require.Len(t, tx.Essence().Outputs(), 2)
+	var hashTimeLockedOutput *ledgerstate.HashTimeLockedOutput
+	for _, output := range tx.Essence().Outputs() {
+		if output.ID() == outputID {
+			hashTimeLockedOutput = output.(*ledgerstate.HashTimeLockedOutput)
+		}
+	}
+	require.NotNil(t, hashTimeLockedOutput)
+	assert.True(t, bobAddress.Address().Equals(hashTimeLockedOutput.Address()))
+	assert.Equal(t, preimage.HashLock(), hashTimeLockedOutput.HashLock())
+
+	// the node books the hash time lock on the address of bob
+	connector.outputs[bobAddress] = map[ledgerstate.OutputID]*Output{outputID: {
+		Address:                bobAddress,
+		Object:                 hashTimeLockedOutput,
+		GradeOfFinalityReached: true,
+	}}
+	assert.Len(t, bob.UnspentHashTimeLockedOutputs(false), 0)
+	require.NoError(t, bob.Refresh())
+	assert.Len(t, bob.UnspentHashTimeLockedOutputs(false)[bobAddress], 1)
+
+	// the hash time lock can neither be claimed with a wrong preimage nor be refunded before the deadline
+	_, err = bob.ClaimHashTimeLock(claimhtlcoptions.OutputID(outputID.Base58()), claimhtlcoptions.Preimage(ledgerstate.Preimage{}))
+	assert.Error(t, err)
+	_, err = bob.RefundHashTimeLock(refundhtlcoptions.OutputID(outputID.Base58()))
+	assert.Error(t, err)
+	_, err = alice.RevealedPreimage(outputID, preimage.HashLock())
+	assert.ErrorIs(t, err, ErrPreimageNotRevealed)
+
+	// bob claims the funds and reveals the preimage to alice
+	claimTx, err := bob.ClaimHashTimeLock(
+		claimhtlcoptions.OutputID(outputID.Base58()),
+		claimhtlcoptions.Preimage(preimage),
+		claimhtlcoptions.AccessManaPledgeID(pledgeID),
+		claimhtlcoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	require.Len(t, claimTx.UnlockBlocks(), 1)
+	assert.Equal(t, ledgerstate.HashLockUnlockBlockType, claimTx.UnlockBlocks()[0].Type())
+	assert.Len(t, bob.UnspentHashTimeLockedOutputs(true), 0)
+
+	revealedPreimage, err := alice.RevealedPreimage(outputID, preimage.HashLock())
+	require.NoError(t, err)
+	assert.Equal(t, preimage, revealedPreimage)
+}
+
+func TestWallet_RefundHashTimeLock(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	aliceSeed := seed.NewSeed()
+	aliceAddress := aliceSeed.Address(0)
+
+	var preimage ledgerstate.Preimage
+	expiredOutput := newTestOutput(t, aliceAddress, ledgerstate.NewHashTimeLockedOutput(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 100},
+		seed.NewSeed().Address(0).Address(), preimage.HashLock(), time.Now().Add(-time.Minute), aliceAddress.Address()))
+	outputID := expiredOutput.Object.ID()
+	alice := newTestWallet(aliceSeed, newMockConnector(expiredOutput))
+
+	// the refund address can not claim the funds
+	_, err := alice.ClaimHashTimeLock(claimhtlcoptions.OutputID(outputID.Base58()), claimhtlcoptions.Preimage(preimage))
+	assert.Error(t, err)
+
+	tx, err := alice.RefundHashTimeLock(
+		refundhtlcoptions.OutputID(outputID.Base58()),
+		refundhtlcoptions.AccessManaPledgeID(pledgeID),
+		refundhtlcoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	require.Len(t, tx.Essence().Outputs(), 1)
+	balance, _ := tx.Essence().Outputs()[0].Balances().Get(ledgerstate.ColorIOTA)
+	assert.Equal(t, uint64(100), balance)
+	assert.Equal(t, ledgerstate.SignatureUnlockBlockType, tx.UnlockBlocks()[0].Type())
+}
@@ -0,0 +1,97 @@
+package wallet
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// ErrWatchOnlyWallet is returned if a watch-only wallet without an external signer is asked to sign a transaction.
+var ErrWatchOnlyWallet = errors.New("watch-only wallet can not sign transactions")
+
+// Keychain derives the addresses of a wallet and signs with their private keys.
+type Keychain interface {
+	// Address returns the address with the given index.
+	Address(index uint64) address.Address
+	// Sign signs the given data with the private key of the given address.
+	Sign(addr address.Address, data []byte) (*ledgerstate.ED25519Signature, error)
+}
+
+// Signer signs the given data with the private key of the given address. It is used by watch-only wallets to have
+// their transactions signed by the holder of the seed.
+type Signer func(addr address.Address, data []byte) (*ledgerstate.ED25519Signature, error)
+
+// region seedKeychain /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// seedKeychain is the Keychain of wallets using the flat address sequence of a seed.
+type seedKeychain struct {
+	seed *seed.Seed
+}
+
+// Address returns the address with the given index.
+func (s *seedKeychain) Address(index uint64) address.Address {
+	return s.seed.Address(index)
+}
+
+// Sign signs the given data with the private key of the given address.
+func (s *seedKeychain) Sign(addr address.Address, data []byte) (*ledgerstate.ED25519Signature, error) {
+	keyPair := s.seed.KeyPair(addr.Index)
+
+	return ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(data)), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region accountKeychain //////////////////////////////////////////////////////////////////////////////////////////////
+
+// accountKeychain is the Keychain of wallets using an account of a seed.
+type accountKeychain struct {
+	seed    *seed.Seed
+	account *seed.Account
+}
+
+// Address returns the address with the given index.
+func (a *accountKeychain) Address(index uint64) address.Address {
+	return a.account.Address(index)
+}
+
+// Sign signs the given data with the private key of the given address.
+func (a *accountKeychain) Sign(addr address.Address, data []byte) (*ledgerstate.ED25519Signature, error) {
+	return a.account.Sign(addr.Index, data), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region watchOnlyKeychain ////////////////////////////////////////////////////////////////////////////////////////////
+
+// watchOnlyKeychain is the Keychain of watch-only wallets, which only know the public key of an account.
+type watchOnlyKeychain struct {
+	accountPublicKey *seed.AccountPublicKey
+	signer           Signer
+}
+
+// Address returns the address with the given index.
+func (w *watchOnlyKeychain) Address(index uint64) address.Address {
+	return w.accountPublicKey.Address(index)
+}
+
+// Sign hands the given data to the external signer of the wallet.
+func (w *watchOnlyKeychain) Sign(addr address.Address, data []byte) (*ledgerstate.ED25519Signature, error) {
+	if w.signer == nil {
+		return nil, ErrWatchOnlyWallet
+	}
+
+	signature, err := w.signer(addr, data)
+	if err != nil {
+		return nil, errors.Errorf("external signer failed to sign with address %s: %w", addr.Base58(), err)
+	}
+	if !signature.AddressSignatureValid(addr.Address(), data) {
+		return nil, errors.Errorf("external signer returned an invalid signature for address %s", addr.Base58())
+	}
+
+	return signature, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
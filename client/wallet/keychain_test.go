@@ -0,0 +1,62 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/bitmask"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_WatchOnly(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	account := walletSeed.Account(1)
+	accountAddress := account.Address(0)
+	output := newTestOutput(t, accountAddress, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 100}), accountAddress.Address()))
+	withConnector := GenericConnector(newMockConnector(output))
+
+	// the watch-only wallet sees the funds of the account
+	watchOnlyWallet := New(ImportWatchOnly(account.PublicKey(), 0, []bitmask.BitMask{}, NewAssetRegistry(DefaultAssetRegistryNetwork)), withConnector)
+	assert.True(t, watchOnlyWallet.WatchOnly())
+	assert.Nil(t, watchOnlyWallet.Seed())
+	assert.Equal(t, accountAddress, watchOnlyWallet.AddressManager().Address(0))
+	confirmedBalance, _, err := watchOnlyWallet.AvailableBalance()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), confirmedBalance[ledgerstate.ColorIOTA])
+
+	// but it can not spend them
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	sendOptions := []sendoptions.SendFundsOption{
+		sendoptions.Destination(seed.NewSeed().Address(0), 100),
+		sendoptions.AccessManaPledgeID(pledgeID),
+		sendoptions.ConsensusManaPledgeID(pledgeID),
+	}
+	_, err = watchOnlyWallet.SendFunds(sendOptions...)
+	assert.True(t, errors.Is(err, ErrWatchOnlyWallet))
+	assert.Empty(t, watchOnlyWallet.PendingTransactions())
+
+	// unless the transactions are signed by the holder of the seed
+	signingWallet := New(ImportWatchOnly(account.PublicKey(), 0, []bitmask.BitMask{}, NewAssetRegistry(DefaultAssetRegistryNetwork)), ExternalSigner(func(addr address.Address, data []byte) (*ledgerstate.ED25519Signature, error) {
+		return account.Sign(addr.Index, data), nil
+	}), withConnector)
+	tx, err := signingWallet.SendFunds(sendOptions...)
+	require.NoError(t, err)
+	assert.Len(t, signingWallet.PendingTransactions(), 1)
+	assert.Equal(t, ledgerstate.NewInputs(ledgerstate.NewUTXOInput(output.Object.ID())), tx.Essence().Inputs())
+
+	// the wallet of the account exports the key of the watch-only wallet
+	accountWallet := New(ImportAccount(walletSeed, 1, 0, []bitmask.BitMask{}, NewAssetRegistry(DefaultAssetRegistryNetwork)), withConnector)
+	assert.False(t, accountWallet.WatchOnly())
+	accountPublicKey, err := accountWallet.AccountPublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, account.PublicKey().Bytes(), accountPublicKey.Bytes())
+	assert.Equal(t, walletSeed.Bytes(), accountWallet.Seed().Bytes())
+}
@@ -0,0 +1,148 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/bitmask"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+)
+
+// newTestWallet creates a Wallet for the given seed that uses the given Connector.
+func newTestWallet(walletSeed *seed.Seed, connector Connector, options ...Option) *Wallet {
+	return New(append([]Option{Import(walletSeed, 0, []bitmask.BitMask{}, NewAssetRegistry(DefaultAssetRegistryNetwork)), GenericConnector(connector)}, options...)...)
+}
+
+// newTestOutput assigns the ID of a new random transaction to the given output and returns it as a confirmed Output
+// on the given address.
+func newTestOutput(t *testing.T, walletAddress address.Address, output ledgerstate.Output) *Output {
+	txID, err := ledgerstate.TransactionIDFromRandomness()
+	require.NoError(t, err)
+
+	return &Output{
+		Address:                walletAddress,
+		Object:                 output.SetID(ledgerstate.NewOutputID(txID, 0)),
+		GradeOfFinalityReached: true,
+	}
+}
+
+// mockConnector is a Connector that serves the given unspent outputs, records the sent transactions and reports the
+// configured status of every transaction.
+type mockConnector struct {
+	outputs              OutputsByAddressAndOutputID
+	statuses             map[ledgerstate.TransactionID]*TransactionStatus
+	sentTransactions     []*ledgerstate.Transaction
+	sendErr              error
+	minimumOutputDeposit uint64
+}
+
+func newMockConnector(outputs ...*Output) *mockConnector {
+	connector := &mockConnector{
+		outputs:  NewAddressToOutputs(),
+		statuses: make(map[ledgerstate.TransactionID]*TransactionStatus),
+	}
+	for _, output := range outputs {
+		if _, addressExists := connector.outputs[output.Address]; !addressExists {
+			connector.outputs[output.Address] = make(map[ledgerstate.OutputID]*Output)
+		}
+		connector.outputs[output.Address][output.Object.ID()] = output
+	}
+
+	return connector
+}
+
+func (m *mockConnector) UnspentOutputs(addresses ...address.Address) (unspentOutputs OutputsByAddressAndOutputID, err error) {
+	unspentOutputs = NewAddressToOutputs()
+	for _, addr := range addresses {
+		for outputID, output := range m.outputs[addr] {
+			if _, addressExists := unspentOutputs[addr]; !addressExists {
+				unspentOutputs[addr] = make(map[ledgerstate.OutputID]*Output)
+			}
+			outputCopy := *output
+			unspentOutputs[addr][outputID] = &outputCopy
+		}
+	}
+
+	return unspentOutputs, nil
+}
+
+func (m *mockConnector) SendTransaction(tx *ledgerstate.Transaction) (err error) {
+	if m.sendErr != nil {
+		return m.sendErr
+	}
+	m.sentTransactions = append(m.sentTransactions, tx)
+	if _, exists := m.statuses[tx.ID()]; !exists {
+		m.statuses[tx.ID()] = &TransactionStatus{GradeOfFinality: gof.Low, Liked: true}
+	}
+	return
+}
+
+func (m *mockConnector) RequestFaucetFunds(address.Address, int, ledgerstate.Color, uint64) (err error) {
+	return
+}
+
+func (m *mockConnector) GetAllowedPledgeIDs() (pledgeIDMap map[mana.Type][]string, err error) {
+	return
+}
+
+func (m *mockConnector) GetTransactionGoF(txID ledgerstate.TransactionID) (gradeOfFinality gof.GradeOfFinality, err error) {
+	status, exists := m.statuses[txID]
+	if !exists {
+		return gof.None, errors.Errorf("unknown transaction %s", txID.Base58())
+	}
+	return status.GradeOfFinality, nil
+}
+
+func (m *mockConnector) GetTransactionStatus(tx *ledgerstate.Transaction, _ gof.GradeOfFinality) (status *TransactionStatus, err error) {
+	if status, exists := m.statuses[tx.ID()]; exists {
+		return status, nil
+	}
+	return &TransactionStatus{Unknown: true}, nil
+}
+
+func (m *mockConnector) GetUnspentAliasOutput(*ledgerstate.AliasAddress) (output *ledgerstate.AliasOutput, err error) {
+	return
+}
+
+func (m *mockConnector) GetTokenFoundry(color ledgerstate.Color) (foundry *ledgerstate.FoundryOutput, err error) {
+	for _, tx := range m.sentTransactions {
+		for _, output := range tx.Essence().Outputs() {
+			if casted, isFoundry := output.(*ledgerstate.FoundryOutput); isFoundry && casted.TokenColor() == color {
+				// the ledger assigns the color of the token when it books the foundry
+				foundry = casted.UpdateMintingColor().(*ledgerstate.FoundryOutput)
+			}
+		}
+	}
+	if foundry == nil {
+		return nil, errors.Errorf("unknown token %s", color.Base58())
+	}
+	return foundry, nil
+}
+
+func (m *mockConnector) GetMinimumOutputDeposit() (minimumOutputDeposit uint64, err error) {
+	return m.minimumOutputDeposit, nil
+}
+
+func (m *mockConnector) GetRevealedPreimages(outputID ledgerstate.OutputID) (preimages []ledgerstate.Preimage, err error) {
+	for _, tx := range m.sentTransactions {
+		for i, input := range tx.Essence().Inputs() {
+			if input.(*ledgerstate.UTXOInput).ReferencedOutputID() != outputID {
+				continue
+			}
+			unlockBlock := tx.UnlockBlocks()[i]
+			if referenceUnlockBlock, isReference := unlockBlock.(*ledgerstate.ReferenceUnlockBlock); isReference {
+				unlockBlock = tx.UnlockBlocks()[referenceUnlockBlock.ReferencedIndex()]
+			}
+			if hashLockUnlockBlock, isHashLock := unlockBlock.(*ledgerstate.HashLockUnlockBlock); isHashLock {
+				preimages = append(preimages, hashLockUnlockBlock.Preimage())
+			}
+		}
+	}
+	return
+}
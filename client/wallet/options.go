@@ -8,6 +8,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 )
 
 // Option represents an optional parameter .
@@ -28,6 +29,40 @@ func Import(seed *seed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.B
 	}
 }
 
+// ImportAccount restores a wallet that derives its addresses from the account with the given index of the seed.
+func ImportAccount(walletSeed *seed.Seed, accountIndex uint32, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *AssetRegistry) Option {
+	return func(wallet *Wallet) {
+		wallet.addressManager = NewKeychainAddressManager(&accountKeychain{seed: walletSeed, account: walletSeed.Account(accountIndex)}, lastAddressIndex, spentAddresses)
+		wallet.assetRegistry = assetRegistry
+	}
+}
+
+// ImportWatchOnly restores a watch-only wallet that tracks the addresses of the account with the given public key. A
+// watch-only wallet can not sign its transactions, unless an ExternalSigner is configured.
+func ImportWatchOnly(accountPublicKey *seed.AccountPublicKey, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *AssetRegistry) Option {
+	return func(wallet *Wallet) {
+		wallet.addressManager = NewKeychainAddressManager(&watchOnlyKeychain{accountPublicKey: accountPublicKey}, lastAddressIndex, spentAddresses)
+		wallet.assetRegistry = assetRegistry
+	}
+}
+
+// ExternalSigner configures the Signer that signs the transactions of a watch-only wallet, e.g. an offline device
+// holding the seed.
+func ExternalSigner(signer Signer) Option {
+	return func(wallet *Wallet) {
+		wallet.externalSigner = signer
+	}
+}
+
+// ImportPendingTransactions restores the pending transactions tracked by a wallet that has previously been created.
+func ImportPendingTransactions(pendingTransactions ...*PendingTransaction) Option {
+	return func(wallet *Wallet) {
+		for _, pendingTx := range pendingTransactions {
+			wallet.pendingTransactions[pendingTx.Transaction.ID()] = pendingTx
+		}
+	}
+}
+
 // ReusableAddress configures the wallet to run in "single address" mode where all the funds are always managed on a
 // single reusable address.
 func ReusableAddress(enabled bool) Option {
@@ -65,6 +100,13 @@ func ConfirmationTimeout(timeout time.Duration) Option {
 	}
 }
 
+// ConfirmationTargetGoF defines the grade of finality a transaction needs to reach to be considered confirmed.
+func ConfirmationTargetGoF(gradeOfFinality gof.GradeOfFinality) Option {
+	return func(wallet *Wallet) {
+		wallet.ConfirmationTargetGoF = gradeOfFinality
+	}
+}
+
 // AssetRegistryNetwork defines which network we intend to use for asset lookups.
 func AssetRegistryNetwork(network string) Option {
 	return func(wallet *Wallet) {
@@ -121,6 +121,22 @@ func (o OutputsByAddressAndOutputID) AliasOutputsOnly() OutputsByAddressAndOutpu
 	return result
 }
 
+// HashTimeLockedOutputsOnly filters out any non hash time locked outputs.
+func (o OutputsByAddressAndOutputID) HashTimeLockedOutputsOnly() OutputsByAddressAndOutputID {
+	result := NewAddressToOutputs()
+	for addy, IDToOutputMap := range o {
+		for outputID, output := range IDToOutputMap {
+			if output.Object.Type() == ledgerstate.HashTimeLockedOutputType {
+				if _, addressExists := result[addy]; !addressExists {
+					result[addy] = make(map[ledgerstate.OutputID]*Output)
+				}
+				result[addy][outputID] = output
+			}
+		}
+	}
+	return result
+}
+
 // TotalFundsInOutputs returns the total funds present in the outputs.
 func (o OutputsByAddressAndOutputID) TotalFundsInOutputs() map[ledgerstate.Color]uint64 {
 	result := make(map[ledgerstate.Color]uint64)
@@ -76,6 +76,11 @@ func (o *OutputManager) UnspentAliasOutputs(includePending bool, addresses ...ad
 	return o.getOutputs(includePending, addresses...).AliasOutputsOnly()
 }
 
+// UnspentHashTimeLockedOutputs returns the HashTimeLockedOutputs that have not been spent, yet.
+func (o *OutputManager) UnspentHashTimeLockedOutputs(includePending bool, addresses ...address.Address) (unspentOutputs OutputsByAddressAndOutputID) {
+	return o.getOutputs(includePending, addresses...).HashTimeLockedOutputsOnly()
+}
+
 func (o *OutputManager) getOutputs(includePending bool, addresses ...address.Address) (unspentOutputs OutputsByAddressAndOutputID) {
 	// prepare result
 	unspentOutputs = make(map[address.Address]map[ledgerstate.OutputID]*Output)
@@ -129,3 +134,12 @@ func (o *OutputManager) MarkOutputSpent(addy address.Address, outputID ledgersta
 	// mark output as spent
 	output.Spent = true
 }
+
+// StoreOutput adds the given output to the outputs of the wallet or replaces the stored version of it. It is used to
+// restore the local state of outputs whose spending transaction was rolled back.
+func (o *OutputManager) StoreOutput(output *Output) {
+	if _, addressExists := o.unspentOutputs[output.Address]; !addressExists {
+		o.unspentOutputs[output.Address] = make(map[ledgerstate.OutputID]*Output)
+	}
+	o.unspentOutputs[output.Address][output.Object.ID()] = output
+}
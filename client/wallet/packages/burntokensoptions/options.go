@@ -0,0 +1,89 @@
+package burntokensoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// BurnTokensOption is a function that provides an option.
+type BurnTokensOption func(options *BurnTokensOptions) error
+
+// Color is an option for the BurnTokens call that defines the token that is burned by its foundry.
+func Color(color ledgerstate.Color) BurnTokensOption {
+	return func(options *BurnTokensOptions) error {
+		if color == ledgerstate.ColorIOTA || color == ledgerstate.ColorMint {
+			return errors.Errorf("%s is not the color of a token", color.String())
+		}
+		options.Color = color
+		return nil
+	}
+}
+
+// Amount is an option for the BurnTokens call that defines how many tokens of the wallet are burned. The burned tokens
+// are turned back into IOTA.
+func Amount(amount uint64) BurnTokensOption {
+	return func(options *BurnTokensOptions) error {
+		if amount == 0 {
+			return errors.New("the amount of burned tokens needs to be larger than 0")
+		}
+		options.Amount = amount
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for BurnTokens call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) BurnTokensOption {
+	return func(options *BurnTokensOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for BurnTokens call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) BurnTokensOption {
+	return func(options *BurnTokensOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) BurnTokensOption {
+	return func(options *BurnTokensOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// BurnTokensOptions is a struct that is used to aggregate the optional parameters in the BurnTokens call.
+type BurnTokensOptions struct {
+	Color                 ledgerstate.Color
+	Amount                uint64
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+}
+
+// Build is a utility function that constructs the BurnTokensOptions.
+func Build(options ...BurnTokensOption) (result *BurnTokensOptions, err error) {
+	// create options to collect the arguments provided
+	result = &BurnTokensOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if result.Color == ledgerstate.ColorIOTA {
+		return nil, errors.New("you need to provide the Color of the burned token")
+	}
+	if result.Amount == 0 {
+		return nil, errors.New("you need to provide the Amount of burned tokens")
+	}
+
+	return
+}
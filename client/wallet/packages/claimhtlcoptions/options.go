@@ -0,0 +1,96 @@
+package claimhtlcoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// ClaimHashTimeLockOption is a function that provides an option.
+type ClaimHashTimeLockOption func(options *ClaimHashTimeLockOptions) error
+
+// OutputID specifies which hash time locked output to claim.
+func OutputID(outputID string) ClaimHashTimeLockOption {
+	return func(options *ClaimHashTimeLockOptions) error {
+		parsed, err := ledgerstate.OutputIDFromBase58(outputID)
+		if err != nil {
+			return err
+		}
+		options.OutputID = parsed
+		return nil
+	}
+}
+
+// Preimage specifies the secret that unlocks the hash lock of the output.
+func Preimage(preimage ledgerstate.Preimage) ClaimHashTimeLockOption {
+	return func(options *ClaimHashTimeLockOptions) error {
+		options.Preimage = &preimage
+		return nil
+	}
+}
+
+// ToAddress specifies the wallet address the claimed funds are sent to. If not set, an address of the wallet is chosen.
+func ToAddress(addr address.Address) ClaimHashTimeLockOption {
+	return func(options *ClaimHashTimeLockOptions) error {
+		options.ToAddress = addr
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for ClaimHashTimeLock call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) ClaimHashTimeLockOption {
+	return func(options *ClaimHashTimeLockOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for ClaimHashTimeLock call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) ClaimHashTimeLockOption {
+	return func(options *ClaimHashTimeLockOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) ClaimHashTimeLockOption {
+	return func(options *ClaimHashTimeLockOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// ClaimHashTimeLockOptions is a struct that is used to aggregate the optional parameters in the ClaimHashTimeLock call.
+type ClaimHashTimeLockOptions struct {
+	OutputID              ledgerstate.OutputID
+	Preimage              *ledgerstate.Preimage
+	ToAddress             address.Address
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+}
+
+// Build is a utility function that constructs the ClaimHashTimeLockOptions.
+func Build(options ...ClaimHashTimeLockOption) (result *ClaimHashTimeLockOptions, err error) {
+	// create options to collect the arguments provided
+	result = &ClaimHashTimeLockOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if result.OutputID == ledgerstate.EmptyOutputID {
+		return nil, errors.New("you need to provide the OutputID of the hash time lock to claim")
+	}
+	if result.Preimage == nil {
+		return nil, errors.New("you need to provide the Preimage that unlocks the hash time lock")
+	}
+
+	return
+}
@@ -0,0 +1,103 @@
+package createfoundryoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// CreateFoundryOption is a function that provides an option.
+type CreateFoundryOption func(options *CreateFoundryOptions) error
+
+// Alias is an option for the CreateFoundry call that defines the alias that controls the foundry. The wallet has to be
+// the state controller of the alias.
+func Alias(aliasID string) CreateFoundryOption {
+	return func(options *CreateFoundryOptions) error {
+		parsed, err := ledgerstate.AliasAddressFromBase58EncodedString(aliasID)
+		if err != nil {
+			return err
+		}
+		options.Alias = parsed
+		return nil
+	}
+}
+
+// MaximumSupply is an option for the CreateFoundry call that defines how many tokens the foundry can mint at most.
+func MaximumSupply(maximumSupply uint64) CreateFoundryOption {
+	return func(options *CreateFoundryOptions) error {
+		if maximumSupply == 0 || maximumSupply > ledgerstate.MaxOutputBalance {
+			return errors.Errorf("maximum supply has to be between 1 and %d", ledgerstate.MaxOutputBalance)
+		}
+		options.MaximumSupply = maximumSupply
+		return nil
+	}
+}
+
+// TokenMetadata is an option for the CreateFoundry call that defines the immutable metadata of the token, e.g. its name
+// and symbol.
+func TokenMetadata(tokenMetadata []byte) CreateFoundryOption {
+	return func(options *CreateFoundryOptions) error {
+		if len(tokenMetadata) > ledgerstate.MaxTokenMetadataSize {
+			return errors.Errorf("token metadata exceeds the maximum size of %d bytes", ledgerstate.MaxTokenMetadataSize)
+		}
+		options.TokenMetadata = tokenMetadata
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for CreateFoundry call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) CreateFoundryOption {
+	return func(options *CreateFoundryOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for CreateFoundry call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) CreateFoundryOption {
+	return func(options *CreateFoundryOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) CreateFoundryOption {
+	return func(options *CreateFoundryOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// CreateFoundryOptions is a struct that is used to aggregate the optional parameters in the CreateFoundry call.
+type CreateFoundryOptions struct {
+	Alias                 *ledgerstate.AliasAddress
+	MaximumSupply         uint64
+	TokenMetadata         []byte
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+}
+
+// Build is a utility function that constructs the CreateFoundryOptions.
+func Build(options ...CreateFoundryOption) (result *CreateFoundryOptions, err error) {
+	// create options to collect the arguments provided
+	result = &CreateFoundryOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if result.Alias == nil {
+		return nil, errors.New("an alias identifier must be specified for the foundry")
+	}
+	if result.MaximumSupply == 0 {
+		return nil, errors.New("you need to provide the MaximumSupply of the token")
+	}
+
+	return
+}
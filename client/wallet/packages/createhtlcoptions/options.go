@@ -0,0 +1,185 @@
+package createhtlcoptions
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/constants"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// CreateHashTimeLockOption is a function that provides an option.
+type CreateHashTimeLockOption func(options *CreateHashTimeLockOptions) error
+
+// Destination is an option for the CreateHashTimeLock call that defines the address that can claim the funds by
+// revealing the preimage of the hash lock.
+func Destination(addr ledgerstate.Address, amount uint64, optionalColor ...ledgerstate.Color) CreateHashTimeLockOption {
+	// determine optional output color
+	var outputColor ledgerstate.Color
+	switch len(optionalColor) {
+	case 0:
+		outputColor = ledgerstate.ColorIOTA
+	case 1:
+		outputColor = optionalColor[0]
+	default:
+		return optionError(errors.New("providing more than one output color for the destination of funds is forbidden"))
+	}
+
+	if addr == nil {
+		return optionError(errors.New("empty destination address provided"))
+	}
+	if amount == 0 {
+		return optionError(errors.New("the amount provided in the destination needs to be larger than 0"))
+	}
+
+	return func(options *CreateHashTimeLockOptions) error {
+		if options.Balances == nil {
+			options.Balances = make(map[ledgerstate.Color]uint64)
+		}
+		if options.DestinationAddress != nil && !options.DestinationAddress.Equals(addr) {
+			return errors.New("a hash time lock can only have a single destination address")
+		}
+		options.DestinationAddress = addr
+		options.Balances[outputColor] += amount
+		return nil
+	}
+}
+
+// HashLock is an option for the CreateHashTimeLock call that defines the hash of the secret that the destination has
+// to reveal to claim the funds.
+func HashLock(hashLock ledgerstate.HashLock) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.HashLock = &hashLock
+		return nil
+	}
+}
+
+// Deadline is an option for the CreateHashTimeLock call that defines until when the destination can claim the funds.
+// After the deadline only the refund address can take the funds back. The deadline is truncated to seconds, which is
+// the precision the deadline is exposed with in the web API.
+func Deadline(deadline time.Time) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		if deadline.Before(time.Now()) {
+			return errors.Errorf("invalid deadline: %s is in the past", deadline.String())
+		}
+		if deadline.After(constants.MaxRepresentableTime) {
+			return errors.Errorf("invalid deadline: %s is later, than max representable time %s",
+				deadline.String(), constants.MaxRepresentableTime.String())
+		}
+		options.Deadline = deadline.Truncate(time.Second)
+		return nil
+	}
+}
+
+// RefundAddress is an option for the CreateHashTimeLock call that defines the wallet address that can take back the
+// funds after the deadline. If not set, a fresh address of the wallet is used.
+func RefundAddress(addr address.Address) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.RefundAddress = addr
+		return nil
+	}
+}
+
+// Remainder is an option for the CreateHashTimeLock call that allows us to specify the remainder address that is
+// supposed to be used in the corresponding transaction.
+func Remainder(addr address.Address) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.RemainderAddress = addr
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for CreateHashTimeLock call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for CreateHashTimeLock call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// UsePendingOutputs defines if we can collect outputs that are still pending confirmation.
+func UsePendingOutputs(usePendingOutputs bool) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		options.UsePendingOutputs = usePendingOutputs
+		return nil
+	}
+}
+
+// CreateHashTimeLockOptions is a struct that is used to aggregate the optional parameters in the CreateHashTimeLock call.
+type CreateHashTimeLockOptions struct {
+	DestinationAddress    ledgerstate.Address
+	Balances              map[ledgerstate.Color]uint64
+	HashLock              *ledgerstate.HashLock
+	Deadline              time.Time
+	RefundAddress         address.Address
+	RemainderAddress      address.Address
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+	UsePendingOutputs     bool
+}
+
+// RequiredFunds derives how much funds are needed to fund the hash time lock.
+func (c *CreateHashTimeLockOptions) RequiredFunds() map[ledgerstate.Color]uint64 {
+	requiredFunds := make(map[ledgerstate.Color]uint64)
+	for color, amount := range c.Balances {
+		// if we want to color sth then we need fresh IOTA
+		if color == ledgerstate.ColorMint {
+			color = ledgerstate.ColorIOTA
+		}
+		requiredFunds[color] += amount
+	}
+	return requiredFunds
+}
+
+// Build is a utility function that constructs the CreateHashTimeLockOptions.
+func Build(options ...CreateHashTimeLockOption) (result *CreateHashTimeLockOptions, err error) {
+	// create options to collect the arguments provided
+	result = &CreateHashTimeLockOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if result.DestinationAddress == nil {
+		return nil, errors.New("you need to provide a Destination for the hash time lock")
+	}
+	if result.HashLock == nil {
+		return nil, errors.New("you need to provide the HashLock of the secret")
+	}
+	if result.Deadline.IsZero() {
+		return nil, errors.New("you need to provide a Deadline for the hash time lock")
+	}
+
+	return
+}
+
+// optionError is a utility function that returns a Option that returns the error provided in the
+// argument.
+func optionError(err error) CreateHashTimeLockOption {
+	return func(options *CreateHashTimeLockOptions) error {
+		return err
+	}
+}
@@ -0,0 +1,108 @@
+package minttokensoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// MintTokensOption is a function that provides an option.
+type MintTokensOption func(options *MintTokensOptions) error
+
+// Color is an option for the MintTokens call that defines the token that is minted by its foundry.
+func Color(color ledgerstate.Color) MintTokensOption {
+	return func(options *MintTokensOptions) error {
+		if color == ledgerstate.ColorIOTA || color == ledgerstate.ColorMint {
+			return errors.Errorf("%s is not the color of a token", color.String())
+		}
+		options.Color = color
+		return nil
+	}
+}
+
+// Amount is an option for the MintTokens call that defines how many tokens are minted. The same amount of IOTA is
+// recolored to the token.
+func Amount(amount uint64) MintTokensOption {
+	return func(options *MintTokensOptions) error {
+		if amount == 0 {
+			return errors.New("the amount of minted tokens needs to be larger than 0")
+		}
+		options.Amount = amount
+		return nil
+	}
+}
+
+// ToAddress is an option for the MintTokens call that defines the address that receives the minted tokens. If not
+// set, the tokens are sent to an address of the wallet.
+func ToAddress(addr ledgerstate.Address) MintTokensOption {
+	return func(options *MintTokensOptions) error {
+		options.ToAddress = addr
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for MintTokens call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) MintTokensOption {
+	return func(options *MintTokensOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for MintTokens call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) MintTokensOption {
+	return func(options *MintTokensOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) MintTokensOption {
+	return func(options *MintTokensOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// MintTokensOptions is a struct that is used to aggregate the optional parameters in the MintTokens call.
+type MintTokensOptions struct {
+	Color                 ledgerstate.Color
+	Amount                uint64
+	ToAddress             ledgerstate.Address
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+}
+
+// ToWalletAddress returns the ToAddress as an address of the wallet or address.AddressEmpty if none was set.
+func (m *MintTokensOptions) ToWalletAddress() address.Address {
+	if m.ToAddress == nil {
+		return address.AddressEmpty
+	}
+	return address.Address{AddressBytes: m.ToAddress.Array()}
+}
+
+// Build is a utility function that constructs the MintTokensOptions.
+func Build(options ...MintTokensOption) (result *MintTokensOptions, err error) {
+	// create options to collect the arguments provided
+	result = &MintTokensOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if result.Color == ledgerstate.ColorIOTA {
+		return nil, errors.New("you need to provide the Color of the minted token")
+	}
+	if result.Amount == 0 {
+		return nil, errors.New("you need to provide the Amount of minted tokens")
+	}
+
+	return
+}
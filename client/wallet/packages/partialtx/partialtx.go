@@ -0,0 +1,466 @@
+package partialtx
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+	"github.com/iotaledger/hive.go/typeutils"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+var (
+	// ErrSealed is returned if inputs or outputs are added to a Transaction that was already signed by one of its
+	// parties.
+	ErrSealed = errors.New("partial transaction is sealed by a signature")
+
+	// ErrIncomplete is returned if a Transaction is not signed for all of its inputs yet.
+	ErrIncomplete = errors.New("partial transaction is not signed for all inputs")
+)
+
+// region Transaction //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Transaction is a transaction that is constructed by multiple parties. It contains the essence of the transaction,
+// the Outputs consumed by its inputs and a signature slot for every input. Every party adds its inputs and outputs and
+// signs the inputs it controls. Once the first signature is added the essence is sealed and can no longer be changed.
+type Transaction struct {
+	timestamp         time.Time
+	accessPledgeID    identity.ID
+	consensusPledgeID identity.ID
+	consumedOutputs   ledgerstate.OutputsByID
+	outputs           []ledgerstate.Output
+	signatures        map[ledgerstate.OutputID]*ledgerstate.SignatureUnlockBlock
+}
+
+// New creates an empty Transaction with the given timestamp that pledges its mana to the given nodes.
+func New(timestamp time.Time, accessPledgeID, consensusPledgeID identity.ID) *Transaction {
+	return &Transaction{
+		timestamp:         timestamp,
+		accessPledgeID:    accessPledgeID,
+		consensusPledgeID: consensusPledgeID,
+		consumedOutputs:   make(ledgerstate.OutputsByID),
+		signatures:        make(map[ledgerstate.OutputID]*ledgerstate.SignatureUnlockBlock),
+	}
+}
+
+// FromBytes unmarshals a Transaction from a sequence of bytes.
+func FromBytes(bytes []byte) (transaction *Transaction, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if transaction, err = FromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse partial transaction from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FromBase58EncodedString creates a Transaction from a base58 encoded string.
+func FromBase58EncodedString(base58String string) (transaction *Transaction, err error) {
+	decodedBytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = errors.Errorf("error while decoding base58 encoded partial transaction (%v)", err)
+		return
+	}
+
+	if transaction, _, err = FromBytes(decodedBytes); err != nil {
+		err = errors.Errorf("failed to parse partial transaction from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// FromMarshalUtil unmarshals a Transaction using a MarshalUtil (for easier unmarshaling). The consumed Outputs,
+// Outputs and signatures are checked in the same way as if they were added one by one.
+func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (transaction *Transaction, err error) {
+	timestamp, err := marshalUtil.ReadTime()
+	if err != nil {
+		err = errors.Errorf("failed to parse timestamp (%v)", err)
+		return
+	}
+	var pledgeIDs [2]identity.ID
+	for i := range pledgeIDs {
+		pledgeIDBytes, readErr := marshalUtil.ReadBytes(len(identity.ID{}))
+		if readErr != nil {
+			err = errors.Errorf("failed to parse pledge ID (%v)", readErr)
+			return
+		}
+		copy(pledgeIDs[i][:], pledgeIDBytes)
+	}
+	transaction = New(timestamp, pledgeIDs[0], pledgeIDs[1])
+
+	consumedOutputsCount, err := marshalUtil.ReadUint16()
+	if err != nil {
+		err = errors.Errorf("failed to parse consumed outputs count (%v)", err)
+		return
+	}
+	for i := uint16(0); i < consumedOutputsCount; i++ {
+		outputID, outputIDErr := ledgerstate.OutputIDFromMarshalUtil(marshalUtil)
+		if outputIDErr != nil {
+			err = errors.Errorf("failed to parse OutputID of consumed output: %w", outputIDErr)
+			return
+		}
+		output, outputErr := ledgerstate.OutputFromMarshalUtil(marshalUtil)
+		if outputErr != nil {
+			err = errors.Errorf("failed to parse consumed output: %w", outputErr)
+			return
+		}
+		output.SetID(outputID)
+		if err = transaction.AddInput(output); err != nil {
+			return
+		}
+	}
+
+	outputsCount, err := marshalUtil.ReadUint16()
+	if err != nil {
+		err = errors.Errorf("failed to parse outputs count (%v)", err)
+		return
+	}
+	for i := uint16(0); i < outputsCount; i++ {
+		output, outputErr := ledgerstate.OutputFromMarshalUtil(marshalUtil)
+		if outputErr != nil {
+			err = errors.Errorf("failed to parse output: %w", outputErr)
+			return
+		}
+		if err = transaction.AddOutput(output); err != nil {
+			return
+		}
+	}
+
+	signaturesCount, err := marshalUtil.ReadUint16()
+	if err != nil {
+		err = errors.Errorf("failed to parse signatures count (%v)", err)
+		return
+	}
+	for i := uint16(0); i < signaturesCount; i++ {
+		outputID, outputIDErr := ledgerstate.OutputIDFromMarshalUtil(marshalUtil)
+		if outputIDErr != nil {
+			err = errors.Errorf("failed to parse OutputID of signature: %w", outputIDErr)
+			return
+		}
+		unlockBlock, unlockBlockErr := ledgerstate.SignatureUnlockBlockFromMarshalUtil(marshalUtil)
+		if unlockBlockErr != nil {
+			err = errors.Errorf("failed to parse signature: %w", unlockBlockErr)
+			return
+		}
+		if err = transaction.AddSignature(outputID, unlockBlock.Signature()); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Timestamp returns the timestamp of the essence.
+func (t *Transaction) Timestamp() time.Time {
+	return t.timestamp
+}
+
+// AccessPledgeID returns the access mana pledge nodeID of the essence.
+func (t *Transaction) AccessPledgeID() identity.ID {
+	return t.accessPledgeID
+}
+
+// ConsensusPledgeID returns the consensus mana pledge nodeID of the essence.
+func (t *Transaction) ConsensusPledgeID() identity.ID {
+	return t.consensusPledgeID
+}
+
+// AddInput adds an input that consumes the given Output. The Output needs to have its ID set and needs to be unlockable
+// by a signature.
+func (t *Transaction) AddInput(output ledgerstate.Output) error {
+	if len(t.signatures) != 0 {
+		return ErrSealed
+	}
+	if output.ID() == ledgerstate.EmptyOutputID {
+		return errors.New("consumed output has no OutputID")
+	}
+	if _, exists := t.consumedOutputs[output.ID()]; exists {
+		return errors.Errorf("output %s is already consumed by the partial transaction", output.ID().Base58())
+	}
+	switch output.Type() {
+	case ledgerstate.SigLockedSingleOutputType, ledgerstate.SigLockedColoredOutputType, ledgerstate.ExtendedLockedOutputType:
+	default:
+		return errors.Errorf("output %s of type %s can not be consumed by a partial transaction", output.ID().Base58(), output.Type().String())
+	}
+	if len(t.consumedOutputs) >= ledgerstate.MaxInputCount {
+		return errors.Errorf("partial transaction can not have more than %d inputs", ledgerstate.MaxInputCount)
+	}
+
+	t.consumedOutputs[output.ID()] = output
+
+	return nil
+}
+
+// AddOutput adds the given Output to the essence.
+func (t *Transaction) AddOutput(output ledgerstate.Output) error {
+	if len(t.signatures) != 0 {
+		return ErrSealed
+	}
+	outputBytes := output.Bytes()
+	for _, existingOutput := range t.outputs {
+		if bytes.Equal(existingOutput.Bytes(), outputBytes) {
+			return errors.Errorf("partial transaction already contains output %s", output.String())
+		}
+	}
+	if len(t.outputs) >= ledgerstate.MaxOutputCount {
+		return errors.Errorf("partial transaction can not have more than %d outputs", ledgerstate.MaxOutputCount)
+	}
+
+	t.outputs = append(t.outputs, output)
+
+	return nil
+}
+
+// Inputs returns the consumed Outputs in the order of the inputs of the essence.
+func (t *Transaction) Inputs() (inputs ledgerstate.Outputs) {
+	inputs = make(ledgerstate.Outputs, 0, len(t.consumedOutputs))
+	for _, input := range t.consumedOutputs.Inputs() {
+		inputs = append(inputs, t.consumedOutputs[input.(*ledgerstate.UTXOInput).ReferencedOutputID()])
+	}
+
+	return
+}
+
+// Outputs returns the Outputs of the essence.
+func (t *Transaction) Outputs() ledgerstate.Outputs {
+	return ledgerstate.NewOutputs(t.outputs...)
+}
+
+// Essence returns the TransactionEssence that is signed by the parties.
+func (t *Transaction) Essence() *ledgerstate.TransactionEssence {
+	outputs := make([]ledgerstate.Output, len(t.outputs))
+	for i, output := range t.outputs {
+		outputs[i] = output.Clone()
+	}
+
+	return ledgerstate.NewTransactionEssence(0, t.timestamp, t.accessPledgeID, t.consensusPledgeID, t.consumedOutputs.Inputs(), ledgerstate.NewOutputs(outputs...))
+}
+
+// UnlockAddress returns the Address that needs to sign the input consuming the Output with the given ID.
+func (t *Transaction) UnlockAddress(outputID ledgerstate.OutputID) (unlockAddress ledgerstate.Address, err error) {
+	output, exists := t.consumedOutputs[outputID]
+	if !exists {
+		return nil, errors.Errorf("output %s is not consumed by the partial transaction", outputID.Base58())
+	}
+
+	if extendedLockedOutput, isExtended := output.(*ledgerstate.ExtendedLockedOutput); isExtended {
+		if extendedLockedOutput.TimeLockedNow(t.timestamp) {
+			return nil, errors.Errorf("output %s is time locked at %s", outputID.Base58(), t.timestamp.String())
+		}
+
+		return extendedLockedOutput.UnlockAddressNow(t.timestamp), nil
+	}
+
+	return output.Address(), nil
+}
+
+// AddSignature fills the signature slot of the input consuming the Output with the given ID. The signature needs to be
+// a valid signature of the essence by the unlock address of the Output.
+func (t *Transaction) AddSignature(outputID ledgerstate.OutputID, signature ledgerstate.Signature) error {
+	unlockAddress, err := t.UnlockAddress(outputID)
+	if err != nil {
+		return err
+	}
+	unlockBlock := ledgerstate.NewSignatureUnlockBlock(signature)
+	if !unlockBlock.AddressSignatureValid(unlockAddress, t.Essence().Bytes()) {
+		return errors.Errorf("signature is not valid for output %s", outputID.Base58())
+	}
+
+	t.signatures[outputID] = unlockBlock
+
+	return nil
+}
+
+// Signed returns true if the input consuming the Output with the given ID was signed.
+func (t *Transaction) Signed(outputID ledgerstate.OutputID) bool {
+	_, signed := t.signatures[outputID]
+
+	return signed
+}
+
+// MissingSignatures returns the IDs of the consumed Outputs whose inputs were not signed yet.
+func (t *Transaction) MissingSignatures() (outputIDs []ledgerstate.OutputID) {
+	for _, input := range t.Inputs() {
+		if !t.Signed(input.ID()) {
+			outputIDs = append(outputIDs, input.ID())
+		}
+	}
+
+	return
+}
+
+// Complete returns true if all inputs were signed.
+func (t *Transaction) Complete() bool {
+	return len(t.consumedOutputs) != 0 && len(t.signatures) == len(t.consumedOutputs)
+}
+
+// Merge adds the signatures of another Transaction with the same essence.
+func (t *Transaction) Merge(other *Transaction) error {
+	if !bytes.Equal(t.Essence().Bytes(), other.Essence().Bytes()) {
+		return errors.New("partial transactions have different essences")
+	}
+
+	for outputID, unlockBlock := range other.signatures {
+		if _, exists := t.signatures[outputID]; !exists {
+			t.signatures[outputID] = unlockBlock
+		}
+	}
+
+	return nil
+}
+
+// Contains returns true if the Transaction contains the inputs and outputs of the other Transaction and uses the same
+// timestamp and pledge IDs. A party uses it to check that the Transaction it signs still contains its offer.
+func (t *Transaction) Contains(other *Transaction) bool {
+	if !t.timestamp.Equal(other.timestamp) || t.accessPledgeID != other.accessPledgeID || t.consensusPledgeID != other.consensusPledgeID {
+		return false
+	}
+
+	for outputID, otherOutput := range other.consumedOutputs {
+		output, exists := t.consumedOutputs[outputID]
+		if !exists || !bytes.Equal(output.Bytes(), otherOutput.Bytes()) {
+			return false
+		}
+	}
+
+	outputs := make(map[string]bool)
+	for _, output := range t.outputs {
+		outputs[typeutils.BytesToString(output.Bytes())] = true
+	}
+	for _, otherOutput := range other.outputs {
+		if !outputs[typeutils.BytesToString(otherOutput.Bytes())] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Balances returns the funds that are consumed but not yet spent by an output (surplus) and the funds that are spent
+// but not yet consumed by an input (deficit). The Transaction is balanced if both are empty.
+func (t *Transaction) Balances() (surplus, deficit map[ledgerstate.Color]uint64) {
+	balances := make(map[ledgerstate.Color]int64)
+	for _, output := range t.consumedOutputs {
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			balances[color] += int64(balance)
+			return true
+		})
+	}
+	for _, output := range t.outputs {
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			if color == ledgerstate.ColorMint {
+				color = ledgerstate.ColorIOTA
+			}
+			balances[color] -= int64(balance)
+			return true
+		})
+	}
+
+	surplus = make(map[ledgerstate.Color]uint64)
+	deficit = make(map[ledgerstate.Color]uint64)
+	for color, balance := range balances {
+		switch {
+		case balance > 0:
+			surplus[color] = uint64(balance)
+		case balance < 0:
+			deficit[color] = uint64(-balance)
+		}
+	}
+
+	return
+}
+
+// Transaction returns the ledgerstate.Transaction once all inputs are signed. Inputs signed by the same signature are
+// unlocked by a reference to the first of them.
+func (t *Transaction) Transaction() (transaction *ledgerstate.Transaction, err error) {
+	if !t.Complete() {
+		return nil, errors.Errorf("%d of %d inputs are signed: %w", len(t.signatures), len(t.consumedOutputs), ErrIncomplete)
+	}
+
+	essence := t.Essence()
+	unlockBlocks := make(ledgerstate.UnlockBlocks, len(essence.Inputs()))
+	signatureIndexes := make(map[string]uint16)
+	for i, input := range essence.Inputs() {
+		unlockBlock := t.signatures[input.(*ledgerstate.UTXOInput).ReferencedOutputID()]
+		unlockBlockBytes := typeutils.BytesToString(unlockBlock.Bytes())
+		if index, exists := signatureIndexes[unlockBlockBytes]; exists {
+			unlockBlocks[i] = ledgerstate.NewReferenceUnlockBlock(index)
+			continue
+		}
+
+		unlockBlocks[i] = unlockBlock
+		signatureIndexes[unlockBlockBytes] = uint16(i)
+	}
+
+	return ledgerstate.NewTransaction(essence, unlockBlocks), nil
+}
+
+// Bytes returns a marshaled version of the Transaction.
+func (t *Transaction) Bytes() []byte {
+	marshalUtil := marshalutil.New().
+		WriteTime(t.timestamp).
+		WriteBytes(t.accessPledgeID.Bytes()).
+		WriteBytes(t.consensusPledgeID.Bytes())
+
+	inputs := t.Inputs()
+	marshalUtil.WriteUint16(uint16(len(inputs)))
+	for _, input := range inputs {
+		marshalUtil.Write(input.ID()).Write(input)
+	}
+
+	marshalUtil.WriteUint16(uint16(len(t.outputs)))
+	for _, output := range t.outputs {
+		marshalUtil.Write(output)
+	}
+
+	signedOutputIDs := make([]ledgerstate.OutputID, 0, len(t.signatures))
+	for outputID := range t.signatures {
+		signedOutputIDs = append(signedOutputIDs, outputID)
+	}
+	sort.Slice(signedOutputIDs, func(i, j int) bool {
+		return bytes.Compare(signedOutputIDs[i].Bytes(), signedOutputIDs[j].Bytes()) < 0
+	})
+	marshalUtil.WriteUint16(uint16(len(signedOutputIDs)))
+	for _, outputID := range signedOutputIDs {
+		marshalUtil.Write(outputID).Write(t.signatures[outputID])
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// Base58 returns a base58 encoded version of the Transaction.
+func (t *Transaction) Base58() string {
+	return base58.Encode(t.Bytes())
+}
+
+// String returns a human readable version of the Transaction.
+func (t *Transaction) String() string {
+	inputs := stringify.StructBuilder("Inputs")
+	for i, input := range t.Inputs() {
+		inputs.AddField(stringify.StructField(strconv.Itoa(i), input))
+	}
+	outputs := stringify.StructBuilder("Outputs")
+	for i, output := range t.Outputs() {
+		outputs.AddField(stringify.StructField(strconv.Itoa(i), output))
+	}
+
+	return stringify.Struct("PartialTransaction",
+		stringify.StructField("timestamp", t.timestamp),
+		stringify.StructField("accessPledgeID", t.accessPledgeID),
+		stringify.StructField("consensusPledgeID", t.consensusPledgeID),
+		stringify.StructField("inputs", inputs),
+		stringify.StructField("outputs", outputs),
+		stringify.StructField("signatures", len(t.signatures)),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
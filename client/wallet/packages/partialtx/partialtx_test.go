@@ -0,0 +1,108 @@
+package partialtx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestTransaction(t *testing.T) {
+	aliceSeed, bobSeed := seed.NewSeed(), seed.NewSeed()
+	tokenColor := ledgerstate.Color{1}
+
+	// alice offers 100 IOTA from two outputs of the same address for 50 tokens
+	offer := New(time.Now(), identity.ID{}, identity.ID{})
+	require.NoError(t, offer.AddInput(randomOutput(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 60}, aliceSeed.Address(0).Address())))
+	require.NoError(t, offer.AddInput(randomOutput(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 40}, aliceSeed.Address(0).Address())))
+	require.NoError(t, offer.AddOutput(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{tokenColor: 50}), aliceSeed.Address(1).Address())))
+	assert.Error(t, offer.AddInput(offer.Inputs()[0]))
+	assert.Error(t, offer.AddOutput(offer.Outputs()[0]))
+
+	surplus, deficit := offer.Balances()
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 100}, surplus)
+	assert.Equal(t, map[ledgerstate.Color]uint64{tokenColor: 50}, deficit)
+
+	// bob accepts the offer
+	accepted, _, err := FromBytes(offer.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, offer.Bytes(), accepted.Bytes())
+	require.NoError(t, accepted.AddInput(randomOutput(deficit, bobSeed.Address(0).Address())))
+	require.NoError(t, accepted.AddOutput(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(surplus), bobSeed.Address(1).Address())))
+	surplus, deficit = accepted.Balances()
+	assert.Empty(t, surplus)
+	assert.Empty(t, deficit)
+	assert.True(t, accepted.Contains(offer))
+	assert.False(t, offer.Contains(accepted))
+
+	// the parties sign their inputs on separate copies
+	aliceCopy, _, err := FromBytes(accepted.Bytes())
+	require.NoError(t, err)
+	bobCopy, _, err := FromBytes(accepted.Bytes())
+	require.NoError(t, err)
+	for _, input := range accepted.Inputs() {
+		unlockAddress, unlockAddressErr := accepted.UnlockAddress(input.ID())
+		require.NoError(t, unlockAddressErr)
+		switch {
+		case unlockAddress.Equals(aliceSeed.Address(0).Address()):
+			assert.Error(t, aliceCopy.AddSignature(input.ID(), sign(bobSeed, 0, aliceCopy)))
+			require.NoError(t, aliceCopy.AddSignature(input.ID(), sign(aliceSeed, 0, aliceCopy)))
+		default:
+			require.NoError(t, bobCopy.AddSignature(input.ID(), sign(bobSeed, 0, bobCopy)))
+		}
+	}
+	assert.ErrorIs(t, aliceCopy.AddOutput(ledgerstate.NewSigLockedSingleOutput(1, aliceSeed.Address(2).Address())), ErrSealed)
+	assert.Len(t, aliceCopy.MissingSignatures(), 1)
+	_, err = aliceCopy.Transaction()
+	assert.ErrorIs(t, err, ErrIncomplete)
+
+	// merging the signatures completes the transaction
+	assert.Error(t, aliceCopy.Merge(offer))
+	require.NoError(t, aliceCopy.Merge(bobCopy))
+	assert.True(t, aliceCopy.Complete())
+	tx, err := aliceCopy.Transaction()
+	require.NoError(t, err)
+
+	parsedTx, _, err := ledgerstate.TransactionFromBytes(tx.Bytes())
+	require.NoError(t, err)
+	assert.True(t, ledgerstate.TransactionBalancesValid(aliceCopy.Inputs(), parsedTx.Essence().Outputs()))
+	unlocksValid, err := ledgerstate.UnlockBlocksValidWithError(aliceCopy.Inputs(), parsedTx)
+	require.NoError(t, err)
+	assert.True(t, unlocksValid)
+
+	referenceUnlockBlocks := 0
+	for _, unlockBlock := range parsedTx.UnlockBlocks() {
+		if unlockBlock.Type() == ledgerstate.ReferenceUnlockBlockType {
+			referenceUnlockBlocks++
+		}
+	}
+	assert.Equal(t, 1, referenceUnlockBlocks)
+
+	// signatures survive the marshaling
+	restored, err := FromBase58EncodedString(aliceCopy.Base58())
+	require.NoError(t, err)
+	assert.True(t, restored.Complete())
+	restoredTx, err := restored.Transaction()
+	require.NoError(t, err)
+	assert.Equal(t, tx.ID(), restoredTx.ID())
+}
+
+func randomOutput(balances map[ledgerstate.Color]uint64, address ledgerstate.Address) ledgerstate.Output {
+	txID, err := ledgerstate.TransactionIDFromRandomness()
+	if err != nil {
+		panic(err)
+	}
+
+	return ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(balances), address).SetID(ledgerstate.NewOutputID(txID, 0))
+}
+
+func sign(s *seed.Seed, index uint64, transaction *Transaction) ledgerstate.Signature {
+	keyPair := s.KeyPair(index)
+
+	return ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(transaction.Essence().Bytes()))
+}
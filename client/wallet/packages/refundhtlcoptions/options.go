@@ -0,0 +1,85 @@
+package refundhtlcoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// RefundHashTimeLockOption is a function that provides an option.
+type RefundHashTimeLockOption func(options *RefundHashTimeLockOptions) error
+
+// OutputID specifies which expired hash time locked output to refund.
+func OutputID(outputID string) RefundHashTimeLockOption {
+	return func(options *RefundHashTimeLockOptions) error {
+		parsed, err := ledgerstate.OutputIDFromBase58(outputID)
+		if err != nil {
+			return err
+		}
+		options.OutputID = parsed
+		return nil
+	}
+}
+
+// ToAddress specifies the wallet address the refunded funds are sent to. If not set, an address of the wallet is
+// chosen.
+func ToAddress(addr address.Address) RefundHashTimeLockOption {
+	return func(options *RefundHashTimeLockOptions) error {
+		options.ToAddress = addr
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for RefundHashTimeLock call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) RefundHashTimeLockOption {
+	return func(options *RefundHashTimeLockOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for RefundHashTimeLock call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) RefundHashTimeLockOption {
+	return func(options *RefundHashTimeLockOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) RefundHashTimeLockOption {
+	return func(options *RefundHashTimeLockOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// RefundHashTimeLockOptions is a struct that is used to aggregate the optional parameters in the RefundHashTimeLock call.
+type RefundHashTimeLockOptions struct {
+	OutputID              ledgerstate.OutputID
+	ToAddress             address.Address
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+}
+
+// Build is a utility function that constructs the RefundHashTimeLockOptions.
+func Build(options ...RefundHashTimeLockOption) (result *RefundHashTimeLockOptions, err error) {
+	// create options to collect the arguments provided
+	result = &RefundHashTimeLockOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if result.OutputID == ledgerstate.EmptyOutputID {
+		return nil, errors.New("you need to provide the OutputID of the hash time lock to refund")
+	}
+
+	return
+}
@@ -0,0 +1,198 @@
+package seed
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/mr-tron/base58"
+	"go.dedis.ch/kyber/v3"
+	"go.dedis.ch/kyber/v3/group/edwards25519"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// AccountPublicKeyLength contains the length of a marshaled AccountPublicKey (public key and chain code).
+const AccountPublicKeyLength = ed25519.PublicKeySize + chainCodeLength
+
+const (
+	chainCodeLength = 32
+
+	// accountDerivationKey is the HMAC key used to derive the accounts from a seed.
+	accountDerivationKey = "GoShimmer wallet account"
+)
+
+var curve = edwards25519.NewBlakeSHA256Ed25519()
+
+// region Account //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Account is a hierarchical deterministic account derived from a Seed. The addresses of an account can be derived from
+// its AccountPublicKey alone, which allows to watch them without knowing the seed.
+type Account struct {
+	index      uint32
+	privateKey kyber.Scalar
+	publicKey  *AccountPublicKey
+}
+
+// Account derives the account with the given index from the seed.
+func (seed *Seed) Account(index uint32) *Account {
+	indexBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(indexBytes, index)
+	digest := hmacSHA512([]byte(accountDerivationKey), seed.Bytes(), indexBytes)
+
+	account := &Account{
+		index:      index,
+		privateKey: curve.Scalar().SetBytes(digest[:32]),
+		publicKey:  &AccountPublicKey{},
+	}
+	publicKeyBytes, _ := curve.Point().Mul(account.privateKey, nil).MarshalBinary()
+	copy(account.publicKey.publicKey[:], publicKeyBytes)
+	copy(account.publicKey.chainCode[:], digest[32:])
+
+	return account
+}
+
+// Index returns the index of the account within its seed.
+func (a *Account) Index() uint32 {
+	return a.index
+}
+
+// PublicKey returns the AccountPublicKey, which allows to derive the addresses of the account.
+func (a *Account) PublicKey() *AccountPublicKey {
+	return a.publicKey
+}
+
+// Address returns the Address of the account with the given index.
+func (a *Account) Address(index uint64) address.Address {
+	return a.publicKey.Address(index)
+}
+
+// Sign signs the given data with the private key of the address with the given index.
+func (a *Account) Sign(index uint64, data []byte) *ledgerstate.ED25519Signature {
+	tweak, nonceKey := a.publicKey.derive(index)
+	privateKey := curve.Scalar().Add(a.privateKey, tweak)
+	publicKey := a.publicKey.PublicKey(index)
+
+	// Ed25519 signature (RFC 8032) using the derived scalar instead of a hashed seed
+	privateKeyBytes, _ := privateKey.MarshalBinary()
+	r := curve.Scalar().SetBytes(sha512Sum(hmacSHA512(nonceKey, privateKeyBytes)[:32], data))
+	rBytes, _ := curve.Point().Mul(r, nil).MarshalBinary()
+	h := curve.Scalar().SetBytes(sha512Sum(rBytes, publicKey[:], data))
+	s := curve.Scalar().Add(r, curve.Scalar().Mul(h, privateKey))
+	sBytes, _ := s.MarshalBinary()
+
+	var signature ed25519.Signature
+	copy(signature[:32], rBytes)
+	copy(signature[32:], sBytes)
+
+	return ledgerstate.NewED25519Signature(publicKey, signature)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AccountPublicKey /////////////////////////////////////////////////////////////////////////////////////////////
+
+// AccountPublicKey is the public part of an Account. It consists of the public key of the account and a chain code and
+// allows to derive all the addresses of the account, but none of their private keys.
+type AccountPublicKey struct {
+	publicKey [ed25519.PublicKeySize]byte
+	chainCode [chainCodeLength]byte
+}
+
+// AccountPublicKeyFromBytes unmarshals an AccountPublicKey from a sequence of bytes.
+func AccountPublicKeyFromBytes(bytes []byte) (accountPublicKey *AccountPublicKey, err error) {
+	if len(bytes) != AccountPublicKeyLength {
+		return nil, errors.Errorf("invalid length of account public key: %d != %d", len(bytes), AccountPublicKeyLength)
+	}
+	if err = curve.Point().UnmarshalBinary(bytes[:ed25519.PublicKeySize]); err != nil {
+		return nil, errors.Errorf("failed to parse account public key: %w", err)
+	}
+
+	accountPublicKey = &AccountPublicKey{}
+	copy(accountPublicKey.publicKey[:], bytes[:ed25519.PublicKeySize])
+	copy(accountPublicKey.chainCode[:], bytes[ed25519.PublicKeySize:])
+
+	return accountPublicKey, nil
+}
+
+// AccountPublicKeyFromBase58 unmarshals an AccountPublicKey from a base58 encoded string.
+func AccountPublicKeyFromBase58(base58String string) (accountPublicKey *AccountPublicKey, err error) {
+	bytes, err := base58.Decode(base58String)
+	if err != nil {
+		return nil, errors.Errorf("failed to decode base58 encoded account public key: %w", err)
+	}
+
+	return AccountPublicKeyFromBytes(bytes)
+}
+
+// PublicKey returns the public key of the address with the given index.
+func (a *AccountPublicKey) PublicKey(index uint64) (publicKey ed25519.PublicKey) {
+	accountPublicKey := curve.Point()
+	if err := accountPublicKey.UnmarshalBinary(a.publicKey[:]); err != nil {
+		panic(err)
+	}
+
+	tweak, _ := a.derive(index)
+	publicKeyBytes, _ := curve.Point().Add(accountPublicKey, curve.Point().Mul(tweak, nil)).MarshalBinary()
+	copy(publicKey[:], publicKeyBytes)
+
+	return publicKey
+}
+
+// Address returns the Address with the given index.
+func (a *AccountPublicKey) Address(index uint64) (addr address.Address) {
+	addr = address.Address{
+		Index: index,
+	}
+	copy(addr.AddressBytes[:], ledgerstate.NewED25519Address(a.PublicKey(index)).Bytes())
+
+	return
+}
+
+// Bytes returns a marshaled version of the AccountPublicKey.
+func (a *AccountPublicKey) Bytes() []byte {
+	return append(a.publicKey[:], a.chainCode[:]...)
+}
+
+// Base58 returns a base58 encoded version of the AccountPublicKey.
+func (a *AccountPublicKey) Base58() string {
+	return base58.Encode(a.Bytes())
+}
+
+// String returns a human readable version of the AccountPublicKey.
+func (a *AccountPublicKey) String() string {
+	return "AccountPublicKey(" + a.Base58() + ")"
+}
+
+// derive returns the scalar that is added to the key of the account to get the key of the address with the given index
+// and the key used to derive the signature nonces of that address.
+func (a *AccountPublicKey) derive(index uint64) (tweak kyber.Scalar, nonceKey []byte) {
+	indexBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(indexBytes, index)
+	digest := hmacSHA512(a.chainCode[:], a.publicKey[:], indexBytes)
+
+	return curve.Scalar().SetBytes(digest[:32]), digest[32:]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func hmacSHA512(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	for _, d := range data {
+		_, _ = mac.Write(d)
+	}
+
+	return mac.Sum(nil)
+}
+
+func sha512Sum(data ...[]byte) []byte {
+	hash := sha512.New()
+	for _, d := range data {
+		_, _ = hash.Write(d)
+	}
+
+	return hash.Sum(nil)
+}
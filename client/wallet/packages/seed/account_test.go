@@ -0,0 +1,48 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestAccount(t *testing.T) {
+	seed := NewSeed()
+	account := seed.Account(0)
+
+	// the accounts are independent of each other and of the flat address sequence
+	assert.NotEqual(t, account.Address(0), seed.Account(1).Address(0))
+	assert.NotEqual(t, account.Address(0), seed.Address(0))
+	assert.Equal(t, account.Address(7), seed.Account(0).Address(7))
+
+	// the public key derives the same addresses as the account
+	accountPublicKey, err := AccountPublicKeyFromBase58(account.PublicKey().Base58())
+	require.NoError(t, err)
+	for index := uint64(0); index < 10; index++ {
+		assert.Equal(t, account.Address(index), accountPublicKey.Address(index))
+	}
+
+	// the signatures unlock the outputs on the derived addresses
+	data := []byte("essence")
+	for index := uint64(0); index < 10; index++ {
+		signature := account.Sign(index, data)
+		assert.True(t, signature.AddressSignatureValid(account.Address(index).Address(), data))
+		assert.False(t, signature.AddressSignatureValid(account.Address(index+1).Address(), data))
+		assert.False(t, signature.AddressSignatureValid(account.Address(index).Address(), []byte("other essence")))
+	}
+
+	_, err = AccountPublicKeyFromBytes(account.PublicKey().Bytes()[1:])
+	assert.Error(t, err)
+}
+
+func TestAccount_SignatureMarshaling(t *testing.T) {
+	account := NewSeed().Account(3)
+	signature := account.Sign(42, []byte("essence"))
+
+	restoredSignature, _, err := ledgerstate.ED25519SignatureFromBytes(signature.Bytes())
+	require.NoError(t, err)
+	assert.True(t, restoredSignature.AddressSignatureValid(account.Address(42).Address(), []byte("essence")))
+}
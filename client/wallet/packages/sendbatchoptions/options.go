@@ -0,0 +1,177 @@
+package sendbatchoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// SendBatchOption is the type for the optional parameters for the SendBatch call.
+type SendBatchOption func(*SendBatchOptions) error
+
+// ProgressCallback is called after each transaction of a batch was issued. It receives the number of issued
+// transactions, the number of planned transactions and the transaction that was issued.
+type ProgressCallback func(issued, total int, tx *ledgerstate.Transaction)
+
+// Payment is a single payment of a batch.
+type Payment struct {
+	Address address.Address
+	Amount  uint64
+	Color   ledgerstate.Color
+}
+
+// Destination is an option for the SendBatch call that adds a payment to the batch. Payments are issued in the order
+// in which they were added.
+func Destination(addr address.Address, amount uint64, optionalColor ...ledgerstate.Color) SendBatchOption {
+	// determine optional output color
+	var outputColor ledgerstate.Color
+	switch len(optionalColor) {
+	case 0:
+		outputColor = ledgerstate.ColorIOTA
+	case 1:
+		outputColor = optionalColor[0]
+	default:
+		return optionError(errors.New("providing more than one output color for the destination of funds is forbidden"))
+	}
+
+	// return an error if the amount is less
+	if amount == 0 {
+		return optionError(errors.New("the amount provided in the destinations needs to be larger than 0"))
+	}
+
+	return func(options *SendBatchOptions) error {
+		options.Payments = append(options.Payments, &Payment{
+			Address: addr,
+			Amount:  amount,
+			Color:   outputColor,
+		})
+
+		return nil
+	}
+}
+
+// Remainder is an option for the SendBatch call that allows us to specify the remainder address of the last
+// transaction of the batch.
+func Remainder(addr address.Address) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		options.RemainderAddress = addr
+
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for SendBatch call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for SendBatch call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// WaitForConfirmation defines if the call should wait for the confirmation of the last transaction of the batch
+// before it returns.
+func WaitForConfirmation(wait bool) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// UsePendingOutputs defines if we can collect outputs that are still pending confirmation.
+func UsePendingOutputs(usePendingOutputs bool) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		options.UsePendingOutputs = usePendingOutputs
+		return nil
+	}
+}
+
+// Progress defines a callback that is called after each transaction of the batch was issued.
+func Progress(callback ProgressCallback) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		options.Progress = callback
+		return nil
+	}
+}
+
+// SendBatchOptions is a struct that is used to aggregate the optional parameters provided in the SendBatch call.
+type SendBatchOptions struct {
+	Payments              []*Payment
+	RemainderAddress      address.Address
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	WaitForConfirmation   bool
+	UsePendingOutputs     bool
+	Progress              ProgressCallback
+}
+
+// RequiredFunds derives how much funds are needed to fund all the payments.
+func (s *SendBatchOptions) RequiredFunds() map[ledgerstate.Color]uint64 {
+	requiredFunds := make(map[ledgerstate.Color]uint64)
+	for _, payment := range s.Payments {
+		// if we want to color sth then we need fresh IOTA
+		color := payment.Color
+		if color == ledgerstate.ColorMint {
+			color = ledgerstate.ColorIOTA
+		}
+
+		requiredFunds[color] += payment.Amount
+	}
+	return requiredFunds
+}
+
+// DestinationChunks groups the payments into chunks that pay to at most maxAddresses different addresses, keeping the
+// order of the payments.
+func (s *SendBatchOptions) DestinationChunks(maxAddresses int) (chunks []map[address.Address]map[ledgerstate.Color]uint64) {
+	var currentChunk map[address.Address]map[ledgerstate.Color]uint64
+	for _, payment := range s.Payments {
+		if _, addressExists := currentChunk[payment.Address]; !addressExists {
+			if currentChunk == nil || len(currentChunk) == maxAddresses {
+				currentChunk = make(map[address.Address]map[ledgerstate.Color]uint64)
+				chunks = append(chunks, currentChunk)
+			}
+			currentChunk[payment.Address] = make(map[ledgerstate.Color]uint64)
+		}
+		currentChunk[payment.Address][payment.Color] += payment.Amount
+	}
+
+	return chunks
+}
+
+// Build is a utility function that constructs the SendBatchOptions.
+func Build(options ...SendBatchOption) (result *SendBatchOptions, err error) {
+	// create options to collect the arguments provided
+	result = &SendBatchOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	// sanitize parameters
+	if len(result.Payments) == 0 {
+		err = errors.New("you need to provide at least one Destination for a valid batch to be issued")
+
+		return
+	}
+
+	return
+}
+
+// optionError is a utility function that returns a Option that returns the error provided in the
+// argument.
+func optionError(err error) SendBatchOption {
+	return func(options *SendBatchOptions) error {
+		return err
+	}
+}
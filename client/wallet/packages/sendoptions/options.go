@@ -132,6 +132,22 @@ func Fallback(addy ledgerstate.Address, deadline time.Time) SendFundsOption {
 	}
 }
 
+// ValidUntil is an option for SendFunds call that defines the time after which the transaction can no longer be
+// attached. If the node did not book the transaction until then, the wallet releases its inputs.
+func ValidUntil(validUntil time.Time) SendFundsOption {
+	return func(options *SendFundsOptions) error {
+		if validUntil.Before(time.Now()) {
+			return errors.Errorf("invalid validity window: %s is in the past", validUntil.String())
+		}
+		if validUntil.After(constants.MaxRepresentableTime) {
+			return errors.Errorf("invalid validity window: %s is later, than max representable time %s",
+				validUntil.String(), constants.MaxRepresentableTime.String())
+		}
+		options.ValidUntil = validUntil
+		return nil
+	}
+}
+
 // SendFundsOptions is a struct that is used to aggregate the optional parameters provided in the SendFunds call.
 type SendFundsOptions struct {
 	Destinations          map[address.Address]map[ledgerstate.Color]uint64
@@ -139,6 +155,7 @@ type SendFundsOptions struct {
 	LockUntil             time.Time
 	FallbackAddress       ledgerstate.Address
 	FallbackDeadline      time.Time
+	ValidUntil            time.Time
 	AccessManaPledgeID    string
 	ConsensusManaPledgeID string
 	WaitForConfirmation   bool
@@ -0,0 +1,95 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/partialtx"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_PartialTransactionSwap(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	aliceSeed, bobSeed := seed.NewSeed(), seed.NewSeed()
+	aliceAddress, bobAddress := aliceSeed.Address(0), bobSeed.Address(0)
+	tokenColor := ledgerstate.Color{1}
+
+	connector := newMockConnector(
+		newTestOutput(t, aliceAddress, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 100}), aliceAddress.Address())),
+		newTestOutput(t, bobAddress, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{tokenColor: 50}), bobAddress.Address())),
+	)
+	alice, bob := newTestWallet(aliceSeed, connector), newTestWallet(bobSeed, connector)
+
+	// alice offers 60 IOTA for 50 tokens
+	offer, err := alice.NewPartialTransaction(pledgeID, pledgeID)
+	require.NoError(t, err)
+	aliceInputIDs, err := alice.FundPartialTransaction(offer, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 60})
+	require.NoError(t, err)
+	require.Len(t, aliceInputIDs, 1)
+	require.NoError(t, offer.AddOutput(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{tokenColor: 50}), alice.ReceiveAddress().Address())))
+
+	// bob pays the tokens the offer asks for and takes the IOTA it offers
+	accepted, _, err := partialtx.FromBytes(offer.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, bob.VerifyPartialTransaction(accepted))
+	surplus, deficit := accepted.Balances()
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 60}, surplus)
+	assert.Equal(t, map[ledgerstate.Color]uint64{tokenColor: 50}, deficit)
+	bobInputIDs, err := bob.FundPartialTransaction(accepted, deficit)
+	require.NoError(t, err)
+	require.NoError(t, accepted.AddOutput(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(surplus), bob.ReceiveAddress().Address())))
+	assert.Equal(t, map[ledgerstate.Color]int64{ledgerstate.ColorIOTA: 60, tokenColor: -50}, bob.PartialTransactionBalanceChange(accepted))
+	_, err = bob.SignPartialTransaction(accepted, aliceInputIDs)
+	assert.Error(t, err)
+	signedInputs, err := bob.SignPartialTransaction(accepted, bobInputIDs)
+	require.NoError(t, err)
+	assert.Equal(t, 1, signedInputs)
+	_, err = bob.IssuePartialTransaction(accepted)
+	assert.ErrorIs(t, err, partialtx.ErrIncomplete)
+
+	// alice checks that her offer is still contained and completes the swap
+	completed, _, err := partialtx.FromBytes(accepted.Bytes())
+	require.NoError(t, err)
+	require.True(t, completed.Contains(offer))
+	require.NoError(t, alice.VerifyPartialTransaction(completed))
+	assert.Equal(t, map[ledgerstate.Color]int64{ledgerstate.ColorIOTA: -60, tokenColor: 50}, alice.PartialTransactionBalanceChange(completed))
+	signedInputs, err = alice.SignPartialTransaction(completed, aliceInputIDs)
+	require.NoError(t, err)
+	assert.Equal(t, 1, signedInputs)
+	tx, err := alice.IssuePartialTransaction(completed)
+	require.NoError(t, err)
+
+	require.Len(t, connector.sentTransactions, 1)
+	assert.Equal(t, tx.ID(), connector.sentTransactions[0].ID())
+	assert.Len(t, tx.Essence().Inputs(), 2)
+	assert.Len(t, tx.Essence().Outputs(), 3)
+	require.Len(t, alice.PendingTransactions(), 1)
+	assert.Equal(t, tx.ID(), alice.PendingTransactions()[0].Transaction.ID())
+}
+
+func TestWallet_VerifyPartialTransaction(t *testing.T) {
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	fundingOutput := newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address()))
+	wallet := newTestWallet(walletSeed, newMockConnector(fundingOutput))
+
+	// a consumed output that claims more funds than the ledger contains is rejected
+	ptx, err := wallet.NewPartialTransaction(pledgeID, pledgeID)
+	require.NoError(t, err)
+	require.NoError(t, ptx.AddInput(ledgerstate.NewSigLockedSingleOutput(1000, walletAddress.Address()).SetID(fundingOutput.Object.ID())))
+	assert.Error(t, wallet.VerifyPartialTransaction(ptx))
+
+	// a consumed output that is not in the ledger is rejected
+	unknownTxID, err := ledgerstate.TransactionIDFromRandomness()
+	require.NoError(t, err)
+	ptx, err = wallet.NewPartialTransaction(pledgeID, pledgeID)
+	require.NoError(t, err)
+	require.NoError(t, ptx.AddInput(ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address()).SetID(ledgerstate.NewOutputID(unknownTxID, 0))))
+	assert.Error(t, wallet.VerifyPartialTransaction(ptx))
+}
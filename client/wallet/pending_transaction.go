@@ -0,0 +1,279 @@
+package wallet
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region TransactionState /////////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionState is the state of a transaction issued by the wallet as seen by the wallet.
+type TransactionState uint8
+
+const (
+	// TransactionPending is the state of transactions whose branch is liked but that are not confirmed, yet.
+	TransactionPending TransactionState = iota
+	// TransactionConfirmed is the state of transactions that reached the target grade of finality of the wallet.
+	TransactionConfirmed
+	// TransactionDisliked is the state of transactions whose branch is disliked by the node.
+	TransactionDisliked
+	// TransactionRejected is the state of transactions that double spend an output with a confirmed transaction.
+	TransactionRejected
+	// TransactionExpired is the state of transactions that were not booked by the node before their validity window
+	// ended.
+	TransactionExpired
+)
+
+// expiredTransactionGracePeriod is the time the wallet waits after the end of the validity window of a transaction that
+// is unknown to the node before it considers the transaction expired, so attachments issued just in time can arrive.
+const expiredTransactionGracePeriod = time.Minute
+
+var transactionStateNames = []string{"pending", "confirmed", "disliked", "rejected", "expired"}
+
+// String returns a human readable version of the TransactionState.
+func (t TransactionState) String() string {
+	if int(t) < len(transactionStateNames) {
+		return transactionStateNames[t]
+	}
+	return "unknown"
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionStatus ////////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionStatus is the status of a transaction as reported by the Connector.
+type TransactionStatus struct {
+	// BranchID is the branch the transaction is booked in.
+	BranchID ledgerstate.BranchID
+	// GradeOfFinality is the grade of finality of the transaction.
+	GradeOfFinality gof.GradeOfFinality
+	// Liked is true if the node likes the branch of the transaction.
+	Liked bool
+	// Conflicts maps the inputs of the transaction that are also spent by other transactions to the highest grade of
+	// finality of these transactions.
+	Conflicts map[ledgerstate.OutputID]gof.GradeOfFinality
+	// Unknown is true if the node did not book the transaction.
+	Unknown bool
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PendingTransaction ///////////////////////////////////////////////////////////////////////////////////////////
+
+// PendingTransaction is a transaction issued by the wallet that is tracked until it is confirmed.
+type PendingTransaction struct {
+	// Transaction is the issued transaction.
+	Transaction *ledgerstate.Transaction
+	// ConsumedOutputs are the outputs of the wallet spent by the transaction.
+	ConsumedOutputs OutputsByAddressAndOutputID
+	// IssuingTime is the time the wallet issued the transaction.
+	IssuingTime time.Time
+	// State is the state of the transaction at the last update.
+	State TransactionState
+	// BranchID is the branch of the transaction at the last update.
+	BranchID ledgerstate.BranchID
+	// GradeOfFinality is the grade of finality of the transaction at the last update.
+	GradeOfFinality gof.GradeOfFinality
+	// Conflicts are the double spent inputs of the transaction at the last update.
+	Conflicts map[ledgerstate.OutputID]gof.GradeOfFinality
+	// RolledBack is true if the local spent state of the consumed outputs was rolled back.
+	RolledBack bool
+}
+
+// NewPendingTransaction creates a new PendingTransaction for the given transaction that was just issued.
+func NewPendingTransaction(tx *ledgerstate.Transaction, consumedOutputs OutputsByAddressAndOutputID) *PendingTransaction {
+	return &PendingTransaction{
+		Transaction:     tx,
+		ConsumedOutputs: consumedOutputs,
+		IssuingTime:     time.Now(),
+		State:           TransactionPending,
+		Conflicts:       make(map[ledgerstate.OutputID]gof.GradeOfFinality),
+	}
+}
+
+// PendingTransactionFromMarshalUtil unmarshals a PendingTransaction using a MarshalUtil (for easier unmarshaling).
+func PendingTransactionFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (pendingTx *PendingTransaction, err error) {
+	pendingTx = &PendingTransaction{
+		ConsumedOutputs: NewAddressToOutputs(),
+		Conflicts:       make(map[ledgerstate.OutputID]gof.GradeOfFinality),
+	}
+	if pendingTx.Transaction, err = ledgerstate.TransactionFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse Transaction: %w", err)
+	}
+	if pendingTx.IssuingTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse issuing time: %w", err)
+	}
+	state, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse state: %w", err)
+	}
+	pendingTx.State = TransactionState(state)
+	if pendingTx.BranchID, err = ledgerstate.BranchIDFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse BranchID: %w", err)
+	}
+	gradeOfFinality, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse grade of finality: %w", err)
+	}
+	pendingTx.GradeOfFinality = gof.GradeOfFinality(gradeOfFinality)
+	if pendingTx.RolledBack, err = marshalUtil.ReadBool(); err != nil {
+		return nil, errors.Errorf("failed to parse rolled back flag: %w", err)
+	}
+
+	outputCount, err := marshalUtil.ReadUint16()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse consumed output count: %w", err)
+	}
+	for i := uint16(0); i < outputCount; i++ {
+		output, outputErr := consumedOutputFromMarshalUtil(marshalUtil)
+		if outputErr != nil {
+			return nil, outputErr
+		}
+		if _, addressExists := pendingTx.ConsumedOutputs[output.Address]; !addressExists {
+			pendingTx.ConsumedOutputs[output.Address] = make(map[ledgerstate.OutputID]*Output)
+		}
+		pendingTx.ConsumedOutputs[output.Address][output.Object.ID()] = output
+	}
+
+	conflictCount, err := marshalUtil.ReadUint16()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse conflict count: %w", err)
+	}
+	for i := uint16(0); i < conflictCount; i++ {
+		outputID, outputIDErr := ledgerstate.OutputIDFromMarshalUtil(marshalUtil)
+		if outputIDErr != nil {
+			return nil, errors.Errorf("failed to parse conflicting OutputID: %w", outputIDErr)
+		}
+		conflictGoF, gofErr := marshalUtil.ReadUint8()
+		if gofErr != nil {
+			return nil, errors.Errorf("failed to parse grade of finality of conflict: %w", gofErr)
+		}
+		pendingTx.Conflicts[outputID] = gof.GradeOfFinality(conflictGoF)
+	}
+
+	return pendingTx, nil
+}
+
+// Bytes returns a marshaled version of the PendingTransaction.
+func (p *PendingTransaction) Bytes() []byte {
+	marshalUtil := marshalutil.New().
+		Write(p.Transaction).
+		WriteTime(p.IssuingTime).
+		WriteUint8(uint8(p.State)).
+		Write(p.BranchID).
+		WriteUint8(uint8(p.GradeOfFinality)).
+		WriteBool(p.RolledBack)
+
+	outputs := p.ConsumedOutputs.OutputsByID()
+	marshalUtil.WriteUint16(uint16(len(outputs)))
+	for _, output := range outputs {
+		marshalUtil.
+			WriteBytes(output.Address.AddressBytes[:]).
+			WriteUint64(output.Address.Index).
+			Write(output.Object.ID()).
+			Write(output.Object).
+			WriteBool(output.GradeOfFinalityReached).
+			WriteTime(output.Metadata.Timestamp)
+	}
+
+	marshalUtil.WriteUint16(uint16(len(p.Conflicts)))
+	for outputID, conflictGoF := range p.Conflicts {
+		marshalUtil.Write(outputID).WriteUint8(uint8(conflictGoF))
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// consumedOutputFromMarshalUtil unmarshals an output consumed by a PendingTransaction.
+func consumedOutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output *Output, err error) {
+	output = &Output{Spent: true}
+	addressBytes, err := marshalUtil.ReadBytes(ledgerstate.AddressLength)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse address of consumed output: %w", err)
+	}
+	copy(output.Address.AddressBytes[:], addressBytes)
+	if output.Address.Index, err = marshalUtil.ReadUint64(); err != nil {
+		return nil, errors.Errorf("failed to parse address index of consumed output: %w", err)
+	}
+	outputID, err := ledgerstate.OutputIDFromMarshalUtil(marshalUtil)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse OutputID of consumed output: %w", err)
+	}
+	if output.Object, err = ledgerstate.OutputFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse consumed output: %w", err)
+	}
+	output.Object.SetID(outputID)
+	if output.GradeOfFinalityReached, err = marshalUtil.ReadBool(); err != nil {
+		return nil, errors.Errorf("failed to parse grade of finality flag of consumed output: %w", err)
+	}
+	if output.Metadata.Timestamp, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse timestamp of consumed output: %w", err)
+	}
+
+	return output, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PendingTransactions //////////////////////////////////////////////////////////////////////////////////////////
+
+// PendingTransactionsFromBytes unmarshals the PendingTransactions exported by a wallet.
+func PendingTransactionsFromBytes(data []byte) (pendingTransactions []*PendingTransaction, err error) {
+	marshalUtil := marshalutil.New(data)
+	count, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse pending transaction count: %w", err)
+	}
+	pendingTransactions = make([]*PendingTransaction, count)
+	for i := range pendingTransactions {
+		if pendingTransactions[i], err = PendingTransactionFromMarshalUtil(marshalUtil); err != nil {
+			return nil, errors.Errorf("failed to parse pending transaction: %w", err)
+		}
+	}
+
+	return pendingTransactions, nil
+}
+
+// pendingTransactionsBytes returns a marshaled version of the given PendingTransactions.
+func pendingTransactionsBytes(pendingTransactions []*PendingTransaction) []byte {
+	marshalUtil := marshalutil.New().WriteUint32(uint32(len(pendingTransactions)))
+	for _, pendingTx := range pendingTransactions {
+		marshalUtil.Write(pendingTx)
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// ownedOutputs returns the outputs of the given transaction that belong to one of the given addresses.
+func ownedOutputs(tx *ledgerstate.Transaction, addresses []address.Address) (outputs OutputsByID) {
+	addressesByBytes := make(map[[ledgerstate.AddressLength]byte]address.Address, len(addresses))
+	for _, addr := range addresses {
+		addressesByBytes[addr.AddressBytes] = addr
+	}
+
+	outputs = make(OutputsByID)
+	for _, output := range tx.Essence().Outputs() {
+		addr, owned := addressesByBytes[output.Address().Array()]
+		if !owned {
+			continue
+		}
+		outputs[output.ID()] = &Output{
+			Address: addr,
+			Object:  output,
+			Metadata: OutputMetadata{
+				Timestamp: tx.Essence().Timestamp(),
+			},
+		}
+	}
+
+	return outputs
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
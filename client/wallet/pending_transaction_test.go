@@ -0,0 +1,207 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_UpdatePendingTransactions(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	output := newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address()))
+	connector := newMockConnector(output)
+	wallet := newTestWallet(walletSeed, connector)
+
+	// spend the output
+	consumedOutputs := wallet.outputManager.UnspentOutputs(false)
+	require.Len(t, consumedOutputs.OutputsByID(), 1)
+	tx := sendTransaction(walletSeed, output.Object.ID(), seed.NewSeed().Address(0).Address(), 100)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
+	assert.Empty(t, wallet.outputManager.UnspentOutputs(false))
+	assert.True(t, wallet.AddressManager().IsAddressSpent(0))
+
+	// the branch of the transaction is disliked
+	connector.statuses[tx.ID()] = &TransactionStatus{GradeOfFinality: gof.Low, Liked: false}
+	pendingTransactions, err := wallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	require.Len(t, pendingTransactions, 1)
+	assert.Equal(t, TransactionDisliked, pendingTransactions[0].State)
+	assert.True(t, pendingTransactions[0].RolledBack)
+	assert.Len(t, wallet.outputManager.UnspentOutputs(false).OutputsByID(), 1)
+	assert.False(t, wallet.AddressManager().IsAddressSpent(0))
+
+	// the rolled back state survives a restart of the wallet
+	restoredPendingTransactions, err := PendingTransactionsFromBytes(wallet.ExportPendingTransactions())
+	require.NoError(t, err)
+	require.Len(t, restoredPendingTransactions, 1)
+	assert.Equal(t, tx.ID(), restoredPendingTransactions[0].Transaction.ID())
+	assert.Equal(t, TransactionDisliked, restoredPendingTransactions[0].State)
+	assert.True(t, restoredPendingTransactions[0].RolledBack)
+	assert.Equal(t, consumedOutputs.OutputsByID()[output.Object.ID()].Object.Bytes(), restoredPendingTransactions[0].ConsumedOutputs.OutputsByID()[output.Object.ID()].Object.Bytes())
+
+	delete(connector.outputs[walletAddress], output.Object.ID())
+	restoredWallet := newTestWallet(walletSeed, connector, ImportPendingTransactions(restoredPendingTransactions...))
+	assert.Len(t, restoredWallet.outputManager.UnspentOutputs(false).OutputsByID(), 1)
+
+	// the branch of the transaction is liked again
+	connector.statuses[tx.ID()] = &TransactionStatus{GradeOfFinality: gof.Medium, Liked: true}
+	pendingTransactions, err = restoredWallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	assert.Equal(t, TransactionPending, pendingTransactions[0].State)
+	assert.False(t, pendingTransactions[0].RolledBack)
+	assert.Empty(t, restoredWallet.outputManager.UnspentOutputs(false))
+
+	// the transaction is confirmed
+	connector.statuses[tx.ID()] = &TransactionStatus{GradeOfFinality: gof.High, Liked: true}
+	pendingTransactions, err = restoredWallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	assert.Equal(t, TransactionConfirmed, pendingTransactions[0].State)
+	assert.Empty(t, restoredWallet.PendingTransactions())
+}
+
+func TestWallet_UpdatePendingTransactionsRejected(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	doubleSpentOutput := newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address()))
+	otherOutput := newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(50, walletAddress.Address()))
+	connector := newMockConnector(doubleSpentOutput, otherOutput)
+	wallet := newTestWallet(walletSeed, connector)
+
+	consumedOutputs := wallet.outputManager.UnspentOutputs(false)
+	tx := sendTransaction(walletSeed, doubleSpentOutput.Object.ID(), seed.NewSeed().Address(0).Address(), 150)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
+
+	// a conflicting transaction is confirmed
+	connector.statuses[tx.ID()] = &TransactionStatus{
+		GradeOfFinality: gof.Low,
+		Liked:           false,
+		Conflicts:       map[ledgerstate.OutputID]gof.GradeOfFinality{doubleSpentOutput.Object.ID(): gof.High},
+	}
+	pendingTransactions, err := wallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	assert.Equal(t, TransactionRejected, pendingTransactions[0].State)
+
+	// only the output that was not double spent is available again
+	unspentOutputs := wallet.outputManager.UnspentOutputs(false).OutputsByID()
+	assert.Len(t, unspentOutputs, 1)
+	assert.Contains(t, unspentOutputs, otherOutput.Object.ID())
+}
+
+func TestWallet_UpdatePendingTransactionsExpired(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	output := newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address()))
+	connector := newMockConnector(output)
+	wallet := newTestWallet(walletSeed, connector)
+
+	// the transaction was never booked by the node and its validity window ended a while ago
+	consumedOutputs := wallet.outputManager.UnspentOutputs(false)
+	tx := sendTransaction(walletSeed, output.Object.ID(), seed.NewSeed().Address(0).Address(), 100, time.Now().Add(-2*expiredTransactionGracePeriod))
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
+	assert.Empty(t, wallet.outputManager.UnspentOutputs(false))
+
+	pendingTransactions, err := wallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	require.Len(t, pendingTransactions, 1)
+	assert.Equal(t, TransactionExpired, pendingTransactions[0].State)
+	assert.True(t, pendingTransactions[0].RolledBack)
+	assert.Len(t, wallet.outputManager.UnspentOutputs(false).OutputsByID(), 1)
+
+	// the expired state is final
+	connector.statuses[tx.ID()] = &TransactionStatus{GradeOfFinality: gof.Low, Liked: true}
+	pendingTransactions, err = wallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	assert.Equal(t, TransactionExpired, pendingTransactions[0].State)
+}
+
+func TestWallet_SendFundsValidUntil(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	connector := newMockConnector(newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address())))
+	wallet := newTestWallet(walletSeed, connector)
+
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	validUntil := time.Now().Add(time.Hour)
+	tx, err := wallet.SendFunds(
+		sendoptions.Destination(seed.NewSeed().Address(0), 100),
+		sendoptions.ValidUntil(validUntil),
+		sendoptions.AccessManaPledgeID(pledgeID),
+		sendoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ledgerstate.ExpiringTransactionEssenceVersion, tx.Essence().Version())
+	assert.True(t, validUntil.Equal(tx.Essence().ValidUntil()))
+
+	// a transaction that is unknown to the node stays pending while it can still be booked
+	delete(connector.statuses, tx.ID())
+	pendingTransactions, err := wallet.UpdatePendingTransactions()
+	require.NoError(t, err)
+	require.Len(t, pendingTransactions, 1)
+	assert.Equal(t, TransactionPending, pendingTransactions[0].State)
+	assert.False(t, pendingTransactions[0].RolledBack)
+
+	_, err = wallet.SendFunds(
+		sendoptions.Destination(seed.NewSeed().Address(0), 100),
+		sendoptions.ValidUntil(time.Now().Add(-time.Minute)),
+	)
+	assert.Error(t, err)
+}
+
+func TestWallet_SendFundsRejectedByNode(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	connector := newMockConnector(newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(100, walletAddress.Address())))
+	wallet := newTestWallet(walletSeed, connector)
+
+	// the transaction is not tracked and its inputs can be spent again if the node does not accept it
+	connector.sendErr = errors.New("transaction rejected")
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	_, err := wallet.SendFunds(
+		sendoptions.Destination(seed.NewSeed().Address(0), 100),
+		sendoptions.AccessManaPledgeID(pledgeID),
+		sendoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	assert.ErrorIs(t, err, connector.sendErr)
+	assert.Empty(t, wallet.PendingTransactions())
+	assert.Len(t, wallet.outputManager.UnspentOutputs(false).OutputsByID(), 1)
+	assert.False(t, wallet.AddressManager().IsAddressSpent(0))
+
+	connector.sendErr = nil
+	_, err = wallet.SendFunds(
+		sendoptions.Destination(seed.NewSeed().Address(0), 100),
+		sendoptions.AccessManaPledgeID(pledgeID),
+		sendoptions.ConsensusManaPledgeID(pledgeID),
+	)
+	require.NoError(t, err)
+	assert.Len(t, wallet.PendingTransactions(), 1)
+}
+
+func sendTransaction(walletSeed *seed.Seed, outputID ledgerstate.OutputID, destination ledgerstate.Address, amount uint64, validUntil ...time.Time) *ledgerstate.Transaction {
+	timestamp := time.Now()
+	if len(validUntil) > 0 {
+		timestamp = validUntil[0].Add(-time.Minute)
+	}
+	essence := ledgerstate.NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(outputID)),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(amount, destination)),
+	)
+	if len(validUntil) > 0 {
+		essence = essence.WithValidUntil(validUntil[0])
+	}
+	keyPair := walletSeed.KeyPair(0)
+
+	return ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
+		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
+	})
+}
@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendbatchoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestWallet_SendBatch(t *testing.T) {
+	const (
+		fundingOutputCount = 200
+		paymentCount       = 300
+	)
+
+	walletSeed := seed.NewSeed()
+	walletAddress := walletSeed.Address(0)
+	fundingOutputs := make([]*Output, fundingOutputCount)
+	for i := range fundingOutputs {
+		fundingOutputs[i] = newTestOutput(t, walletAddress, ledgerstate.NewSigLockedSingleOutput(5, walletAddress.Address()))
+	}
+
+	connector := newMockConnector(fundingOutputs...)
+	wallet := newTestWallet(walletSeed, connector)
+
+	pledgeID := base58.Encode(identity.ID{}.Bytes())
+	recipients := make(map[address.Address]uint64)
+	batchOptions := []sendbatchoptions.SendBatchOption{
+		sendbatchoptions.AccessManaPledgeID(pledgeID),
+		sendbatchoptions.ConsensusManaPledgeID(pledgeID),
+	}
+	for i := 0; i < paymentCount; i++ {
+		recipient := seed.NewSeed().Address(0)
+		recipients[recipient] = uint64(i%5 + 1)
+		batchOptions = append(batchOptions, sendbatchoptions.Destination(recipient, recipients[recipient]))
+	}
+	var progress []int
+	batchOptions = append(batchOptions, sendbatchoptions.Progress(func(issued, total int, tx *ledgerstate.Transaction) {
+		assert.Equal(t, 5, total)
+		progress = append(progress, issued)
+	}))
+
+	txs, err := wallet.SendBatch(batchOptions...)
+	require.NoError(t, err)
+
+	// the funding outputs need 2 consolidations, the recipients need 3 payments (126 + 126 + 48 recipients)
+	require.Len(t, txs, 5)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, progress)
+	assert.Equal(t, txs, connector.sentTransactions)
+	assert.Len(t, txs[0].Essence().Inputs(), ledgerstate.MaxInputCount)
+	assert.Len(t, txs[1].Essence().Inputs(), fundingOutputCount-ledgerstate.MaxInputCount)
+	assert.Len(t, txs[2].Essence().Inputs(), 2)
+
+	// every payment spends the remainder of its predecessor
+	for i := 3; i < len(txs); i++ {
+		require.Len(t, txs[i].Essence().Inputs(), 1)
+		assert.Equal(t, txs[i-1].ID(), txs[i].Essence().Inputs()[0].(*ledgerstate.UTXOInput).ReferencedOutputID().TransactionID())
+	}
+
+	// every recipient is paid exactly once
+	paid := make(map[[ledgerstate.AddressLength]byte]uint64)
+	for _, tx := range txs[2:] {
+		for _, output := range tx.Essence().Outputs() {
+			balance, _ := output.Balances().Get(ledgerstate.ColorIOTA)
+			paid[output.Address().Array()] += balance
+		}
+	}
+	for recipient, amount := range recipients {
+		assert.Equal(t, amount, paid[recipient.AddressBytes])
+	}
+	assert.Len(t, wallet.PendingTransactions(), 5)
+}
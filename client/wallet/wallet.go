@@ -1,7 +1,9 @@
 package wallet
 
 import (
+	"bytes"
 	"reflect"
+	"sort"
 	"time"
 	"unsafe"
 
@@ -9,17 +11,26 @@ import (
 	"github.com/iotaledger/hive.go/bitmask"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/mr-tron/base58"
 	"golang.org/x/crypto/blake2b"
 
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/burntokensoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/claimconditionaloptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/claimhtlcoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/consolidateoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createfoundryoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createhtlcoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/createnftoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/delegateoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/deposittonftoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/destroynftoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/minttokensoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/partialtx"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/reclaimoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/refundhtlcoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendbatchoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sendoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sweepnftownednftsoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sweepnftownedoptions"
@@ -44,6 +55,9 @@ const (
 // ErrTooManyOutputs is an error returned when the number of outputs/inputs exceeds the protocol wide constant.
 var ErrTooManyOutputs = errors.New("number of outputs is more, than supported for a single transaction")
 
+// ErrPreimageNotRevealed is returned if the preimage of a hash time lock was not revealed, yet.
+var ErrPreimageNotRevealed = errors.New("preimage not revealed")
+
 // Wallet is a wallet that can handle aliases and extendedlockedoutputs.
 type Wallet struct {
 	addressManager *AddressManager
@@ -51,18 +65,28 @@ type Wallet struct {
 	outputManager  *OutputManager
 	connector      Connector
 
+	// pendingTransactions contains the issued transactions that are tracked until they are confirmed.
+	pendingTransactions map[ledgerstate.TransactionID]*PendingTransaction
+
+	// externalSigner signs the transactions of watch-only wallets.
+	externalSigner Signer
+
 	faucetPowDifficulty int
 	// if this option is enabled the wallet will use a single reusable address instead of changing addresses.
 	reusableAddress          bool
 	ConfirmationPollInterval time.Duration
 	ConfirmationTimeout      time.Duration
+	// ConfirmationTargetGoF is the grade of finality a transaction needs to reach to be considered confirmed.
+	ConfirmationTargetGoF gof.GradeOfFinality
 }
 
 // New is the factory method of the wallet. It either creates a new wallet or restores the wallet backup that is handed
 // in as an optional parameter.
 func New(options ...Option) (wallet *Wallet) {
 	// create wallet
-	wallet = &Wallet{}
+	wallet = &Wallet{
+		pendingTransactions: make(map[ledgerstate.TransactionID]*PendingTransaction),
+	}
 
 	// configure wallet
 	for _, option := range options {
@@ -77,11 +101,20 @@ func New(options ...Option) (wallet *Wallet) {
 		wallet.ConfirmationTimeout = DefaultConfirmationTimeout
 	}
 
+	if wallet.ConfirmationTargetGoF == gof.None {
+		wallet.ConfirmationTargetGoF = gof.High
+	}
+
 	// initialize wallet with default address manager if we did not import a previous wallet
 	if wallet.addressManager == nil {
 		wallet.addressManager = NewAddressManager(seed.NewSeed(), 0, []bitmask.BitMask{})
 	}
 
+	// watch-only wallets hand their transactions to the external signer (if one was provided)
+	if keychain, watchOnly := wallet.addressManager.keychain.(*watchOnlyKeychain); watchOnly {
+		keychain.signer = wallet.externalSigner
+	}
+
 	// initialize asset registry if none was provided in the options.
 	if wallet.assetRegistry == nil {
 		wallet.assetRegistry = NewAssetRegistry(DefaultAssetRegistryNetwork)
@@ -99,6 +132,9 @@ func New(options ...Option) (wallet *Wallet) {
 		panic(err)
 	}
 
+	// restore the local spent state of the imported pending transactions
+	wallet.restorePendingTransactions()
+
 	return
 }
 
@@ -113,10 +149,19 @@ func (wallet *Wallet) SendFunds(options ...sendoptions.SendFundsOption) (tx *led
 		return
 	}
 
+	// the node refuses transfers that create outputs holding less than the minimum deposit
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+	if err = checkDestinationDeposits(sendOptions.Destinations, minimumOutputDeposit); err != nil {
+		return
+	}
+
 	// how much funds will we need to fund this transfer?
 	requiredFunds := sendOptions.RequiredFunds()
 	// collect that many outputs for funding
-	consumedOutputs, err := wallet.collectOutputsForFunding(requiredFunds, sendOptions.UsePendingOutputs)
+	consumedOutputs, err := wallet.collectOutputsForTransfer(requiredFunds, minimumOutputDeposit, sendOptions.UsePendingOutputs)
 	if err != nil {
 		if errors.Is(err, ErrTooManyOutputs) {
 			err = errors.Errorf("consolidate funds and try again: %w", err)
@@ -138,9 +183,15 @@ func (wallet *Wallet) SendFunds(options ...sendoptions.SendFundsOption) (tx *led
 	outputs := wallet.buildOutputs(sendOptions, totalConsumedFunds, remainderAddress)
 
 	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
+	if !sendOptions.ValidUntil.IsZero() {
+		txEssence = txEssence.WithValidUntil(sendOptions.ValidUntil)
+	}
 	outputsByID := consumedOutputs.OutputsByID()
 
-	unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	unlockBlocks, inputsAsOutputsInOrder, err := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	if err != nil {
+		return
+	}
 
 	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
 
@@ -159,9 +210,9 @@ func (wallet *Wallet) SendFunds(options ...sendoptions.SendFundsOption) (tx *led
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(consumedOutputs)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +272,10 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 		txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 		outputsByID := consumedOutputs.OutputsByID()
 
-		unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+		unlockBlocks, inputsAsOutputsInOrder, bErr := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+		if bErr != nil {
+			return nil, bErr
+		}
 
 		tx := ledgerstate.NewTransaction(txEssence, unlockBlocks)
 
@@ -240,8 +294,8 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 			return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 		}
 
-		wallet.markOutputsAndAddressesSpent(consumedOutputs)
-		err = wallet.connector.SendTransaction(tx)
+		wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
+		err = wallet.issueTransaction(tx)
 		if err != nil {
 			return nil, err
 		}
@@ -259,6 +313,202 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region SendBatch ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SendBatch pays all the payments of the batch. Payments that do not fit into a single transaction are split across
+// several chained transactions, each of them spending the remainder of its predecessor. If the funds of the wallet are
+// spread across more outputs than fit into a transaction, they are consolidated first. The returned transactions are
+// ordered as they were issued; if the batch fails midway, the transactions that were issued so far are returned with
+// the error.
+func (wallet *Wallet) SendBatch(options ...sendbatchoptions.SendBatchOption) (txs []*ledgerstate.Transaction, err error) {
+	batchOptions, err := sendbatchoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	// determine pledgeIDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(batchOptions.AccessManaPledgeID, batchOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	// every transaction pays as many addresses as possible and keeps one output for the remainder
+	destinationChunks := batchOptions.DestinationChunks(ledgerstate.MaxOutputCount - 1)
+
+	// the node refuses transfers that create outputs holding less than the minimum deposit
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+	for _, destinations := range destinationChunks {
+		if err = checkDestinationDeposits(destinations, minimumOutputDeposit); err != nil {
+			return
+		}
+	}
+
+	// collect the outputs funding the whole batch, even if they do not fit into a single transaction
+	consumedOutputs, err := wallet.collectOutputsForTransfer(batchOptions.RequiredFunds(), minimumOutputDeposit, batchOptions.UsePendingOutputs)
+	if err != nil && !errors.Is(err, ErrTooManyOutputs) {
+		return
+	}
+	err = nil
+
+	totalTransactions := batchConsolidationCount(consumedOutputs.OutputCount()) + len(destinationChunks)
+
+	issue := func(consumedOutputs OutputsByAddressAndOutputID, outputs ledgerstate.Outputs) (tx *ledgerstate.Transaction, issueErr error) {
+		// the inputs of chained transactions need to be booked by the node
+		if len(txs) > 0 {
+			if issueErr = wallet.waitForTransactionBooked(txs[len(txs)-1].ID()); issueErr != nil {
+				return nil, issueErr
+			}
+		}
+
+		if tx, issueErr = wallet.issueBatchTransaction(consumedOutputs, outputs, aPledgeID, cPledgeID); issueErr != nil {
+			return nil, issueErr
+		}
+		txs = append(txs, tx)
+		if batchOptions.Progress != nil {
+			batchOptions.Progress(len(txs), totalTransactions, tx)
+		}
+
+		return tx, nil
+	}
+
+	// consolidate the funding outputs until they fit into the first payment
+	for consumedOutputs.OutputCount() > ledgerstate.MaxInputCount {
+		consolidatedOutputs := NewAddressToOutputs()
+		for _, chunk := range consumedOutputs.SplitIntoChunksOfMaxInputCount() {
+			if chunk.OutputCount() == 0 {
+				continue
+			}
+
+			toAddress := wallet.chooseToAddress(chunk, address.AddressEmpty)
+			consolidatedOutput := ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(chunk.TotalFundsInOutputs()), toAddress.Address())
+			tx, issueErr := issue(chunk, ledgerstate.NewOutputs(consolidatedOutput))
+			if issueErr != nil {
+				return txs, errors.Errorf("batch stopped after %d of %d transactions: %w", len(txs), totalTransactions, issueErr)
+			}
+			addBatchOutput(consolidatedOutputs, tx, toAddress, consolidatedOutput)
+		}
+		consumedOutputs = consolidatedOutputs
+	}
+
+	// pay the destinations, chaining the transactions through their remainders
+	for i, destinations := range destinationChunks {
+		remainderAddress := wallet.chooseRemainderAddress(consumedOutputs, address.AddressEmpty)
+		if i == len(destinationChunks)-1 {
+			remainderAddress = wallet.chooseRemainderAddress(consumedOutputs, batchOptions.RemainderAddress)
+		}
+
+		remainingFunds := consumedOutputs.TotalFundsInOutputs()
+		outputs := wallet.buildOutputs(&sendoptions.SendFundsOptions{Destinations: destinations}, remainingFunds, remainderAddress)
+		tx, issueErr := issue(consumedOutputs, outputs)
+		if issueErr != nil {
+			return txs, errors.Errorf("batch stopped after %d of %d transactions: %w", len(txs), totalTransactions, issueErr)
+		}
+
+		consumedOutputs = NewAddressToOutputs()
+		if len(remainingFunds) != 0 {
+			addBatchOutput(consumedOutputs, tx, remainderAddress, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(remainingFunds), remainderAddress.Address()))
+		} else if i < len(destinationChunks)-1 {
+			return txs, errors.Errorf("batch ran out of funds after %d of %d transactions", len(txs), totalTransactions)
+		}
+	}
+
+	if batchOptions.WaitForConfirmation {
+		err = wallet.WaitForTxConfirmation(txs[len(txs)-1].ID())
+	}
+
+	return txs, err
+}
+
+// issueBatchTransaction signs and issues a transaction of a batch that spends the given outputs.
+func (wallet *Wallet) issueBatchTransaction(consumedOutputs OutputsByAddressAndOutputID, outputs ledgerstate.Outputs, aPledgeID, cPledgeID identity.ID) (tx *ledgerstate.Transaction, err error) {
+	inputs := wallet.buildInputs(consumedOutputs)
+	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
+
+	unlockBlocks, inputsAsOutputsInOrder, err := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	if err != nil {
+		return
+	}
+
+	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
+
+	// check syntactical validity by marshaling an unmarshaling
+	tx, _, err = ledgerstate.TransactionFromBytes(tx.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// check tx validity (balances, unlock blocks)
+	ok, err := checkBalancesAndUnlocks(inputsAsOutputsInOrder, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
+	}
+
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
+
+	if err = wallet.issueTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// waitForTransactionBooked waits until the node knows the given transaction, so its outputs can be spent.
+func (wallet *Wallet) waitForTransactionBooked(txID ledgerstate.TransactionID) (err error) {
+	timeoutCounter := time.Duration(0)
+	for {
+		if _, err = wallet.connector.GetTransactionGoF(txID); err == nil {
+			return nil
+		}
+		if timeoutCounter > wallet.ConfirmationTimeout {
+			return errors.Errorf("transaction %s was not booked within %d seconds: %w", txID.Base58(), wallet.ConfirmationTimeout/time.Second, err)
+		}
+		time.Sleep(wallet.ConfirmationPollInterval)
+		timeoutCounter += wallet.ConfirmationPollInterval
+	}
+}
+
+// addBatchOutput adds the given output of a batch transaction to the outputs spent by the next transaction of the
+// batch.
+func addBatchOutput(outputs OutputsByAddressAndOutputID, tx *ledgerstate.Transaction, addr address.Address, expectedOutput ledgerstate.Output) {
+	for _, output := range tx.Essence().Outputs() {
+		if !bytes.Equal(output.Bytes(), expectedOutput.Bytes()) {
+			continue
+		}
+
+		if _, addressExists := outputs[addr]; !addressExists {
+			outputs[addr] = make(map[ledgerstate.OutputID]*Output)
+		}
+		outputs[addr][output.ID()] = &Output{
+			Address: addr,
+			Object:  output,
+			Metadata: OutputMetadata{
+				Timestamp: tx.Essence().Timestamp(),
+			},
+		}
+
+		return
+	}
+}
+
+// batchConsolidationCount returns the number of transactions that are needed to consolidate the given number of
+// outputs until they fit into a single transaction.
+func batchConsolidationCount(outputCount int) (transactionCount int) {
+	for outputCount > ledgerstate.MaxInputCount {
+		outputCount = (outputCount + ledgerstate.MaxInputCount - 1) / ledgerstate.MaxInputCount
+		transactionCount += outputCount
+	}
+
+	return transactionCount
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region ClaimConditionalFunds ////////////////////////////////////////////////////////////////////////////////////////
 
 // ClaimConditionalFunds gathers all currently conditionally owned outputs and consolidates them into the output.
@@ -298,7 +548,10 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 	outputsByID := consumedOutputs.OutputsByID()
 
-	unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	unlockBlocks, inputsAsOutputsInOrder, err := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	if err != nil {
+		return
+	}
 
 	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
 
@@ -317,9 +570,9 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(consumedOutputs)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +584,389 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 
 // endregion //////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region CreateHashTimeLock ///////////////////////////////////////////////////////////////////////////////////////////
+
+// CreateHashTimeLock locks funds in a HashTimeLockedOutput that the destination can claim by revealing the preimage of
+// the hash lock until the deadline. After the deadline the funds can be refunded to the wallet. It returns the ID of
+// the created output that the counterparty of a swap needs to claim the funds.
+func (wallet *Wallet) CreateHashTimeLock(options ...createhtlcoptions.CreateHashTimeLockOption) (tx *ledgerstate.Transaction, outputID ledgerstate.OutputID, err error) {
+	createOptions, err := createhtlcoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	// collect outputs for funding the hash time lock
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+	consumedOutputs, err := wallet.collectOutputsForTransfer(createOptions.RequiredFunds(), minimumOutputDeposit, createOptions.UsePendingOutputs)
+	if err != nil {
+		if errors.Is(err, ErrTooManyOutputs) {
+			err = errors.Errorf("consolidate funds and try again: %w", err)
+		}
+		return
+	}
+
+	// determine pledgeIDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(createOptions.AccessManaPledgeID, createOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	refundAddress := createOptions.RefundAddress
+	if refundAddress == address.AddressEmpty {
+		refundAddress = wallet.NewReceiveAddress()
+	}
+	hashTimeLockedOutput := ledgerstate.NewHashTimeLockedOutput(createOptions.Balances, createOptions.DestinationAddress,
+		*createOptions.HashLock, createOptions.Deadline, refundAddress.Address())
+
+	// put the remaining funds on the remainder address
+	remainingFunds := consumedOutputs.TotalFundsInOutputs()
+	for color, amount := range createOptions.RequiredFunds() {
+		remainingFunds[color] -= amount
+		if remainingFunds[color] == 0 {
+			delete(remainingFunds, color)
+		}
+	}
+	outputsSlice := []ledgerstate.Output{hashTimeLockedOutput}
+	if len(remainingFunds) != 0 {
+		remainderAddress := wallet.chooseRemainderAddress(consumedOutputs, createOptions.RemainderAddress)
+		outputsSlice = append(outputsSlice, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(remainingFunds), remainderAddress.Address()))
+	}
+
+	inputs := wallet.buildInputs(consumedOutputs)
+	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, ledgerstate.NewOutputs(outputsSlice...))
+	unlockBlocks, inputsAsOutputsInOrder, err := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	if err != nil {
+		return
+	}
+
+	tx, err = wallet.checkAndIssueTransaction(ledgerstate.NewTransaction(txEssence, unlockBlocks), inputsAsOutputsInOrder, consumedOutputs, createOptions.WaitForConfirmation)
+	if err != nil {
+		return
+	}
+
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() == ledgerstate.HashTimeLockedOutputType {
+			outputID = output.ID()
+		}
+	}
+
+	return tx, outputID, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ClaimHashTimeLock ////////////////////////////////////////////////////////////////////////////////////////////
+
+// ClaimHashTimeLock claims the funds of a HashTimeLockedOutput sent to the wallet by revealing the preimage of its hash
+// lock. The revealed preimage allows the counterparty of the swap to claim the funds on its side.
+func (wallet *Wallet) ClaimHashTimeLock(options ...claimhtlcoptions.ClaimHashTimeLockOption) (tx *ledgerstate.Transaction, err error) {
+	claimOptions, err := claimhtlcoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	consumedOutputs, walletAddress, hashTimeLockedOutput, err := wallet.unspentHashTimeLockedOutput(claimOptions.OutputID, false)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	if hashTimeLockedOutput.Expired(now) {
+		err = errors.Errorf("hash time lock %s expired at %s and can only be refunded", claimOptions.OutputID.Base58(), hashTimeLockedOutput.Deadline().String())
+		return
+	}
+	if !hashTimeLockedOutput.HashLock().UnlockedBy(*claimOptions.Preimage) {
+		err = errors.Errorf("preimage does not unlock %s", hashTimeLockedOutput.HashLock().String())
+		return
+	}
+
+	// determine pledgeIDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(claimOptions.AccessManaPledgeID, claimOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	toAddress := wallet.chooseToAddress(consumedOutputs, claimOptions.ToAddress)
+	outputs := ledgerstate.NewOutputs(ledgerstate.NewSigLockedColoredOutput(hashTimeLockedOutput.Balances(), toAddress.Address()))
+	txEssence := ledgerstate.NewTransactionEssence(0, now, aPledgeID, cPledgeID, wallet.buildInputs(consumedOutputs), outputs)
+
+	signature, err := wallet.addressManager.keychain.Sign(walletAddress, txEssence.Bytes())
+	if err != nil {
+		err = errors.Errorf("failed to sign input with address %s: %w", walletAddress.Base58(), err)
+		return
+	}
+	unlockBlocks := ledgerstate.UnlockBlocks{ledgerstate.NewHashLockUnlockBlock(signature, *claimOptions.Preimage)}
+
+	return wallet.checkAndIssueTransaction(ledgerstate.NewTransaction(txEssence, unlockBlocks), ledgerstate.Outputs{hashTimeLockedOutput}, consumedOutputs, claimOptions.WaitForConfirmation)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RefundHashTimeLock ///////////////////////////////////////////////////////////////////////////////////////////
+
+// RefundHashTimeLock takes back the funds of an expired HashTimeLockedOutput created by the wallet.
+func (wallet *Wallet) RefundHashTimeLock(options ...refundhtlcoptions.RefundHashTimeLockOption) (tx *ledgerstate.Transaction, err error) {
+	refundOptions, err := refundhtlcoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	consumedOutputs, _, hashTimeLockedOutput, err := wallet.unspentHashTimeLockedOutput(refundOptions.OutputID, true)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	if !hashTimeLockedOutput.Expired(now) {
+		err = errors.Errorf("hash time lock %s can not be refunded before %s", refundOptions.OutputID.Base58(), hashTimeLockedOutput.Deadline().String())
+		return
+	}
+
+	// determine pledgeIDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(refundOptions.AccessManaPledgeID, refundOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	toAddress := wallet.chooseToAddress(consumedOutputs, refundOptions.ToAddress)
+	outputs := ledgerstate.NewOutputs(ledgerstate.NewSigLockedColoredOutput(hashTimeLockedOutput.Balances(), toAddress.Address()))
+	inputs := wallet.buildInputs(consumedOutputs)
+	txEssence := ledgerstate.NewTransactionEssence(0, now, aPledgeID, cPledgeID, inputs, outputs)
+	unlockBlocks, inputsAsOutputsInOrder, err := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	if err != nil {
+		return
+	}
+
+	return wallet.checkAndIssueTransaction(ledgerstate.NewTransaction(txEssence, unlockBlocks), inputsAsOutputsInOrder, consumedOutputs, refundOptions.WaitForConfirmation)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RevealedPreimage /////////////////////////////////////////////////////////////////////////////////////////////
+
+// RevealedPreimage returns the preimage that was revealed by the transaction claiming the HashTimeLockedOutput with the
+// given ID. The sender of a swap uses it to claim the funds that were locked with the same hash lock on its side.
+func (wallet *Wallet) RevealedPreimage(outputID ledgerstate.OutputID, hashLock ledgerstate.HashLock) (preimage ledgerstate.Preimage, err error) {
+	preimages, err := wallet.connector.GetRevealedPreimages(outputID)
+	if err != nil {
+		return
+	}
+	for _, revealedPreimage := range preimages {
+		if hashLock.UnlockedBy(revealedPreimage) {
+			return revealedPreimage, nil
+		}
+	}
+
+	return preimage, errors.Errorf("no preimage of %s was revealed for output %s: %w", hashLock.String(), outputID.Base58(), ErrPreimageNotRevealed)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PartialTransaction ///////////////////////////////////////////////////////////////////////////////////////////
+
+// NewPartialTransaction creates an empty partially signed transaction that several parties can contribute inputs and
+// outputs to. Its essence is timestamped now, so it has to be issued before the node stops accepting the timestamp.
+func (wallet *Wallet) NewPartialTransaction(accessManaPledgeID, consensusManaPledgeID string) (ptx *partialtx.Transaction, err error) {
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(accessManaPledgeID, consensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	return partialtx.New(time.Now(), aPledgeID, cPledgeID), nil
+}
+
+// FundPartialTransaction adds inputs of the wallet that cover the given funds to the partially signed transaction and
+// an output that returns the remaining funds of the inputs to the wallet. It returns the IDs of the consumed outputs,
+// which are the inputs that the wallet signs later on. The consumed outputs are only marked as spent once the wallet
+// issues the transaction.
+func (wallet *Wallet) FundPartialTransaction(ptx *partialtx.Transaction, funds map[ledgerstate.Color]uint64, usePendingOutputs ...bool) (inputIDs []ledgerstate.OutputID, err error) {
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+	consumedOutputs, err := wallet.collectOutputsForTransfer(funds, minimumOutputDeposit, len(usePendingOutputs) > 0 && usePendingOutputs[0])
+	if err != nil {
+		if errors.Is(err, ErrTooManyOutputs) {
+			err = errors.Errorf("consolidate funds and try again: %w", err)
+		}
+		return
+	}
+
+	for outputID, output := range consumedOutputs.OutputsByID() {
+		if err = ptx.AddInput(output.Object); err != nil {
+			return
+		}
+		inputIDs = append(inputIDs, outputID)
+	}
+
+	remainingFunds := consumedOutputs.TotalFundsInOutputs()
+	for color, amount := range funds {
+		remainingFunds[color] -= amount
+		if remainingFunds[color] == 0 {
+			delete(remainingFunds, color)
+		}
+	}
+	if len(remainingFunds) != 0 {
+		remainderAddress := wallet.chooseRemainderAddress(consumedOutputs, address.AddressEmpty)
+		err = ptx.AddOutput(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(remainingFunds), remainderAddress.Address()))
+	}
+
+	return
+}
+
+// VerifyPartialTransaction checks that the outputs consumed by the partially signed transaction are unspent outputs of
+// the ledger. The consumed outputs are embedded in the partially signed transaction by the other parties, so they have
+// to be checked against the node before the balances of the transaction can be trusted.
+func (wallet *Wallet) VerifyPartialTransaction(ptx *partialtx.Transaction) (err error) {
+	addresses := make(map[[ledgerstate.AddressLength]byte]address.Address)
+	for _, walletAddress := range wallet.addressManager.Addresses() {
+		addresses[walletAddress.AddressBytes] = walletAddress
+	}
+	queriedAddresses := make([]address.Address, 0)
+	queried := make(map[[ledgerstate.AddressLength]byte]bool)
+	for _, input := range ptx.Inputs() {
+		addressBytes := input.Address().Array()
+		if queried[addressBytes] {
+			continue
+		}
+		queried[addressBytes] = true
+
+		queriedAddress, isWalletAddress := addresses[addressBytes]
+		if !isWalletAddress {
+			queriedAddress = address.Address{AddressBytes: addressBytes}
+		}
+		queriedAddresses = append(queriedAddresses, queriedAddress)
+	}
+
+	unspentOutputs, err := wallet.connector.UnspentOutputs(queriedAddresses...)
+	if err != nil {
+		return errors.Errorf("failed to query the consumed outputs: %w", err)
+	}
+	ledgerOutputs := unspentOutputs.OutputsByID()
+	for _, input := range ptx.Inputs() {
+		ledgerOutput, exists := ledgerOutputs[input.ID()]
+		if !exists {
+			return errors.Errorf("output %s is not an unspent output of the ledger", input.ID().Base58())
+		}
+		if !bytes.Equal(ledgerOutput.Object.Bytes(), input.Bytes()) {
+			return errors.Errorf("output %s does not match the output in the ledger", input.ID().Base58())
+		}
+	}
+
+	return nil
+}
+
+// PartialTransactionBalanceChange returns how the balances of the wallet change if the partially signed transaction is
+// issued, i.e. the funds of its outputs to addresses of the wallet minus the funds of its inputs that the wallet
+// controls.
+func (wallet *Wallet) PartialTransactionBalanceChange(ptx *partialtx.Transaction) (balanceChange map[ledgerstate.Color]int64) {
+	walletAddresses := make(map[[ledgerstate.AddressLength]byte]bool)
+	for _, walletAddress := range wallet.addressManager.Addresses() {
+		walletAddresses[walletAddress.AddressBytes] = true
+	}
+
+	balanceChange = make(map[ledgerstate.Color]int64)
+	for _, input := range ptx.Inputs() {
+		unlockAddress, err := ptx.UnlockAddress(input.ID())
+		if err != nil || !walletAddresses[unlockAddress.Array()] {
+			continue
+		}
+		input.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			balanceChange[color] -= int64(balance)
+			return true
+		})
+	}
+	for _, output := range ptx.Outputs() {
+		if !walletAddresses[output.Address().Array()] {
+			continue
+		}
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			if color == ledgerstate.ColorMint {
+				color = ledgerstate.ColorIOTA
+			}
+			balanceChange[color] += int64(balance)
+			return true
+		})
+	}
+	for color, balance := range balanceChange {
+		if balance == 0 {
+			delete(balanceChange, color)
+		}
+	}
+
+	return balanceChange
+}
+
+// SignPartialTransaction signs the inputs of the partially signed transaction that consume the outputs with the given
+// IDs, i.e. the inputs that the wallet added to the transaction, and returns how many of them it signed. Inputs that
+// other parties added are never signed, even if the wallet controls them. Signing seals the essence of the transaction.
+func (wallet *Wallet) SignPartialTransaction(ptx *partialtx.Transaction, inputIDs []ledgerstate.OutputID) (signedInputs int, err error) {
+	walletAddresses := make(map[[ledgerstate.AddressLength]byte]address.Address)
+	for _, walletAddress := range wallet.addressManager.Addresses() {
+		walletAddresses[walletAddress.AddressBytes] = walletAddress
+	}
+
+	essenceBytes := ptx.Essence().Bytes()
+	signatures := make(map[address.Address]ledgerstate.Signature)
+	for _, outputID := range inputIDs {
+		if ptx.Signed(outputID) {
+			continue
+		}
+		unlockAddress, unlockAddressErr := ptx.UnlockAddress(outputID)
+		if unlockAddressErr != nil {
+			err = errors.Errorf("failed to sign input %s: %w", outputID.Base58(), unlockAddressErr)
+			return
+		}
+		walletAddress, controlled := walletAddresses[unlockAddress.Array()]
+		if !controlled {
+			err = errors.Errorf("input %s is not controlled by the wallet", outputID.Base58())
+			return
+		}
+
+		signature, signed := signatures[walletAddress]
+		if !signed {
+			if signature, err = wallet.addressManager.keychain.Sign(walletAddress, essenceBytes); err != nil {
+				err = errors.Errorf("failed to sign input with address %s: %w", walletAddress.Base58(), err)
+				return
+			}
+			signatures[walletAddress] = signature
+		}
+		if err = ptx.AddSignature(outputID, signature); err != nil {
+			return
+		}
+		signedInputs++
+	}
+
+	return
+}
+
+// IssuePartialTransaction issues the partially signed transaction once all of its inputs are signed.
+func (wallet *Wallet) IssuePartialTransaction(ptx *partialtx.Transaction, waitForConfirmation ...bool) (tx *ledgerstate.Transaction, err error) {
+	if tx, err = ptx.Transaction(); err != nil {
+		return
+	}
+
+	_ = wallet.outputManager.Refresh()
+	inputsByID := ledgerstate.NewOutputsByID(ptx.Inputs()...)
+	consumedOutputs := NewAddressToOutputs()
+	for addr, outputs := range wallet.outputManager.UnspentOutputs(true, wallet.addressManager.Addresses()...) {
+		for outputID, output := range outputs {
+			if _, consumed := inputsByID[outputID]; !consumed {
+				continue
+			}
+			if _, addressExists := consumedOutputs[addr]; !addressExists {
+				consumedOutputs[addr] = make(map[ledgerstate.OutputID]*Output)
+			}
+			consumedOutputs[addr][outputID] = output
+		}
+	}
+
+	return wallet.checkAndIssueTransaction(tx, ptx.Inputs(), consumedOutputs, len(waitForConfirmation) > 0 && waitForConfirmation[0])
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region CreateAsset //////////////////////////////////////////////////////////////////////////////////////////////////
 
 // CreateAsset creates a new colored token with the given details.
@@ -348,7 +984,11 @@ func (wallet *Wallet) CreateAsset(asset Asset, waitForConfirmation ...bool) (ass
 	}
 
 	// where will we spend from?
-	consumedOutputs, err := wallet.collectOutputsForFunding(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: asset.Supply}, false)
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+	consumedOutputs, err := wallet.collectOutputsForTransfer(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: asset.Supply}, minimumOutputDeposit, false)
 	if err != nil {
 		if errors.Is(err, ErrTooManyOutputs) {
 			err = errors.Errorf("consolidate funds and try again: %w", err)
@@ -408,10 +1048,16 @@ func (wallet *Wallet) DelegateFunds(options ...delegateoptions.DelegateFundsOpti
 		return
 	}
 
+	// the node refuses delegations that create outputs holding less than the minimum deposit
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+
 	// how much funds will we need to fund this transfer?
 	requiredFunds := delegateOptions.RequiredFunds()
 	// collect that many outputs for funding
-	consumedOutputs, err := wallet.collectOutputsForFunding(requiredFunds, false)
+	consumedOutputs, err := wallet.collectOutputsForTransfer(requiredFunds, minimumOutputDeposit, false)
 	if err != nil {
 		if errors.Is(err, ErrTooManyOutputs) {
 			err = errors.Errorf("consolidate funds and try again: %w", err)
@@ -468,7 +1114,10 @@ func (wallet *Wallet) DelegateFunds(options ...delegateoptions.DelegateFundsOpti
 	outputs := ledgerstate.NewOutputs(unsortedOutputs...)
 	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, outputs)
 	outputsByID := consumedOutputs.OutputsByID()
-	unlockBlocks, inputsAsOutputsInOrder := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	unlockBlocks, inputsAsOutputsInOrder, err := wallet.buildUnlockBlocks(inputs, outputsByID, txEssence)
+	if err != nil {
+		return
+	}
 	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
 
 	// check syntactical validity by marshaling an unmarshaling
@@ -496,9 +1145,9 @@ func (wallet *Wallet) DelegateFunds(options ...delegateoptions.DelegateFundsOpti
 		}
 	}
 
-	wallet.markOutputsAndAddressesSpent(consumedOutputs)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return
 	}
@@ -594,7 +1243,10 @@ func (wallet *Wallet) CreateNFT(options ...createnftoptions.CreateNFTOption) (tx
 	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), accessPledgeNodeID, consensusPledgeNodeID, inputs, outputs)
 
 	// build unlock blocks
-	unlockBlocks, inputsInOrder := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	unlockBlocks, inputsInOrder, err := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	if err != nil {
+		return
+	}
 
 	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
 
@@ -621,9 +1273,9 @@ func (wallet *Wallet) CreateNFT(options ...createnftoptions.CreateNFTOption) (tx
 		}
 	}
 
-	wallet.markOutputsAndAddressesSpent(consumedOutputs)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -714,10 +1366,11 @@ func (wallet *Wallet) TransferNFT(options ...transfernftoptions.TransferNFTOptio
 		ledgerstate.NewOutputs(nextAlias),
 	)
 	// there is only one input, so signing is easy
-	keyPair := wallet.Seed().KeyPair(walletAlias.Address.Index)
-	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
-		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
-	})
+	signature, err := wallet.addressManager.keychain.Sign(walletAlias.Address, essence.Bytes())
+	if err != nil {
+		return
+	}
+	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(signature)})
 
 	// check syntactical validity by marshaling an unmarshaling
 	tx, _, err = ledgerstate.TransactionFromBytes(tx.Bytes())
@@ -734,11 +1387,11 @@ func (wallet *Wallet) TransferNFT(options ...transfernftoptions.TransferNFTOptio
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(OutputsByAddressAndOutputID{walletAlias.Address: {
+	wallet.markOutputsAndAddressesSpent(tx, OutputsByAddressAndOutputID{walletAlias.Address: {
 		walletAlias.Object.ID(): walletAlias,
 	}})
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -810,10 +1463,11 @@ func (wallet *Wallet) DestroyNFT(options ...destroynftoptions.DestroyNFTOption)
 		ledgerstate.NewInputs(inputs...), ledgerstate.NewOutputs(outputs...))
 
 	// there is only one input, so signing is easy
-	keyPair := wallet.Seed().KeyPair(walletAlias.Address.Index)
-	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
-		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
-	})
+	signature, err := wallet.addressManager.keychain.Sign(walletAlias.Address, essence.Bytes())
+	if err != nil {
+		return
+	}
+	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(signature)})
 
 	// check syntactical validity by marshaling an unmarshaling
 	tx, _, err = ledgerstate.TransactionFromBytes(tx.Bytes())
@@ -830,11 +1484,11 @@ func (wallet *Wallet) DestroyNFT(options ...destroynftoptions.DestroyNFTOption)
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(OutputsByAddressAndOutputID{walletAlias.Address: {
+	wallet.markOutputsAndAddressesSpent(tx, OutputsByAddressAndOutputID{walletAlias.Address: {
 		walletAlias.Object.ID(): walletAlias,
 	}})
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -922,10 +1576,11 @@ func (wallet *Wallet) WithdrawFundsFromNFT(options ...withdrawfromnftoptions.Wit
 		ledgerstate.NewInputs(inputs...), ledgerstate.NewOutputs(outputs...))
 
 	// there is only one input, so signing is easy
-	keyPair := wallet.Seed().KeyPair(walletAlias.Address.Index)
-	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
-		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
-	})
+	signature, err := wallet.addressManager.keychain.Sign(walletAlias.Address, essence.Bytes())
+	if err != nil {
+		return
+	}
+	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(signature)})
 
 	// check syntactical validity by marshaling an unmarshaling
 	tx, _, err = ledgerstate.TransactionFromBytes(tx.Bytes())
@@ -942,11 +1597,11 @@ func (wallet *Wallet) WithdrawFundsFromNFT(options ...withdrawfromnftoptions.Wit
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(OutputsByAddressAndOutputID{walletAlias.Address: {
+	wallet.markOutputsAndAddressesSpent(tx, OutputsByAddressAndOutputID{walletAlias.Address: {
 		walletAlias.Object.ID(): walletAlias,
 	}})
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1038,7 +1693,10 @@ func (wallet *Wallet) DepositFundsToNFT(options ...deposittonftoptions.DepositFu
 	consumedOutputs[walletAlias.Address][walletAlias.Object.ID()] = walletAlias
 
 	// build unlock blocks
-	unlockBlocks, inputsInOrder := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	unlockBlocks, inputsInOrder, err := wallet.buildUnlockBlocks(inputs, consumedOutputs.OutputsByID(), txEssence)
+	if err != nil {
+		return
+	}
 
 	tx = ledgerstate.NewTransaction(txEssence, unlockBlocks)
 
@@ -1057,9 +1715,9 @@ func (wallet *Wallet) DepositFundsToNFT(options ...deposittonftoptions.DepositFu
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(consumedOutputs)
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1161,9 +1819,12 @@ func (wallet Wallet) SweepNFTOwnedFunds(options ...sweepnftownedoptions.SweepNFT
 		if input.Type() == ledgerstate.UTXOInputType {
 			casted := input.(*ledgerstate.UTXOInput)
 			if casted.ReferencedOutputID() == alias.ID() {
-				keyPair := wallet.Seed().KeyPair(walletAlias.Address.Index)
-				unlockBlock := ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes())))
-				unlockBlocks[index] = unlockBlock
+				signature, signErr := wallet.addressManager.keychain.Sign(walletAlias.Address, essence.Bytes())
+				if signErr != nil {
+					err = signErr
+					return
+				}
+				unlockBlocks[index] = ledgerstate.NewSignatureUnlockBlock(signature)
 				aliasInputIndex = index
 			}
 			inputsInOrder = append(inputsInOrder, toBeConsumeByID[casted.ReferencedOutputID()])
@@ -1197,11 +1858,11 @@ func (wallet Wallet) SweepNFTOwnedFunds(options ...sweepnftownedoptions.SweepNFT
 		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
 	}
 
-	wallet.markOutputsAndAddressesSpent(OutputsByAddressAndOutputID{walletAlias.Address: {
+	wallet.markOutputsAndAddressesSpent(tx, OutputsByAddressAndOutputID{walletAlias.Address: {
 		walletAlias.Object.ID(): walletAlias,
 	}})
 
-	err = wallet.connector.SendTransaction(tx)
+	err = wallet.issueTransaction(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1311,9 +1972,12 @@ func (wallet *Wallet) SweepNFTOwnedNFTs(options ...sweepnftownednftsoptions.Swee
 		if input.Type() == ledgerstate.UTXOInputType {
 			casted := input.(*ledgerstate.UTXOInput)
 			if casted.ReferencedOutputID() == alias.ID() {
-				keyPair := wallet.Seed().KeyPair(walletAlias.Address.Index)
-				unlockBlock := ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes())))
-				unlockBlocks[index] = unlockBlock
+				signature, signErr := wallet.addressManager.keychain.Sign(walletAlias.Address, essence.Bytes())
+				if signErr != nil {
+					err = signErr
+					return
+				}
+				unlockBlocks[index] = ledgerstate.NewSignatureUnlockBlock(signature)
 				aliasInputIndex = index
 			}
 			inputsInOrder = append(inputsInOrder, toBeConsumeByID[casted.ReferencedOutputID()])
@@ -1323,49 +1987,270 @@ func (wallet *Wallet) SweepNFTOwnedNFTs(options ...sweepnftownednftsoptions.Swee
 		err = errors.Errorf("failed to find alias %s among prepared transaction inputs", alias.GetAliasAddress().Base58())
 		return
 	}
-	// fill rest of the unlock blocks
-	for i := range essence.Inputs() {
-		if i != aliasInputIndex {
-			unlockBlocks[i] = ledgerstate.NewAliasUnlockBlock(uint16(aliasInputIndex))
+	// fill rest of the unlock blocks
+	for i := range essence.Inputs() {
+		if i != aliasInputIndex {
+			unlockBlocks[i] = ledgerstate.NewAliasUnlockBlock(uint16(aliasInputIndex))
+		}
+	}
+
+	tx = ledgerstate.NewTransaction(essence, unlockBlocks)
+
+	// check tx validity (balances, unlock blocks)
+	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
+	if err != nil {
+		return
+	}
+	if !ok {
+		err = errors.Errorf("created transaction is invalid: %s", tx.String())
+		return
+	}
+
+	wallet.markOutputsAndAddressesSpent(tx, OutputsByAddressAndOutputID{walletAlias.Address: {
+		walletAlias.Object.ID(): walletAlias,
+	}})
+
+	err = wallet.issueTransaction(tx)
+	if err != nil {
+		return
+	}
+
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() == ledgerstate.AliasOutputType {
+			casted := output.(*ledgerstate.AliasOutput)
+			if casted.GetAliasAddress().Equals(alias.GetAliasAddress()) {
+				// we skip the owned nft
+				continue
+			}
+			sweptNFTs = append(sweptNFTs, casted.GetAliasAddress())
+		}
+	}
+
+	if sweepOptions.WaitForConfirmation {
+		err = wallet.WaitForTxConfirmation(tx.ID())
+	}
+	return tx, sweptNFTs, err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TokenFoundry /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CreateFoundry creates a FoundryOutput that is controlled by an alias that is state controlled by the wallet. The
+// foundry can mint tokens of a new color up to its maximum supply and burn them again. It returns the color of the
+// token, which is derived from the ID of the created foundry.
+func (wallet *Wallet) CreateFoundry(options ...createfoundryoptions.CreateFoundryOption) (tx *ledgerstate.Transaction, tokenColor ledgerstate.Color, err error) {
+	createOptions, err := createfoundryoptions.Build(options...)
+	if err != nil {
+		return
+	}
+	// derive mana pledge IDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(createOptions.AccessManaPledgeID, createOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	deposit := map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: ledgerstate.DustThresholdAliasOutputIOTA}
+	foundry, err := ledgerstate.NewFoundryOutput(deposit, createOptions.Alias, createOptions.MaximumSupply, createOptions.TokenMetadata)
+	if err != nil {
+		return
+	}
+
+	tx, err = wallet.issueFoundryTransaction(createOptions.Alias, nil, foundry, deposit, nil, address.AddressEmpty, aPledgeID, cPledgeID, createOptions.WaitForConfirmation)
+	if tx == nil {
+		return
+	}
+
+	for _, output := range tx.Essence().Outputs() {
+		if output.Type() == ledgerstate.FoundryOutputType {
+			tokenColor = output.(*ledgerstate.FoundryOutput).TokenColor()
+		}
+	}
+
+	return tx, tokenColor, err
+}
+
+// MintTokens mints tokens with the foundry of the given color. The foundry has to be controlled by an alias that is
+// state controlled by the wallet. The minted tokens are created by recoloring the same amount of IOTA of the wallet.
+func (wallet *Wallet) MintTokens(options ...minttokensoptions.MintTokensOption) (tx *ledgerstate.Transaction, err error) {
+	mintOptions, err := minttokensoptions.Build(options...)
+	if err != nil {
+		return
+	}
+	// derive mana pledge IDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(mintOptions.AccessManaPledgeID, mintOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	foundry, err := wallet.connector.GetTokenFoundry(mintOptions.Color)
+	if err != nil {
+		return
+	}
+	circulatingSupply, valid := ledgerstate.SafeAddUint64(foundry.CirculatingSupply(), mintOptions.Amount)
+	if !valid {
+		return nil, errors.Errorf("minting %d tokens would overflow the circulating supply of %s", mintOptions.Amount, mintOptions.Color.Base58())
+	}
+	nextFoundry := foundry.NewFoundryOutputNext()
+	if err = nextFoundry.SetCirculatingSupply(circulatingSupply); err != nil {
+		return
+	}
+
+	return wallet.issueFoundryTransaction(foundry.AliasAddress(), foundry, nextFoundry,
+		map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: mintOptions.Amount},
+		map[ledgerstate.Color]uint64{mintOptions.Color: mintOptions.Amount},
+		mintOptions.ToWalletAddress(), aPledgeID, cPledgeID, mintOptions.WaitForConfirmation)
+}
+
+// BurnTokens burns tokens of the wallet with the foundry of the given color. The foundry has to be controlled by an
+// alias that is state controlled by the wallet. The burned tokens are turned back into IOTA.
+func (wallet *Wallet) BurnTokens(options ...burntokensoptions.BurnTokensOption) (tx *ledgerstate.Transaction, err error) {
+	burnOptions, err := burntokensoptions.Build(options...)
+	if err != nil {
+		return
+	}
+	// derive mana pledge IDs
+	aPledgeID, cPledgeID, err := wallet.derivePledgeIDs(burnOptions.AccessManaPledgeID, burnOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	foundry, err := wallet.connector.GetTokenFoundry(burnOptions.Color)
+	if err != nil {
+		return
+	}
+	if burnOptions.Amount > foundry.CirculatingSupply() {
+		return nil, errors.Errorf("can not burn %d tokens of %s with a circulating supply of %d", burnOptions.Amount, burnOptions.Color.Base58(), foundry.CirculatingSupply())
+	}
+	nextFoundry := foundry.NewFoundryOutputNext()
+	if err = nextFoundry.SetCirculatingSupply(foundry.CirculatingSupply() - burnOptions.Amount); err != nil {
+		return
+	}
+
+	return wallet.issueFoundryTransaction(foundry.AliasAddress(), foundry, nextFoundry,
+		map[ledgerstate.Color]uint64{burnOptions.Color: burnOptions.Amount},
+		map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: burnOptions.Amount},
+		address.AddressEmpty, aPledgeID, cPledgeID, burnOptions.WaitForConfirmation)
+}
+
+// TokenFoundry returns the FoundryOutput of the token with the given color, which holds its circulating supply, its
+// maximum supply and its metadata.
+func (wallet *Wallet) TokenFoundry(color ledgerstate.Color) (foundry *ledgerstate.FoundryOutput, err error) {
+	return wallet.connector.GetTokenFoundry(color)
+}
+
+// issueFoundryTransaction issues a transaction that state transitions the alias controlling a foundry and creates the
+// next state of the foundry. The consumed foundry is nil if the foundry is created by the transaction. The funds of the
+// wallet that cover the requiredFunds are consumed and the receivedFunds (minted tokens or burned tokens that are
+// turned back into IOTA) are sent to the toAddress, which defaults to the remainder address of the wallet.
+func (wallet *Wallet) issueFoundryTransaction(aliasID *ledgerstate.AliasAddress, consumedFoundry, foundry *ledgerstate.FoundryOutput, requiredFunds, receivedFunds map[ledgerstate.Color]uint64, toAddress address.Address, aPledgeID, cPledgeID identity.ID, waitForConfirmation bool) (tx *ledgerstate.Transaction, err error) {
+	// look up if we have the alias output. Only the state controller can modify the foundries of the alias.
+	walletAlias, err := wallet.findStateControlledAliasOutputByAliasID(aliasID)
+	if err != nil {
+		return
+	}
+	alias := walletAlias.Object.(*ledgerstate.AliasOutput)
+
+	// collect funds required for the foundry
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return
+	}
+	consumedOutputs, err := wallet.collectOutputsForTransfer(requiredFunds, minimumOutputDeposit, false)
+	if err != nil {
+		if errors.Is(err, ErrTooManyOutputs) {
+			err = errors.Errorf("consolidate funds and try again: %w", err)
 		}
+		return nil, err
 	}
 
-	tx = ledgerstate.NewTransaction(essence, unlockBlocks)
+	// the remainder receives the consumed funds that are not required
+	remainingFunds := consumedOutputs.TotalFundsInOutputs()
+	for color, amount := range requiredFunds {
+		remainingFunds[color] -= amount
+		if remainingFunds[color] == 0 {
+			delete(remainingFunds, color)
+		}
+	}
+	remainderAddress := wallet.chooseRemainderAddress(consumedOutputs, address.AddressEmpty)
+	if toAddress == address.AddressEmpty {
+		toAddress = remainderAddress
+	}
+	fundsByAddress := map[address.Address]map[ledgerstate.Color]uint64{remainderAddress: remainingFunds}
+	if _, exists := fundsByAddress[toAddress]; !exists {
+		fundsByAddress[toAddress] = make(map[ledgerstate.Color]uint64)
+	}
+	for color, amount := range receivedFunds {
+		fundsByAddress[toAddress][color] += amount
+	}
 
-	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
-	if err != nil {
-		return
+	unsortedOutputs := ledgerstate.Outputs{alias.NewAliasOutputNext(false), foundry}
+	for addr, balances := range fundsByAddress {
+		if len(balances) != 0 {
+			unsortedOutputs = append(unsortedOutputs, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(balances), addr.Address()))
+		}
 	}
-	if !ok {
-		err = errors.Errorf("created transaction is invalid: %s", tx.String())
-		return
+
+	// add the alias to the consumed outputs
+	if _, exists := consumedOutputs[walletAlias.Address]; !exists {
+		consumedOutputs[walletAlias.Address] = make(map[ledgerstate.OutputID]*Output)
 	}
+	consumedOutputs[walletAlias.Address][walletAlias.Object.ID()] = walletAlias
 
-	wallet.markOutputsAndAddressesSpent(OutputsByAddressAndOutputID{walletAlias.Address: {
-		walletAlias.Object.ID(): walletAlias,
-	}})
+	unsortedInputs := wallet.buildInputs(consumedOutputs)
+	if consumedFoundry != nil {
+		unsortedInputs = append(unsortedInputs, consumedFoundry.Input())
+	}
+	inputs := ledgerstate.NewInputs(unsortedInputs...)
+	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), aPledgeID, cPledgeID, inputs, ledgerstate.NewOutputs(unsortedOutputs...))
 
-	err = wallet.connector.SendTransaction(tx)
+	// build unlock blocks
+	consumedOutputsByID := consumedOutputs.OutputsByID()
+	unlockBlocks := make(ledgerstate.UnlockBlocks, len(inputs))
+	inputsInOrder := make(ledgerstate.Outputs, len(inputs))
+	// the alias is unlocked first, as the foundry references its unlock block
+	aliasInputIndex := -1
+	for index, input := range inputs {
+		if input.(*ledgerstate.UTXOInput).ReferencedOutputID() == alias.ID() {
+			aliasInputIndex = index
+		}
+	}
+	if aliasInputIndex < 0 {
+		return nil, errors.Errorf("failed to find alias %s among prepared transaction inputs", aliasID.Base58())
+	}
+	signature, err := wallet.addressManager.keychain.Sign(walletAlias.Address, txEssence.Bytes())
 	if err != nil {
-		return
+		return nil, errors.Errorf("failed to sign input with address %s: %w", walletAlias.Address.Base58(), err)
 	}
+	unlockBlocks[aliasInputIndex] = ledgerstate.NewSignatureUnlockBlock(signature)
+	existingUnlockBlocks := map[address.Address]uint16{walletAlias.Address: uint16(aliasInputIndex)}
+	for index, input := range inputs {
+		referencedOutputID := input.(*ledgerstate.UTXOInput).ReferencedOutputID()
+		if consumedFoundry != nil && referencedOutputID == consumedFoundry.ID() {
+			inputsInOrder[index] = consumedFoundry
+			unlockBlocks[index] = ledgerstate.NewAliasUnlockBlock(uint16(aliasInputIndex))
+			continue
+		}
 
-	for _, output := range tx.Essence().Outputs() {
-		if output.Type() == ledgerstate.AliasOutputType {
-			casted := output.(*ledgerstate.AliasOutput)
-			if casted.GetAliasAddress().Equals(alias.GetAliasAddress()) {
-				// we skip the owned nft
-				continue
-			}
-			sweptNFTs = append(sweptNFTs, casted.GetAliasAddress())
+		output := consumedOutputsByID[referencedOutputID]
+		inputsInOrder[index] = output.Object
+		if index == aliasInputIndex {
+			continue
+		}
+		if unlockBlockIndex, unlockBlockExists := existingUnlockBlocks[output.Address]; unlockBlockExists {
+			unlockBlocks[index] = ledgerstate.NewReferenceUnlockBlock(unlockBlockIndex)
+			continue
 		}
-	}
 
-	if sweepOptions.WaitForConfirmation {
-		err = wallet.WaitForTxConfirmation(tx.ID())
+		signature, err = wallet.addressManager.keychain.Sign(output.Address, txEssence.Bytes())
+		if err != nil {
+			return nil, errors.Errorf("failed to sign input with address %s: %w", output.Address.Base58(), err)
+		}
+		unlockBlocks[index] = ledgerstate.NewSignatureUnlockBlock(signature)
+		existingUnlockBlocks[output.Address] = uint16(index)
 	}
-	return tx, sweptNFTs, err
+
+	return wallet.checkAndIssueTransaction(ledgerstate.NewTransaction(txEssence, unlockBlocks), inputsInOrder, consumedOutputs, waitForConfirmation)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1451,12 +2336,28 @@ func (wallet *Wallet) UnspentAliasOutputs(includePending bool) map[address.Addre
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region UnspentHashTimeLockedOutputs /////////////////////////////////////////////////////////////////////////////////
+
+// UnspentHashTimeLockedOutputs returns the HashTimeLockedOutputs that were sent to or created by the wallet and have not
+// been spent yet.
+func (wallet *Wallet) UnspentHashTimeLockedOutputs(includePending bool) map[address.Address]map[ledgerstate.OutputID]*Output {
+	return wallet.outputManager.UnspentHashTimeLockedOutputs(includePending)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region RequestFaucetFunds ///////////////////////////////////////////////////////////////////////////////////////////
 
 // RequestFaucetFunds requests some funds from the faucet for testing purposes.
 func (wallet *Wallet) RequestFaucetFunds(waitForConfirmation ...bool) (err error) {
+	return wallet.RequestColoredFaucetFunds(ledgerstate.ColorIOTA, 0, waitForConfirmation...)
+}
+
+// RequestColoredFaucetFunds requests the given amount of tokens of the given color from the faucet for testing
+// purposes. An amount of zero requests the default amount the faucet dispenses for the color.
+func (wallet *Wallet) RequestColoredFaucetFunds(color ledgerstate.Color, amount uint64, waitForConfirmation ...bool) (err error) {
 	if len(waitForConfirmation) == 0 || !waitForConfirmation[0] {
-		err = wallet.connector.RequestFaucetFunds(wallet.ReceiveAddress(), wallet.faucetPowDifficulty)
+		err = wallet.connector.RequestFaucetFunds(wallet.ReceiveAddress(), wallet.faucetPowDifficulty, color, amount)
 
 		return
 	}
@@ -1469,7 +2370,7 @@ func (wallet *Wallet) RequestFaucetFunds(waitForConfirmation ...bool) (err error
 		return
 	}
 
-	err = wallet.connector.RequestFaucetFunds(wallet.ReceiveAddress(), wallet.faucetPowDifficulty)
+	err = wallet.connector.RequestFaucetFunds(wallet.ReceiveAddress(), wallet.faucetPowDifficulty, color, amount)
 	if err != nil {
 		return
 	}
@@ -1883,9 +2784,46 @@ func (wallet *Wallet) DelegatedAliasBalance(refresh ...bool) (
 
 // region Seed /////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// Seed returns the seed of this wallet that is used to generate all of the wallets addresses and private keys.
+// Seed returns the seed of this wallet that is used to generate all of the wallets addresses and private keys. It
+// returns nil for watch-only wallets.
 func (wallet *Wallet) Seed() *seed.Seed {
-	return wallet.addressManager.seed
+	switch keychain := wallet.addressManager.keychain.(type) {
+	case *seedKeychain:
+		return keychain.seed
+	case *accountKeychain:
+		return keychain.seed
+	default:
+		return nil
+	}
+}
+
+// Account returns the account of the seed that is used by this wallet. It returns nil for wallets that use the flat
+// address sequence of their seed and for watch-only wallets.
+func (wallet *Wallet) Account() *seed.Account {
+	if keychain, isAccount := wallet.addressManager.keychain.(*accountKeychain); isAccount {
+		return keychain.account
+	}
+
+	return nil
+}
+
+// AccountPublicKey returns the public key of the account of this wallet, which allows to create a watch-only wallet.
+func (wallet *Wallet) AccountPublicKey() (accountPublicKey *seed.AccountPublicKey, err error) {
+	switch keychain := wallet.addressManager.keychain.(type) {
+	case *accountKeychain:
+		return keychain.account.PublicKey(), nil
+	case *watchOnlyKeychain:
+		return keychain.accountPublicKey, nil
+	default:
+		return nil, errors.Errorf("wallet does not use an account")
+	}
+}
+
+// WatchOnly returns true if the wallet does not know its seed.
+func (wallet *Wallet) WatchOnly() bool {
+	_, watchOnly := wallet.addressManager.keychain.(*watchOnlyKeychain)
+
+	return watchOnly
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1901,10 +2839,36 @@ func (wallet *Wallet) AddressManager() *AddressManager {
 
 // region ExportState //////////////////////////////////////////////////////////////////////////////////////////////////
 
+// AccountStateMarker prefixes the exported state of wallets that use an account instead of the flat address sequence
+// of their seed. It is followed by the AccountStateType of the wallet.
+var AccountStateMarker = []byte("GoShimmerAccount")
+
+// AccountStateType defines which keys are contained in the exported state of an account wallet.
+type AccountStateType uint8
+
+const (
+	// AccountStateSeed is the type of states that contain the seed and the index of the account.
+	AccountStateSeed AccountStateType = iota
+	// AccountStateWatchOnly is the type of states that only contain the AccountPublicKey.
+	AccountStateWatchOnly
+)
+
 // ExportState exports the current state of the wallet to a marshaled version.
 func (wallet *Wallet) ExportState() []byte {
 	marshalUtil := marshalutil.New()
-	marshalUtil.WriteBytes(wallet.Seed().Bytes())
+	switch keychain := wallet.addressManager.keychain.(type) {
+	case *accountKeychain:
+		marshalUtil.WriteBytes(AccountStateMarker)
+		marshalUtil.WriteUint8(uint8(AccountStateSeed))
+		marshalUtil.WriteBytes(keychain.seed.Bytes())
+		marshalUtil.WriteUint32(keychain.account.Index())
+	case *watchOnlyKeychain:
+		marshalUtil.WriteBytes(AccountStateMarker)
+		marshalUtil.WriteUint8(uint8(AccountStateWatchOnly))
+		marshalUtil.WriteBytes(keychain.accountPublicKey.Bytes())
+	default:
+		marshalUtil.WriteBytes(wallet.Seed().Bytes())
+	}
 	marshalUtil.WriteUint64(wallet.AddressManager().lastAddressIndex)
 	marshalUtil.WriteBytes(wallet.assetRegistry.Bytes())
 	marshalUtil.WriteBytes(*(*[]byte)(unsafe.Pointer(&wallet.addressManager.spentAddresses)))
@@ -1914,10 +2878,240 @@ func (wallet *Wallet) ExportState() []byte {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region PendingTransactions //////////////////////////////////////////////////////////////////////////////////////////
+
+// PendingTransactions returns the transactions issued by the wallet that are not confirmed, yet, ordered by their
+// issuing time.
+func (wallet *Wallet) PendingTransactions() (pendingTransactions []*PendingTransaction) {
+	pendingTransactions = make([]*PendingTransaction, 0, len(wallet.pendingTransactions))
+	for _, pendingTx := range wallet.pendingTransactions {
+		pendingTransactions = append(pendingTransactions, pendingTx)
+	}
+	sort.Slice(pendingTransactions, func(i, j int) bool {
+		return pendingTransactions[i].IssuingTime.Before(pendingTransactions[j].IssuingTime)
+	})
+
+	return
+}
+
+// UpdatePendingTransactions fetches the branch and the GoF of the pending transactions. The local spent state of the
+// transactions whose branch is disliked, that got rejected or that expired before the node booked them is rolled back,
+// so their inputs can be spent again. Confirmed transactions are returned one last time and are no longer tracked
+// afterwards.
+func (wallet *Wallet) UpdatePendingTransactions() (pendingTransactions []*PendingTransaction, err error) {
+	pendingTransactions = wallet.PendingTransactions()
+	for _, pendingTx := range pendingTransactions {
+		// rejected and expired transactions stay rejected and expired
+		if pendingTx.State == TransactionRejected || pendingTx.State == TransactionExpired {
+			continue
+		}
+
+		status, statusErr := wallet.connector.GetTransactionStatus(pendingTx.Transaction, wallet.ConfirmationTargetGoF)
+		if statusErr != nil {
+			err = errors.Errorf("failed to fetch status of transaction %s: %w", pendingTx.Transaction.ID().Base58(), statusErr)
+			continue
+		}
+		if status.Unknown {
+			// a transaction that is not booked by the end of its validity window can never be booked
+			if pendingTx.Transaction.Essence().Expired(time.Now().Add(-expiredTransactionGracePeriod)) {
+				pendingTx.State = TransactionExpired
+				if !pendingTx.RolledBack {
+					wallet.rollBackTransaction(pendingTx)
+				}
+			}
+			continue
+		}
+		pendingTx.BranchID = status.BranchID
+		pendingTx.GradeOfFinality = status.GradeOfFinality
+		pendingTx.Conflicts = status.Conflicts
+		pendingTx.State = wallet.transactionState(status)
+
+		switch pendingTx.State {
+		case TransactionDisliked, TransactionRejected:
+			if !pendingTx.RolledBack {
+				wallet.rollBackTransaction(pendingTx)
+			}
+		case TransactionPending, TransactionConfirmed:
+			if pendingTx.RolledBack {
+				wallet.reapplyTransaction(pendingTx)
+			}
+		}
+
+		if pendingTx.State == TransactionConfirmed {
+			wallet.forgetTransaction(pendingTx)
+		}
+	}
+
+	return pendingTransactions, err
+}
+
+// ReissueTransaction issues a new transaction that sends the funds of the given rolled back transaction to the same
+// destinations, spending the inputs that are still available. The rolled back transaction is no longer tracked
+// afterwards.
+func (wallet *Wallet) ReissueTransaction(txID ledgerstate.TransactionID, waitForConfirmation ...bool) (tx *ledgerstate.Transaction, err error) {
+	pendingTx, exists := wallet.pendingTransactions[txID]
+	if !exists {
+		return nil, errors.Errorf("transaction %s is not tracked by the wallet", txID.Base58())
+	}
+	if !pendingTx.RolledBack {
+		return nil, errors.Errorf("transaction %s is %s and can not be re-issued", txID.Base58(), pendingTx.State)
+	}
+
+	essence := pendingTx.Transaction.Essence()
+	options := []sendoptions.SendFundsOption{
+		sendoptions.AccessManaPledgeID(base58.Encode(essence.AccessPledgeID().Bytes())),
+		sendoptions.ConsensusManaPledgeID(base58.Encode(essence.ConsensusPledgeID().Bytes())),
+	}
+	if len(waitForConfirmation) > 0 {
+		options = append(options, sendoptions.WaitForConfirmation(waitForConfirmation[0]))
+	}
+
+	// the outputs on our own addresses are remainders, which are determined anew
+	ownOutputs := ownedOutputs(pendingTx.Transaction, wallet.addressManager.Addresses())
+	destinationCount := 0
+	for _, output := range essence.Outputs() {
+		if _, owned := ownOutputs[output.ID()]; owned {
+			continue
+		}
+		switch output.Type() {
+		case ledgerstate.SigLockedSingleOutputType, ledgerstate.SigLockedColoredOutputType:
+			output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+				if color == ledgerstate.ColorMint {
+					err = errors.Errorf("re-issuing transactions that mint tokens is not supported")
+					return false
+				}
+				options = append(options, sendoptions.Destination(address.Address{AddressBytes: output.Address().Array()}, balance, color))
+				destinationCount++
+				return true
+			})
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf("re-issuing transactions with outputs of type %s is not supported", output.Type())
+		}
+	}
+
+	// the funds of the transaction did not leave the wallet, so there is nothing to re-issue
+	if destinationCount == 0 {
+		delete(wallet.pendingTransactions, txID)
+		return nil, nil
+	}
+
+	tx, err = wallet.SendFunds(options...)
+	if tx != nil {
+		delete(wallet.pendingTransactions, txID)
+	}
+
+	return tx, err
+}
+
+// ExportPendingTransactions exports the pending transactions of the wallet to a marshaled version.
+func (wallet *Wallet) ExportPendingTransactions() []byte {
+	return pendingTransactionsBytes(wallet.PendingTransactions())
+}
+
+// transactionState derives the state of a transaction from its status.
+func (wallet *Wallet) transactionState(status *TransactionStatus) TransactionState {
+	if status.GradeOfFinality >= wallet.ConfirmationTargetGoF {
+		return TransactionConfirmed
+	}
+	for _, conflictGoF := range status.Conflicts {
+		if conflictGoF >= wallet.ConfirmationTargetGoF {
+			return TransactionRejected
+		}
+	}
+	if !status.Liked {
+		return TransactionDisliked
+	}
+
+	return TransactionPending
+}
+
+// rollBackTransaction marks the outputs consumed by the given transaction as unspent again, unless they are double spent
+// or were created by another rolled back transaction. The outputs created by the transaction are hidden.
+func (wallet *Wallet) rollBackTransaction(pendingTx *PendingTransaction) {
+	for addr, outputs := range pendingTx.ConsumedOutputs {
+		restored := false
+		for outputID, output := range outputs {
+			if _, doubleSpent := pendingTx.Conflicts[outputID]; doubleSpent {
+				continue
+			}
+			if creator, tracked := wallet.pendingTransactions[outputID.TransactionID()]; tracked && creator.RolledBack {
+				continue
+			}
+
+			restoredOutput := *output
+			restoredOutput.Spent = false
+			wallet.outputManager.StoreOutput(&restoredOutput)
+			restored = true
+		}
+		if restored && !wallet.reusableAddress {
+			wallet.addressManager.MarkAddressUnspent(addr.Index)
+		}
+	}
+
+	for _, output := range ownedOutputs(pendingTx.Transaction, wallet.addressManager.Addresses()) {
+		output.Spent = true
+		wallet.outputManager.StoreOutput(output)
+	}
+
+	pendingTx.RolledBack = true
+}
+
+// reapplyTransaction reverts the rollback of the given transaction after its branch is liked again.
+func (wallet *Wallet) reapplyTransaction(pendingTx *PendingTransaction) {
+	wallet.markSpent(pendingTx.ConsumedOutputs)
+
+	for _, output := range ownedOutputs(pendingTx.Transaction, wallet.addressManager.Addresses()) {
+		wallet.outputManager.StoreOutput(output)
+	}
+
+	pendingTx.RolledBack = false
+}
+
+// forgetTransaction stops tracking the given confirmed transaction. The outputs it consumed are no longer restored by
+// the rollback of other transactions.
+func (wallet *Wallet) forgetTransaction(pendingTx *PendingTransaction) {
+	delete(wallet.pendingTransactions, pendingTx.Transaction.ID())
+
+	for _, input := range pendingTx.Transaction.Essence().Inputs() {
+		outputID := input.(*ledgerstate.UTXOInput).ReferencedOutputID()
+		for _, otherTx := range wallet.pendingTransactions {
+			for _, outputs := range otherTx.ConsumedOutputs {
+				delete(outputs, outputID)
+			}
+		}
+	}
+}
+
+// restorePendingTransactions applies the local spent state of the imported pending transactions to the outputs
+// fetched from the node.
+func (wallet *Wallet) restorePendingTransactions() {
+	pendingTransactions := wallet.PendingTransactions()
+	for _, pendingTx := range pendingTransactions {
+		if pendingTx.RolledBack {
+			wallet.rollBackTransaction(pendingTx)
+		}
+	}
+	for _, pendingTx := range pendingTransactions {
+		if !pendingTx.RolledBack {
+			wallet.markSpent(pendingTx.ConsumedOutputs)
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region WaitForTxConfirmation ////////////////////////////////////////////////////////////////////////////////////////
 
-// WaitForTxConfirmation waits for the given tx to reach a high grade of finalty.
-func (wallet *Wallet) WaitForTxConfirmation(txID ledgerstate.TransactionID) (err error) {
+// WaitForTxConfirmation waits for the given tx to reach the target grade of finality. If no target is given, the
+// ConfirmationTargetGoF of the wallet is used.
+func (wallet *Wallet) WaitForTxConfirmation(txID ledgerstate.TransactionID, targetGoF ...gof.GradeOfFinality) (err error) {
+	target := wallet.ConfirmationTargetGoF
+	if len(targetGoF) > 0 {
+		target = targetGoF[0]
+	}
 	timeoutCounter := time.Duration(0)
 	for {
 		time.Sleep(wallet.ConfirmationPollInterval)
@@ -1926,7 +3120,7 @@ func (wallet *Wallet) WaitForTxConfirmation(txID ledgerstate.TransactionID) (err
 		if fetchErr != nil {
 			return fetchErr
 		}
-		if finality == gof.High {
+		if finality >= target {
 			return
 		}
 		if timeoutCounter > wallet.ConfirmationTimeout {
@@ -2125,6 +3319,51 @@ func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[ledgerstate.Co
 	)
 }
 
+// collectOutputsForTransfer collects the outputs funding a transfer of the required funds. If the remainder of the
+// transfer would hold less than the minimum output deposit, further outputs are consolidated into the remainder. If the
+// wallet does not hold enough funds for that, the transfer is refused.
+func (wallet *Wallet) collectOutputsForTransfer(requiredFunds map[ledgerstate.Color]uint64, minimumOutputDeposit uint64, includePending bool) (consumedOutputs OutputsByAddressAndOutputID, err error) {
+	if consumedOutputs, err = wallet.collectOutputsForFunding(requiredFunds, includePending); err != nil && !errors.Is(err, ErrTooManyOutputs) {
+		return nil, err
+	}
+
+	remainder := totalTokens(consumedOutputs.TotalFundsInOutputs()) - totalTokens(requiredFunds)
+	if remainder == 0 || remainder >= minimumOutputDeposit {
+		return consumedOutputs, err
+	}
+
+	fundsIncludingDeposit := make(map[ledgerstate.Color]uint64, len(requiredFunds)+1)
+	for color, balance := range requiredFunds {
+		fundsIncludingDeposit[color] = balance
+	}
+	fundsIncludingDeposit[ledgerstate.ColorIOTA] += minimumOutputDeposit
+	if consumedOutputs, err = wallet.collectOutputsForFunding(fundsIncludingDeposit, includePending); err != nil && !errors.Is(err, ErrTooManyOutputs) {
+		return nil, errors.Errorf("the remainder of %d tokens is less than the minimum deposit of %d and there are not enough funds to increase it: %w", remainder, minimumOutputDeposit, ledgerstate.ErrDustOutput)
+	}
+
+	return consumedOutputs, err
+}
+
+// checkDestinationDeposits checks that every destination receives at least the minimum output deposit.
+func checkDestinationDeposits(destinations map[address.Address]map[ledgerstate.Color]uint64, minimumOutputDeposit uint64) error {
+	for destination, balances := range destinations {
+		if deposit := totalTokens(balances); deposit < minimumOutputDeposit {
+			return errors.Errorf("can not send %d tokens to %s, as it is less than the minimum deposit of %d: %w", deposit, destination.Base58(), minimumOutputDeposit, ledgerstate.ErrDustOutput)
+		}
+	}
+
+	return nil
+}
+
+// totalTokens returns the amount of tokens of all colors in the given balances.
+func totalTokens(balances map[ledgerstate.Color]uint64) (total uint64) {
+	for _, balance := range balances {
+		total += balance
+	}
+
+	return
+}
+
 // enoughCollected checks if collected has at least target funds.
 func enoughCollected(collected, target map[ledgerstate.Color]uint64) bool {
 	for color, balance := range target {
@@ -2208,7 +3447,7 @@ func (wallet *Wallet) buildOutputs(
 }
 
 // buildUnlockBlocks constructs the unlock blocks for a transaction.
-func (wallet *Wallet) buildUnlockBlocks(inputs ledgerstate.Inputs, consumedOutputsByID OutputsByID, essence *ledgerstate.TransactionEssence) (unlocks ledgerstate.UnlockBlocks, inputsInOrder ledgerstate.Outputs) {
+func (wallet *Wallet) buildUnlockBlocks(inputs ledgerstate.Inputs, consumedOutputsByID OutputsByID, essence *ledgerstate.TransactionEssence) (unlocks ledgerstate.UnlockBlocks, inputsInOrder ledgerstate.Outputs, err error) {
 	unlocks = make([]ledgerstate.UnlockBlock, len(inputs))
 	existingUnlockBlocks := make(map[address.Address]uint16)
 	for outputIndex, input := range inputs {
@@ -2219,16 +3458,56 @@ func (wallet *Wallet) buildUnlockBlocks(inputs ledgerstate.Inputs, consumedOutpu
 			continue
 		}
 
-		keyPair := wallet.Seed().KeyPair(output.Address.Index)
-		unlockBlock := ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes())))
-		unlocks[outputIndex] = unlockBlock
+		signature, signErr := wallet.addressManager.keychain.Sign(output.Address, essence.Bytes())
+		if signErr != nil {
+			return nil, nil, errors.Errorf("failed to sign input with address %s: %w", output.Address.Base58(), signErr)
+		}
+		unlocks[outputIndex] = ledgerstate.NewSignatureUnlockBlock(signature)
 		existingUnlockBlocks[output.Address] = uint16(outputIndex)
 	}
 	return
 }
 
-// markOutputsAndAddressesSpent marks consumed outputs and their addresses as spent.
-func (wallet *Wallet) markOutputsAndAddressesSpent(consumedOutputs OutputsByAddressAndOutputID) {
+// markOutputsAndAddressesSpent marks consumed outputs and their addresses as spent and tracks the transaction spending
+// them until it is confirmed.
+func (wallet *Wallet) markOutputsAndAddressesSpent(tx *ledgerstate.Transaction, consumedOutputs OutputsByAddressAndOutputID) {
+	wallet.pendingTransactions[tx.ID()] = NewPendingTransaction(tx, consumedOutputs)
+	wallet.markSpent(consumedOutputs)
+}
+
+// issueTransaction sends the given transaction to the network. If the node does not accept it, the transaction is no
+// longer tracked and the outputs it consumed are available again.
+func (wallet *Wallet) issueTransaction(tx *ledgerstate.Transaction) error {
+	if err := wallet.connector.SendTransaction(tx); err != nil {
+		wallet.untrackTransaction(tx.ID())
+		return err
+	}
+	return nil
+}
+
+// untrackTransaction stops tracking the given transaction that was never issued and marks the outputs and addresses it
+// consumed as unspent again.
+func (wallet *Wallet) untrackTransaction(txID ledgerstate.TransactionID) {
+	pendingTx, tracked := wallet.pendingTransactions[txID]
+	if !tracked {
+		return
+	}
+	delete(wallet.pendingTransactions, txID)
+
+	for addr, outputs := range pendingTx.ConsumedOutputs {
+		for _, output := range outputs {
+			restoredOutput := *output
+			restoredOutput.Spent = false
+			wallet.outputManager.StoreOutput(&restoredOutput)
+		}
+		if !wallet.reusableAddress {
+			wallet.addressManager.MarkAddressUnspent(addr.Index)
+		}
+	}
+}
+
+// markSpent marks the given outputs and their addresses as spent.
+func (wallet *Wallet) markSpent(consumedOutputs OutputsByAddressAndOutputID) {
 	// mark outputs as spent
 	for addr, outputs := range consumedOutputs {
 		for outputID := range outputs {
@@ -2288,6 +3567,79 @@ func (wallet *Wallet) chooseToAddress(consumedOutputs OutputsByAddressAndOutputI
 	return optionsToAddress
 }
 
+// unspentHashTimeLockedOutput looks up the confirmed HashTimeLockedOutput with the given ID that the wallet can spend
+// either as receiver or, if refund is true, as sender.
+func (wallet *Wallet) unspentHashTimeLockedOutput(outputID ledgerstate.OutputID, refund bool) (consumedOutputs OutputsByAddressAndOutputID, walletAddress address.Address, hashTimeLockedOutput *ledgerstate.HashTimeLockedOutput, err error) {
+	if err = wallet.outputManager.Refresh(); err != nil {
+		return
+	}
+
+	for addr, outputs := range wallet.outputManager.UnspentHashTimeLockedOutputs(false) {
+		output, exists := outputs[outputID]
+		if !exists {
+			continue
+		}
+		casted := output.Object.(*ledgerstate.HashTimeLockedOutput)
+		unlockAddress := casted.Address()
+		if refund {
+			unlockAddress = casted.FallbackAddress()
+		}
+		if !addr.Address().Equals(unlockAddress) {
+			continue
+		}
+
+		consumedOutputs = NewAddressToOutputs()
+		consumedOutputs[addr] = map[ledgerstate.OutputID]*Output{outputID: output}
+		return consumedOutputs, addr, casted, nil
+	}
+
+	if refund {
+		err = errors.Errorf("failed to find confirmed hash time lock %s created by the wallet", outputID.Base58())
+	} else {
+		err = errors.Errorf("failed to find confirmed hash time lock %s sent to the wallet", outputID.Base58())
+	}
+	return
+}
+
+// checkAndIssueTransaction checks the validity of a transaction, marks the consumed outputs of the wallet as spent and
+// issues it.
+func (wallet *Wallet) checkAndIssueTransaction(tx *ledgerstate.Transaction, inputsInOrder ledgerstate.Outputs, consumedOutputs OutputsByAddressAndOutputID, waitForConfirmation bool) (*ledgerstate.Transaction, error) {
+	// check syntactical validity by marshaling an unmarshaling
+	tx, _, err := ledgerstate.TransactionFromBytes(tx.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// check tx validity (balances, unlock blocks)
+	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
+	}
+
+	// check the dust protection of the node
+	minimumOutputDeposit, err := wallet.connector.GetMinimumOutputDeposit()
+	if err != nil {
+		return nil, err
+	}
+	if err = ledgerstate.DustProtectionValid(tx.Essence().Outputs(), minimumOutputDeposit); err != nil {
+		return nil, err
+	}
+
+	wallet.markOutputsAndAddressesSpent(tx, consumedOutputs)
+
+	if err = wallet.issueTransaction(tx); err != nil {
+		return nil, err
+	}
+	if waitForConfirmation {
+		err = wallet.WaitForTxConfirmation(tx.ID())
+	}
+
+	return tx, err
+}
+
 // checkBalancesAndUnlocks checks if tx balances are okay and unlock blocks are valid.
 func checkBalancesAndUnlocks(inputs ledgerstate.Outputs, tx *ledgerstate.Transaction) (bool, error) {
 	balancesValid := ledgerstate.TransactionBalancesValid(inputs, tx.Essence().Outputs())
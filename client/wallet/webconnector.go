@@ -35,13 +35,19 @@ func (webConnector *WebConnector) ServerStatus() (status ServerStatus, err error
 	status.Version = response.Version
 	status.ManaDecay = response.ManaDecay
 	status.DelegationAddress = response.ManaDelegationAddress
+	status.MinimumOutputDeposit = response.MinimumOutputDeposit
 
 	return
 }
 
-// RequestFaucetFunds request some funds from the faucet for test purposes.
-func (webConnector *WebConnector) RequestFaucetFunds(addr address.Address, powTarget int) (err error) {
-	_, err = webConnector.client.SendFaucetRequest(addr.Address().Base58(), powTarget)
+// RequestFaucetFunds request some funds from the faucet for test purposes. An amount of zero requests the default
+// amount the faucet dispenses for the color.
+func (webConnector *WebConnector) RequestFaucetFunds(addr address.Address, powTarget int, color ledgerstate.Color, amount uint64) (err error) {
+	if color == ledgerstate.ColorIOTA && amount == 0 {
+		_, err = webConnector.client.SendFaucetRequest(addr.Address().Base58(), powTarget)
+		return
+	}
+	_, err = webConnector.client.SendColoredFaucetRequest(addr.Address().Base58(), color, amount, powTarget)
 
 	return
 }
@@ -116,6 +122,60 @@ func (webConnector WebConnector) GetTransactionGoF(txID ledgerstate.TransactionI
 	return
 }
 
+// GetTransactionStatus fetches the branch and the GoF of the transaction and looks up the transactions that spend the
+// same inputs, unless the transaction already reached the given confirmation target.
+func (webConnector WebConnector) GetTransactionStatus(tx *ledgerstate.Transaction, confirmationTargetGoF gof.GradeOfFinality) (status *TransactionStatus, err error) {
+	txMetadata, err := webConnector.client.GetTransactionMetadata(tx.ID().Base58())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return &TransactionStatus{Unknown: true}, nil
+		}
+		return
+	}
+	branchID, err := ledgerstate.BranchIDFromBase58(txMetadata.BranchID)
+	if err != nil {
+		return
+	}
+
+	status = &TransactionStatus{
+		BranchID:        branchID,
+		GradeOfFinality: txMetadata.GradeOfFinality,
+		Liked:           true,
+		Conflicts:       make(map[ledgerstate.OutputID]gof.GradeOfFinality),
+	}
+	if status.GradeOfFinality >= confirmationTargetGoF {
+		return
+	}
+
+	branch, err := webConnector.client.GetBranch(txMetadata.BranchID)
+	if err != nil {
+		return nil, err
+	}
+	status.Liked = branch.Liked
+
+	for _, input := range tx.Essence().Inputs() {
+		outputID := input.(*ledgerstate.UTXOInput).ReferencedOutputID()
+		consumers, consumersErr := webConnector.client.GetOutputConsumers(outputID.Base58())
+		if consumersErr != nil {
+			return nil, consumersErr
+		}
+		for _, consumer := range consumers.Consumers {
+			if consumer.TransactionID == tx.ID().Base58() {
+				continue
+			}
+			conflictingTxMetadata, metadataErr := webConnector.client.GetTransactionMetadata(consumer.TransactionID)
+			if metadataErr != nil {
+				return nil, metadataErr
+			}
+			if conflictingGoF, exists := status.Conflicts[outputID]; !exists || conflictingTxMetadata.GradeOfFinality > conflictingGoF {
+				status.Conflicts[outputID] = conflictingTxMetadata.GradeOfFinality
+			}
+		}
+	}
+
+	return
+}
+
 // GetAllowedPledgeIDs gets the list of nodeIDs that the node accepts as pledgeIDs in a transaction.
 func (webConnector WebConnector) GetAllowedPledgeIDs() (pledgeIDMap map[mana.Type][]string, err error) {
 	res, err := webConnector.client.GetAllowedManaPledgeNodeIDs()
@@ -159,6 +219,72 @@ func (webConnector WebConnector) GetUnspentAliasOutput(addr *ledgerstate.AliasAd
 	return nil, errors.Errorf("couldn't find unspent alias output for alias addr %s", addr.Base58())
 }
 
+// GetTokenFoundry returns the unspent FoundryOutput that controls the supply of the token with the given color.
+func (webConnector WebConnector) GetTokenFoundry(color ledgerstate.Color) (foundry *ledgerstate.FoundryOutput, err error) {
+	res, err := webConnector.client.GetTokenSupply(color.Base58())
+	if err != nil {
+		return
+	}
+	if res.Foundry == nil {
+		return nil, errors.Errorf("couldn't find foundry of token %s", color.Base58())
+	}
+	uncastedOutput, err := res.Foundry.ToLedgerstateOutput()
+	if err != nil {
+		return
+	}
+	foundry, ok := uncastedOutput.(*ledgerstate.FoundryOutput)
+	if !ok {
+		return nil, errors.Errorf("foundry output received from api cannot be casted to ledgerstate representation")
+	}
+	return foundry, nil
+}
+
+// GetMinimumOutputDeposit returns the amount of tokens that every output created by a transaction has to hold at least
+// to be accepted by the node.
+func (webConnector WebConnector) GetMinimumOutputDeposit() (minimumOutputDeposit uint64, err error) {
+	response, err := webConnector.client.Info()
+	if err != nil {
+		return
+	}
+
+	return response.MinimumOutputDeposit, nil
+}
+
+// GetRevealedPreimages returns the preimages that the transactions consuming the given output reveal in their
+// HashLockUnlockBlocks.
+func (webConnector WebConnector) GetRevealedPreimages(outputID ledgerstate.OutputID) (preimages []ledgerstate.Preimage, err error) {
+	consumers, err := webConnector.client.GetOutputConsumers(outputID.Base58())
+	if err != nil {
+		return
+	}
+
+	for _, consumer := range consumers.Consumers {
+		tx, txErr := webConnector.client.GetTransaction(consumer.TransactionID)
+		if txErr != nil {
+			return nil, txErr
+		}
+		for i, input := range tx.Inputs {
+			if input.ReferencedOutputID == nil || input.ReferencedOutputID.Base58 != outputID.Base58() || i >= len(tx.UnlockBlocks) {
+				continue
+			}
+			unlockBlock := tx.UnlockBlocks[i]
+			if unlockBlock.Type == ledgerstate.ReferenceUnlockBlockType.String() && int(unlockBlock.ReferencedIndex) < len(tx.UnlockBlocks) {
+				unlockBlock = tx.UnlockBlocks[unlockBlock.ReferencedIndex]
+			}
+			if unlockBlock.Type != ledgerstate.HashLockUnlockBlockType.String() {
+				continue
+			}
+			preimage, preimageErr := ledgerstate.PreimageFromBase58EncodedString(unlockBlock.Preimage)
+			if preimageErr != nil {
+				return nil, preimageErr
+			}
+			preimages = append(preimages, preimage)
+		}
+	}
+
+	return preimages, nil
+}
+
 // colorFromString is an internal utility method that parses the given string into a Color.
 func colorFromString(colorStr string) (color ledgerstate.Color) {
 	if colorStr == "IOTA" {
@@ -270,6 +270,18 @@ module.exports = {
         id: 'apis/snapshot',
       },
 
+      {
+        type: 'doc',
+        label: 'Epochs',
+        id: 'apis/epochs',
+      },
+
+      {
+        type: 'doc',
+        label: 'Database',
+        id: 'apis/database',
+      },
+
       {
         type: 'doc',
         label: 'Faucet',
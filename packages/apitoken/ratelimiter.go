@@ -0,0 +1,59 @@
+package apitoken
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the number of requests per minute of each token.
+type RateLimiter struct {
+	windows map[string]*window
+	mutex   sync.Mutex
+}
+
+// window is the number of requests of a token in the current one-minute time window.
+type window struct {
+	start    time.Time
+	requests int
+}
+
+// NewRateLimiter creates a new RateLimiter.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		windows: make(map[string]*window),
+	}
+}
+
+// Allow returns true if another request with the given key is allowed with a limit of the given number of requests
+// per minute (0 means unlimited).
+func (r *RateLimiter) Allow(key string, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	w, exists := r.windows[key]
+	if !exists || now.Sub(w.start) >= time.Minute {
+		r.cleanup(now)
+		w = &window{start: now}
+		r.windows[key] = w
+	}
+	if w.requests >= limit {
+		return false
+	}
+	w.requests++
+
+	return true
+}
+
+// cleanup removes the expired windows.
+func (r *RateLimiter) cleanup(now time.Time) {
+	for key, w := range r.windows {
+		if now.Sub(w.start) >= time.Minute {
+			delete(r.windows, key)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package apitoken
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrUnknownScope is returned if a scope name is not known.
+var ErrUnknownScope = errors.New("unknown scope")
+
+// Scope is a set of permissions granted by an API token.
+type Scope uint8
+
+const (
+	// ScopeRead grants access to the read-only queries.
+	ScopeRead Scope = 1 << iota
+	// ScopeIssue grants access to the routes that issue messages or transactions.
+	ScopeIssue
+	// ScopePeering grants access to the management of the peers.
+	ScopePeering
+	// ScopeAdmin grants access to all the routes.
+	ScopeAdmin
+)
+
+// scopeNames contains the names of the scopes.
+var scopeNames = []struct {
+	scope Scope
+	name  string
+}{
+	{ScopeRead, "read"},
+	{ScopeIssue, "issue"},
+	{ScopePeering, "peering"},
+	{ScopeAdmin, "admin"},
+}
+
+// ParseScope parses the given scope names into a Scope.
+func ParseScope(names ...string) (scope Scope, err error) {
+nextName:
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		for _, scopeName := range scopeNames {
+			if strings.EqualFold(scopeName.name, name) {
+				scope |= scopeName.scope
+				continue nextName
+			}
+		}
+		return 0, fmt.Errorf("%w: %s", ErrUnknownScope, name)
+	}
+	return scope, nil
+}
+
+// Names returns the names of the scopes contained in the Scope.
+func (s Scope) Names() (names []string) {
+	for _, scopeName := range scopeNames {
+		if s&scopeName.scope != 0 {
+			names = append(names, scopeName.name)
+		}
+	}
+	return names
+}
+
+// Allows returns true if the Scope grants the required one. The admin scope grants every other scope.
+func (s Scope) Allows(required Scope) bool {
+	return s&ScopeAdmin != 0 || s&required == required
+}
+
+// String returns a human readable version of the Scope.
+func (s Scope) String() string {
+	return strings.Join(s.Names(), ",")
+}
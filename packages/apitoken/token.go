@@ -0,0 +1,110 @@
+package apitoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// tokenVersion is the prefix of the tokens generated by this package.
+const tokenVersion = "v1"
+
+var (
+	// ErrInvalidToken is returned if a token is malformed or its signature is invalid.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenExpired is returned if a token has expired.
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Claims contains the information carried by an API token.
+type Claims struct {
+	// Subject identifies the holder of the token.
+	Subject string
+	// Scope contains the permissions granted by the token.
+	Scope Scope
+	// ExpiresAt is the time after which the token is not valid anymore (zero means no expiration).
+	ExpiresAt time.Time
+	// RateLimit is the maximum number of requests per minute allowed with the token (0 means unlimited).
+	RateLimit int
+}
+
+// jsonClaims is the serialized version of the Claims.
+type jsonClaims struct {
+	Subject   string   `json:"sub"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	RateLimit int      `json:"rateLimit,omitempty"`
+}
+
+// Issue creates a new token carrying the given claims and signed with the given secret.
+func Issue(secret []byte, claims Claims) (string, error) {
+	serializedClaims := jsonClaims{
+		Subject:   claims.Subject,
+		Scopes:    claims.Scope.Names(),
+		RateLimit: claims.RateLimit,
+	}
+	if !claims.ExpiresAt.IsZero() {
+		serializedClaims.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+
+	claimsBytes, err := json.Marshal(serializedClaims)
+	if err != nil {
+		return "", err
+	}
+
+	payload := tokenVersion + "." + base64.RawURLEncoding.EncodeToString(claimsBytes)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(sign(secret, payload)), nil
+}
+
+// Verify checks the signature and the expiration of the given token and returns its claims.
+func Verify(secret []byte, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenVersion {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+	if !hmac.Equal(signature, sign(secret, parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("%w: invalid signature", ErrInvalidToken)
+	}
+
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+	serializedClaims := &jsonClaims{}
+	if err := json.Unmarshal(claimsBytes, serializedClaims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	claims := &Claims{
+		Subject:   serializedClaims.Subject,
+		RateLimit: serializedClaims.RateLimit,
+	}
+	if claims.Scope, err = ParseScope(serializedClaims.Scopes...); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+	if serializedClaims.ExpiresAt != 0 {
+		claims.ExpiresAt = time.Unix(serializedClaims.ExpiresAt, 0)
+		if time.Now().After(claims.ExpiresAt) {
+			return nil, ErrTokenExpired
+		}
+	}
+
+	return claims, nil
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,61 @@
+package apitoken
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	secret := []byte("secret")
+	token, err := Issue(secret, Claims{
+		Subject:   "wallet",
+		Scope:     ScopeRead | ScopeIssue,
+		ExpiresAt: time.Now().Add(time.Hour),
+		RateLimit: 10,
+	})
+	require.NoError(t, err)
+
+	claims, err := Verify(secret, token)
+	require.NoError(t, err)
+	assert.Equal(t, "wallet", claims.Subject)
+	assert.Equal(t, ScopeRead|ScopeIssue, claims.Scope)
+	assert.Equal(t, 10, claims.RateLimit)
+
+	_, err = Verify([]byte("other secret"), token)
+	assert.True(t, errors.Is(err, ErrInvalidToken))
+
+	_, err = Verify(secret, token[:len(token)-2])
+	assert.True(t, errors.Is(err, ErrInvalidToken))
+
+	expiredToken, err := Issue(secret, Claims{Subject: "wallet", Scope: ScopeRead, ExpiresAt: time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+	_, err = Verify(secret, expiredToken)
+	assert.True(t, errors.Is(err, ErrTokenExpired))
+}
+
+func TestScope(t *testing.T) {
+	scope, err := ParseScope("read", "Peering")
+	require.NoError(t, err)
+	assert.Equal(t, ScopeRead|ScopePeering, scope)
+	assert.Equal(t, "read,peering", scope.String())
+
+	assert.True(t, scope.Allows(ScopeRead))
+	assert.False(t, scope.Allows(ScopeIssue))
+	assert.True(t, ScopeAdmin.Allows(ScopeIssue))
+
+	_, err = ParseScope("write")
+	assert.True(t, errors.Is(err, ErrUnknownScope))
+}
+
+func TestRateLimiter(t *testing.T) {
+	rateLimiter := NewRateLimiter()
+	assert.True(t, rateLimiter.Allow("a", 2))
+	assert.True(t, rateLimiter.Allow("a", 2))
+	assert.False(t, rateLimiter.Allow("a", 2))
+	assert.True(t, rateLimiter.Allow("b", 2))
+	assert.True(t, rateLimiter.Allow("c", 0))
+}
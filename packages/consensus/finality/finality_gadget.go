@@ -2,6 +2,8 @@ package finality
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/datastructure/walker"
@@ -18,6 +20,7 @@ import (
 type Gadget interface {
 	HandleMarker(marker *markers.Marker, aw float64) (err error)
 	HandleBranch(branchID ledgerstate.BranchID, aw float64) (err error)
+	Shutdown()
 	tangle.ConfirmationOracle
 }
 
@@ -75,11 +78,13 @@ type Options struct {
 	MessageTransFunc       MessageThresholdTranslation
 	BranchGoFReachedLevel  gof.GradeOfFinality
 	MessageGoFReachedLevel gof.GradeOfFinality
+	BranchPolicy           *Policy
+	MessagePolicy          *Policy
 }
 
 var defaultOpts = []Option{
-	WithBranchThresholdTranslation(DefaultBranchGoFTranslation),
-	WithMessageThresholdTranslation(DefaultMessageGoFTranslation),
+	WithBranchPolicy(DefaultPolicy()),
+	WithMessagePolicy(DefaultPolicy()),
 	WithBranchGoFReachedLevel(gof.High),
 	WithMessageGoFReachedLevel(gof.High),
 }
@@ -98,6 +103,22 @@ func WithBranchThresholdTranslation(f BranchThresholdTranslation) Option {
 	}
 }
 
+// WithBranchPolicy returns an Option setting the Policy used to derive the grade of finality of branches.
+func WithBranchPolicy(policy *Policy) Option {
+	return func(opts *Options) {
+		opts.BranchPolicy = policy
+		opts.BranchTransFunc = policy.BranchThresholdTranslation()
+	}
+}
+
+// WithMessagePolicy returns an Option setting the Policy used to derive the grade of finality of messages.
+func WithMessagePolicy(policy *Policy) Option {
+	return func(opts *Options) {
+		opts.MessagePolicy = policy
+		opts.MessageTransFunc = policy.MessageThresholdTranslation()
+	}
+}
+
 // WithBranchGoFReachedLevel returns an Option setting the branch reached grade of finality level.
 func WithBranchGoFReachedLevel(branchGradeOfFinality gof.GradeOfFinality) Option {
 	return func(opts *Options) {
@@ -118,6 +139,19 @@ type SimpleFinalityGadget struct {
 	tangle *tangle.Tangle
 	opts   *Options
 	events *tangle.ConfirmationEvents
+
+	// pendingMarkers and pendingBranches contain the latest approval weight of the objects whose promotion is delayed
+	// by the minimum durations of the policies.
+	pendingMarkers  map[markers.Marker]*pendingPromotion
+	pendingBranches map[ledgerstate.BranchID]*pendingPromotion
+	pendingMutex    sync.Mutex
+	shutdown        bool
+}
+
+// pendingPromotion is a delayed promotion of a marker or branch.
+type pendingPromotion struct {
+	aw    float64
+	timer *time.Timer
 }
 
 // NewSimpleFinalityGadget creates a new SimpleFinalityGadget.
@@ -126,10 +160,13 @@ func NewSimpleFinalityGadget(t *tangle.Tangle, opts ...Option) *SimpleFinalityGa
 		tangle: t,
 		opts:   &Options{},
 		events: &tangle.ConfirmationEvents{
-			MessageConfirmed:     events.NewEvent(tangle.MessageIDCaller),
-			TransactionConfirmed: events.NewEvent(ledgerstate.TransactionIDEventHandler),
-			BranchConfirmed:      events.NewEvent(ledgerstate.BranchIDEventHandler),
+			MessageConfirmed:      events.NewEvent(tangle.MessageIDCaller),
+			TransactionConfirmed:  events.NewEvent(ledgerstate.TransactionIDEventHandler),
+			BranchConfirmed:       events.NewEvent(ledgerstate.BranchIDEventHandler),
+			TransactionGoFChanged: events.NewEvent(tangle.TransactionGoFChangedCaller),
 		},
+		pendingMarkers:  make(map[markers.Marker]*pendingPromotion),
+		pendingBranches: make(map[ledgerstate.BranchID]*pendingPromotion),
 	}
 
 	for _, defOpt := range defaultOpts {
@@ -142,6 +179,16 @@ func NewSimpleFinalityGadget(t *tangle.Tangle, opts ...Option) *SimpleFinalityGa
 	return sfg
 }
 
+// BranchPolicy returns the Policy used to derive the grade of finality of branches.
+func (s *SimpleFinalityGadget) BranchPolicy() *Policy {
+	return s.opts.BranchPolicy
+}
+
+// MessagePolicy returns the Policy used to derive the grade of finality of messages.
+func (s *SimpleFinalityGadget) MessagePolicy() *Policy {
+	return s.opts.MessagePolicy
+}
+
 // Events returns the events this gadget exposes.
 func (s *SimpleFinalityGadget) Events() *tangle.ConfirmationEvents {
 	return s.events
@@ -212,11 +259,16 @@ func (s *SimpleFinalityGadget) HandleMarker(marker *markers.Marker, aw float64)
 
 	// check that we're updating the GoF
 	var gofIncreased bool
+	var retryAfter time.Duration
 	s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
 		if gradeOfFinality > messageMetadata.GradeOfFinality() {
-			gofIncreased = true
+			gradeOfFinality, retryAfter = s.opts.MessagePolicy.Promote(messageMetadata.GradeOfFinality(), messageMetadata.GradeOfFinalityTime(), gradeOfFinality, time.Now())
+			gofIncreased = gradeOfFinality > messageMetadata.GradeOfFinality()
 		}
 	})
+	if retryAfter > 0 {
+		s.retryMarkerLater(marker, aw, retryAfter)
+	}
 	if !gofIncreased {
 		return
 	}
@@ -256,6 +308,14 @@ func (s *SimpleFinalityGadget) HandleMarker(marker *markers.Marker, aw float64)
 func (s *SimpleFinalityGadget) HandleBranch(branchID ledgerstate.BranchID, aw float64) (err error) {
 	newGradeOfFinality := s.opts.BranchTransFunc(branchID, aw)
 
+	var retryAfter time.Duration
+	s.tangle.LedgerState.UTXODAG.CachedTransactionMetadata(branchID.TransactionID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		newGradeOfFinality, retryAfter = s.opts.BranchPolicy.Promote(transactionMetadata.GradeOfFinality(), transactionMetadata.GradeOfFinalityTime(), newGradeOfFinality, time.Now())
+	})
+	if retryAfter > 0 {
+		s.retryBranchLater(branchID, aw, retryAfter)
+	}
+
 	// update GoF of txs within the same branch
 	txGoFPropWalker := walker.New()
 	s.tangle.LedgerState.UTXODAG.CachedTransactionMetadata(branchID.TransactionID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
@@ -272,6 +332,84 @@ func (s *SimpleFinalityGadget) HandleBranch(branchID ledgerstate.BranchID, aw fl
 	return err
 }
 
+// Shutdown stops the delayed promotions, so they do not access the tangle after it was shut down.
+func (s *SimpleFinalityGadget) Shutdown() {
+	s.pendingMutex.Lock()
+	defer s.pendingMutex.Unlock()
+
+	s.shutdown = true
+	for marker, pending := range s.pendingMarkers {
+		pending.timer.Stop()
+		delete(s.pendingMarkers, marker)
+	}
+	for branchID, pending := range s.pendingBranches {
+		pending.timer.Stop()
+		delete(s.pendingBranches, branchID)
+	}
+}
+
+// retryMarkerLater handles the marker again after the given delay, using the latest approval weight it received.
+func (s *SimpleFinalityGadget) retryMarkerLater(marker *markers.Marker, aw float64, delay time.Duration) {
+	s.pendingMutex.Lock()
+	defer s.pendingMutex.Unlock()
+
+	if s.shutdown {
+		return
+	}
+	if pending, scheduled := s.pendingMarkers[*marker]; scheduled {
+		pending.aw = aw
+		return
+	}
+
+	pending := &pendingPromotion{aw: aw}
+	pending.timer = time.AfterFunc(delay, func() {
+		s.pendingMutex.Lock()
+		if s.pendingMarkers[*marker] != pending {
+			s.pendingMutex.Unlock()
+			return
+		}
+		latestAW := pending.aw
+		delete(s.pendingMarkers, *marker)
+		s.pendingMutex.Unlock()
+
+		if err := s.HandleMarker(marker, latestAW); err != nil {
+			s.tangle.Events.Error.Trigger(errors.Wrapf(err, "failed to promote marker %s", marker))
+		}
+	})
+	s.pendingMarkers[*marker] = pending
+}
+
+// retryBranchLater handles the branch again after the given delay, using the latest approval weight it received.
+func (s *SimpleFinalityGadget) retryBranchLater(branchID ledgerstate.BranchID, aw float64, delay time.Duration) {
+	s.pendingMutex.Lock()
+	defer s.pendingMutex.Unlock()
+
+	if s.shutdown {
+		return
+	}
+	if pending, scheduled := s.pendingBranches[branchID]; scheduled {
+		pending.aw = aw
+		return
+	}
+
+	pending := &pendingPromotion{aw: aw}
+	pending.timer = time.AfterFunc(delay, func() {
+		s.pendingMutex.Lock()
+		if s.pendingBranches[branchID] != pending {
+			s.pendingMutex.Unlock()
+			return
+		}
+		latestAW := pending.aw
+		delete(s.pendingBranches, branchID)
+		s.pendingMutex.Unlock()
+
+		if err := s.HandleBranch(branchID, latestAW); err != nil {
+			s.tangle.Events.Error.Trigger(errors.Wrapf(err, "failed to promote branch %s", branchID))
+		}
+	})
+	s.pendingBranches[branchID] = pending
+}
+
 func (s *SimpleFinalityGadget) forwardPropagateBranchGoFToTxs(candidateTxID ledgerstate.TransactionID, candidateBranchID ledgerstate.BranchID, newGradeOfFinality gof.GradeOfFinality, txGoFPropWalker *walker.Walker) bool {
 	return s.tangle.LedgerState.UTXODAG.CachedTransactionMetadata(candidateTxID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 		// we stop if we walk outside our branch
@@ -302,6 +440,7 @@ func (s *SimpleFinalityGadget) updateTransactionGoF(transactionMetadata *ledgers
 	if !transactionMetadata.SetGradeOfFinality(newGradeOfFinality) {
 		return
 	}
+	s.events.TransactionGoFChanged.Trigger(transactionMetadata.ID(), newGradeOfFinality)
 
 	s.tangle.LedgerState.UTXODAG.CachedTransaction(transactionMetadata.ID()).Consume(func(transaction *ledgerstate.Transaction) {
 		// we use a set of consumer txs as our candidate tx can consume multiple outputs from the same txs,
@@ -375,6 +514,7 @@ func (s *SimpleFinalityGadget) setPayloadGoF(messageID tangle.MessageID, gradeOf
 			if !transactionMetadata.SetGradeOfFinality(gradeOfFinality) {
 				return
 			}
+			s.Events().TransactionGoFChanged.Trigger(transactionID, gradeOfFinality)
 
 			// set GoF in outputs
 			s.tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
@@ -2,6 +2,7 @@ package finality
 
 import (
 	"testing"
+	"time"
 
 	"github.com/iotaledger/hive.go/events"
 	"github.com/stretchr/testify/assert"
@@ -376,6 +377,43 @@ func TestSimpleFinalityGadget(t *testing.T) {
 	}
 }
 
+func TestSimpleFinalityGadget_MinDurations(t *testing.T) {
+	testTangle := tangle.NewTestTangle(
+		tangle.SolidifierConfig(tangle.SolidifierParams{MaxParentsTimeDifference: 30 * time.Minute}),
+		tangle.TipManagerConfig(tangle.TipManagerParams{MinParentsCount: 1, MaxParentsCount: 8}),
+	)
+	defer testTangle.Shutdown()
+	testTangle.Setup()
+
+	testFramework := tangle.NewMessageTestFramework(testTangle)
+	testFramework.CreateMessage("Message1", tangle.WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message2", tangle.WithStrongParents("Message1"))
+	testFramework.IssueMessages("Message1").WaitMessagesBooked()
+	testFramework.IssueMessages("Message2").WaitMessagesBooked()
+
+	policy := &Policy{
+		Thresholds:   Thresholds{Low: testingLowBound, Medium: testingMediumBound, High: testingHighBound},
+		MinDurations: map[gof.GradeOfFinality]time.Duration{gof.Low: 200 * time.Millisecond},
+	}
+	fg := NewSimpleFinalityGadget(testTangle, WithMessagePolicy(policy), WithBranchPolicy(policy))
+
+	// the message stays at gof.Low for the minimum duration before it is promoted to the grade of its approval weight
+	require.NoError(t, fg.HandleMarker(testFramework.MessageMetadata("Message1").StructureDetails().PastMarkers.Marker(), 0.6))
+	assertMsgsGoFs(t, testFramework, map[gof.GradeOfFinality][]string{gof.Low: {"Message1"}})
+
+	assert.Eventually(t, func() bool {
+		return testFramework.MessageMetadata("Message1").GradeOfFinality() == gof.High
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// pending promotions are dropped on shutdown
+	require.NoError(t, fg.HandleMarker(testFramework.MessageMetadata("Message2").StructureDetails().PastMarkers.Marker(), 0.6))
+	assertMsgsGoFs(t, testFramework, map[gof.GradeOfFinality][]string{gof.Low: {"Message2"}})
+	fg.Shutdown()
+
+	time.Sleep(400 * time.Millisecond)
+	assertMsgsGoFs(t, testFramework, map[gof.GradeOfFinality][]string{gof.Low: {"Message2"}})
+}
+
 func assertMsgsGoFs(t *testing.T, testFramework *tangle.MessageTestFramework, expected map[gof.GradeOfFinality][]string) {
 	for expectedGoF, msgAliases := range expected {
 		for _, msgAlias := range msgAliases {
@@ -0,0 +1,115 @@
+package finality
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// ErrInvalidPolicy is returned when a Policy contains invalid thresholds or durations.
+var ErrInvalidPolicy = errors.New("invalid finality policy")
+
+// Thresholds defines the approval weight lower bounds of the grades of finality.
+type Thresholds struct {
+	Low    float64
+	Medium float64
+	High   float64
+}
+
+// DefaultThresholds are the approval weight lower bounds used by the default translations.
+var DefaultThresholds = Thresholds{
+	Low:    lowLowerBound,
+	Medium: mediumLowerBound,
+	High:   highLowerBound,
+}
+
+// GradeOfFinality translates the given approval weight to a gof.GradeOfFinality.
+func (t Thresholds) GradeOfFinality(aw float64) gof.GradeOfFinality {
+	switch {
+	case aw >= t.High:
+		return gof.High
+	case aw >= t.Medium:
+		return gof.Medium
+	case aw >= t.Low:
+		return gof.Low
+	default:
+		return gof.None
+	}
+}
+
+// Validate checks that the thresholds are ascending and within the range of approval weights.
+func (t Thresholds) Validate() error {
+	if t.Low < 0 || t.Low > t.Medium || t.Medium > t.High || t.High > 1 {
+		return errors.Wrapf(ErrInvalidPolicy, "thresholds must satisfy 0 <= low <= medium <= high <= 1, got %.2f/%.2f/%.2f", t.Low, t.Medium, t.High)
+	}
+	return nil
+}
+
+// Policy defines how approval weight is translated to grades of finality. Besides the thresholds it can require an
+// object to stay for a minimum time at a grade of finality before it is promoted to a higher one.
+type Policy struct {
+	Thresholds Thresholds
+	// MinDurations contains the minimum time an object has to spend at a grade of finality before it is promoted.
+	MinDurations map[gof.GradeOfFinality]time.Duration
+}
+
+// DefaultPolicy returns the Policy used if nothing else is configured. It uses the DefaultThresholds and promotes
+// objects immediately.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Thresholds: DefaultThresholds,
+	}
+}
+
+// Validate checks that the Policy is valid.
+func (p *Policy) Validate() error {
+	if err := p.Thresholds.Validate(); err != nil {
+		return err
+	}
+	for gradeOfFinality, duration := range p.MinDurations {
+		if gradeOfFinality > gof.High || duration < 0 {
+			return errors.Wrapf(ErrInvalidPolicy, "invalid minimum duration %s for %s", duration, gradeOfFinality)
+		}
+	}
+	return nil
+}
+
+// BranchThresholdTranslation returns the BranchThresholdTranslation of the Policy.
+func (p *Policy) BranchThresholdTranslation() BranchThresholdTranslation {
+	return func(_ ledgerstate.BranchID, aw float64) gof.GradeOfFinality {
+		return p.Thresholds.GradeOfFinality(aw)
+	}
+}
+
+// MessageThresholdTranslation returns the MessageThresholdTranslation of the Policy.
+func (p *Policy) MessageThresholdTranslation() MessageThresholdTranslation {
+	return p.Thresholds.GradeOfFinality
+}
+
+// Promote determines the grade of finality an object can be promoted to right now, given its current grade of
+// finality, the time it reached it and the grade of finality derived from its approval weight. If the target can't be
+// reached yet because of the minimum durations, the time after which the promotion should be retried is returned.
+func (p *Policy) Promote(current gof.GradeOfFinality, reachedAt time.Time, target gof.GradeOfFinality, now time.Time) (promoted gof.GradeOfFinality, retryAfter time.Duration) {
+	if target <= current || len(p.MinDurations) == 0 {
+		return target, 0
+	}
+
+	// the time spent at None is unknown, so it never delays a promotion
+	if minDuration := p.MinDurations[current]; current != gof.None && minDuration > 0 {
+		if elapsed := now.Sub(reachedAt); elapsed < minDuration {
+			return current, minDuration - elapsed
+		}
+	}
+
+	promoted = current + 1
+	for promoted < target && p.MinDurations[promoted] == 0 {
+		promoted++
+	}
+	if promoted < target {
+		retryAfter = p.MinDurations[promoted]
+	}
+	return promoted, retryAfter
+}
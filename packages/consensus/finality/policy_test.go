@@ -0,0 +1,60 @@
+package finality
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+)
+
+func TestThresholds(t *testing.T) {
+	thresholds := Thresholds{Low: 0.3, Medium: 0.5, High: 0.8}
+	assert.NoError(t, thresholds.Validate())
+	assert.Equal(t, gof.None, thresholds.GradeOfFinality(0.29))
+	assert.Equal(t, gof.Low, thresholds.GradeOfFinality(0.3))
+	assert.Equal(t, gof.Medium, thresholds.GradeOfFinality(0.79))
+	assert.Equal(t, gof.High, thresholds.GradeOfFinality(0.8))
+
+	for _, aw := range []float64{0, 0.25, 0.45, 0.5, 0.67, 1} {
+		assert.Equal(t, DefaultMessageGoFTranslation(aw), DefaultThresholds.GradeOfFinality(aw))
+	}
+
+	assert.ErrorIs(t, Thresholds{Low: 0.5, Medium: 0.4, High: 0.8}.Validate(), ErrInvalidPolicy)
+	assert.ErrorIs(t, Thresholds{Low: 0.3, Medium: 0.5, High: 1.2}.Validate(), ErrInvalidPolicy)
+}
+
+func TestPolicy_Promote(t *testing.T) {
+	now := time.Now()
+
+	// without minimum durations the target is reached immediately
+	promoted, retryAfter := DefaultPolicy().Promote(gof.None, time.Time{}, gof.High, now)
+	assert.Equal(t, gof.High, promoted)
+	assert.Zero(t, retryAfter)
+
+	policy := &Policy{
+		Thresholds:   DefaultThresholds,
+		MinDurations: map[gof.GradeOfFinality]time.Duration{gof.Medium: 10 * time.Second},
+	}
+
+	// promotion stops at Medium, which needs to be held for the minimum duration
+	promoted, retryAfter = policy.Promote(gof.None, time.Time{}, gof.High, now)
+	assert.Equal(t, gof.Medium, promoted)
+	assert.Equal(t, 10*time.Second, retryAfter)
+
+	// the minimum duration at Medium has not passed yet
+	promoted, retryAfter = policy.Promote(gof.Medium, now.Add(-4*time.Second), gof.High, now)
+	assert.Equal(t, gof.Medium, promoted)
+	assert.Equal(t, 6*time.Second, retryAfter)
+
+	// the minimum duration at Medium has passed
+	promoted, retryAfter = policy.Promote(gof.Medium, now.Add(-10*time.Second), gof.High, now)
+	assert.Equal(t, gof.High, promoted)
+	assert.Zero(t, retryAfter)
+
+	// demotions are never delayed
+	promoted, retryAfter = policy.Promote(gof.Medium, now, gof.Low, now)
+	assert.Equal(t, gof.Low, promoted)
+	assert.Zero(t, retryAfter)
+}
@@ -0,0 +1,47 @@
+package firstseen
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// SeenTimeFunc returns the time at which the given branch was first seen by the node. A zero time means that the
+// branch is unknown.
+type SeenTimeFunc func(branchID ledgerstate.BranchID) (seenTime time.Time)
+
+// FirstSeenWins is a deterministic baseline implementation of consensus.Mechanism. Out of every conflict set the
+// branch that was seen first is liked, independently of the approval weight it receives later on. If two branches were
+// seen at the same time, the branch with the lower lexical bytes is liked.
+type FirstSeenWins struct {
+	*otv.OnTangleVoting
+
+	seenTimeFunc SeenTimeFunc
+}
+
+// NewFirstSeenWins is the constructor for FirstSeenWins.
+func NewFirstSeenWins(branchDAG *ledgerstate.BranchDAG, seenTimeFunc SeenTimeFunc) *FirstSeenWins {
+	f := &FirstSeenWins{
+		seenTimeFunc: seenTimeFunc,
+	}
+	f.OnTangleVoting = otv.NewOnTangleVoting(branchDAG, nil, otv.WithPreferenceFunc(f.seenBefore))
+	return f
+}
+
+// seenBefore checks whether branchA was seen before branchB. Unknown branches are treated as seen last.
+func (f *FirstSeenWins) seenBefore(_ ledgerstate.ConflictID, branchA, branchB ledgerstate.BranchID) bool {
+	seenA := f.seenTimeFunc(branchA)
+	seenB := f.seenTimeFunc(branchB)
+	switch {
+	case seenA.Equal(seenB):
+		return bytes.Compare(branchA.Bytes(), branchB.Bytes()) <= 0
+	case seenA.IsZero():
+		return false
+	case seenB.IsZero():
+		return true
+	default:
+		return seenA.Before(seenB)
+	}
+}
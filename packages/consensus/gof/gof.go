@@ -1,5 +1,10 @@
 package gof
 
+import (
+	"fmt"
+	"strings"
+)
+
 // GradeOfFinality defines the grade of finality of an object.
 type GradeOfFinality uint8
 
@@ -27,3 +32,19 @@ func (gof GradeOfFinality) String() string {
 func (gof GradeOfFinality) Bytes() []byte {
 	return []byte{byte(gof)}
 }
+
+// ParseGradeOfFinality parses the given (case-insensitive) name of a GradeOfFinality, e.g. "high".
+func ParseGradeOfFinality(name string) (gradeOfFinality GradeOfFinality, err error) {
+	switch strings.ToLower(name) {
+	case "none":
+		return None, nil
+	case "low":
+		return Low, nil
+	case "medium":
+		return Medium, nil
+	case "high":
+		return High, nil
+	default:
+		return None, fmt.Errorf("unknown grade of finality: %s", name)
+	}
+}
@@ -0,0 +1,113 @@
+package hysteresis
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/consensus"
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// WeightThreshold is an implementation of consensus.Mechanism that likes the heaviest branch of every conflict set,
+// like OnTangleVoting does, but adds hysteresis to the decision: once a branch is liked, the opinion only flips to a
+// conflicting branch if its approval weight exceeds the weight of the liked branch by more than the configured delta.
+// This prevents the opinion from oscillating between branches with almost equal weight. The incumbents are only
+// updated by UpdateIncumbents, so retrieving the opinion has no side effects.
+type WeightThreshold struct {
+	*otv.OnTangleVoting
+
+	branchDAG  *ledgerstate.BranchDAG
+	weightFunc consensus.WeightFunc
+	delta      float64
+
+	// incumbents contains the currently liked branch of every conflict set that was evaluated so far.
+	incumbents      map[ledgerstate.ConflictID]ledgerstate.BranchID
+	incumbentsMutex sync.RWMutex
+}
+
+// NewWeightThreshold is the constructor for WeightThreshold.
+func NewWeightThreshold(branchDAG *ledgerstate.BranchDAG, weightFunc consensus.WeightFunc, delta float64) *WeightThreshold {
+	w := &WeightThreshold{
+		branchDAG:  branchDAG,
+		weightFunc: weightFunc,
+		delta:      delta,
+		incumbents: make(map[ledgerstate.ConflictID]ledgerstate.BranchID),
+	}
+	w.OnTangleVoting = otv.NewOnTangleVoting(branchDAG, weightFunc, otv.WithPreferenceFunc(w.prefers))
+	return w
+}
+
+// UpdateIncumbents re-evaluates the incumbents of the conflict sets of the given branch. It is called whenever the
+// approval weight of the branch changes: a conflicting branch replaces the incumbent if its weight exceeds the weight of
+// the incumbent by more than the delta.
+func (w *WeightThreshold) UpdateIncumbents(branchID ledgerstate.BranchID) {
+	w.branchDAG.Branch(branchID).Consume(func(branch ledgerstate.Branch) {
+		conflictBranch, ok := branch.(*ledgerstate.ConflictBranch)
+		if !ok {
+			return
+		}
+
+		w.incumbentsMutex.Lock()
+		defer w.incumbentsMutex.Unlock()
+		for conflictID := range conflictBranch.Conflicts() {
+			heaviest := w.heaviestMember(conflictID)
+			incumbent, exists := w.incumbents[conflictID]
+			if !exists || (heaviest != incumbent && w.weightFunc(heaviest) > w.weightFunc(incumbent)+w.delta) {
+				w.incumbents[conflictID] = heaviest
+			}
+		}
+	})
+}
+
+// PruneConflicts removes the incumbents of the conflict sets of the given branch. It is called when the branch is
+// confirmed, as its conflict sets are resolved and need no further evaluation.
+func (w *WeightThreshold) PruneConflicts(branchID ledgerstate.BranchID) {
+	w.branchDAG.Branch(branchID).Consume(func(branch ledgerstate.Branch) {
+		conflictBranch, ok := branch.(*ledgerstate.ConflictBranch)
+		if !ok {
+			return
+		}
+
+		w.incumbentsMutex.Lock()
+		defer w.incumbentsMutex.Unlock()
+		for conflictID := range conflictBranch.Conflicts() {
+			delete(w.incumbents, conflictID)
+		}
+	})
+}
+
+// prefers checks whether branchA is preferred over branchB in the given conflict set. The incumbent of the conflict
+// set is preferred over all other members, all other comparisons are decided by the approval weight.
+func (w *WeightThreshold) prefers(conflictID ledgerstate.ConflictID, branchA, branchB ledgerstate.BranchID) bool {
+	w.incumbentsMutex.RLock()
+	incumbent, exists := w.incumbents[conflictID]
+	w.incumbentsMutex.RUnlock()
+
+	switch {
+	case exists && incumbent == branchA:
+		return true
+	case exists && incumbent == branchB:
+		return false
+	default:
+		return w.weighsMore(branchA, branchB)
+	}
+}
+
+// heaviestMember returns the member of the given conflict set with the highest approval weight.
+func (w *WeightThreshold) heaviestMember(conflictID ledgerstate.ConflictID) (heaviest ledgerstate.BranchID) {
+	w.branchDAG.ConflictMembers(conflictID).Consume(func(conflictMember *ledgerstate.ConflictMember) {
+		if heaviest == ledgerstate.UndefinedBranchID || w.weighsMore(conflictMember.BranchID(), heaviest) {
+			heaviest = conflictMember.BranchID()
+		}
+	})
+	return heaviest
+}
+
+// weighsMore checks whether branchA is heavier than branchB. If they have equal weight, the branch with lower lexical
+// bytes is considered heavier.
+func (w *WeightThreshold) weighsMore(branchA, branchB ledgerstate.BranchID) bool {
+	weightA := w.weightFunc(branchA)
+	weightB := w.weightFunc(branchB)
+	return weightA > weightB || (weightA == weightB && bytes.Compare(branchA.Bytes(), branchB.Bytes()) <= 0)
+}
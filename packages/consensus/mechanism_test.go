@@ -0,0 +1,188 @@
+package consensus_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus"
+	"github.com/iotaledger/goshimmer/packages/consensus/firstseen"
+	"github.com/iotaledger/goshimmer/packages/consensus/hysteresis"
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const testingHysteresisDelta = 0.15
+
+// mechanismScenario is a tangle with weighted nodes whose approval weights are shared by all the mechanisms.
+type mechanismScenario struct {
+	tangle        *tangle.Tangle
+	testFramework *tangle.MessageTestFramework
+	nodes         tangle.NodeIdentities
+	mechanisms    map[string]consensus.Mechanism
+}
+
+// newMechanismScenario creates a tangle where the nodes A, B, C, D and E own 30%, 15%, 25%, 20% and 10% of the
+// consensus mana.
+func newMechanismScenario(t *testing.T) *mechanismScenario {
+	s := &mechanismScenario{nodes: make(tangle.NodeIdentities)}
+	for _, node := range []string{"A", "B", "C", "D", "E"} {
+		s.nodes[node] = identity.GenerateIdentity()
+	}
+
+	var weightProvider *tangle.CManaWeightProvider
+	manaRetrieverMock := func() map[identity.ID]float64 {
+		for _, node := range s.nodes {
+			weightProvider.Update(time.Now(), node.ID())
+		}
+		return map[identity.ID]float64{
+			s.nodes["A"].ID(): 30,
+			s.nodes["B"].ID(): 15,
+			s.nodes["C"].ID(): 25,
+			s.nodes["D"].ID(): 20,
+			s.nodes["E"].ID(): 10,
+		}
+	}
+	weightProvider = tangle.NewCManaWeightProvider(manaRetrieverMock, time.Now)
+
+	s.tangle = tangle.NewTestTangle(
+		tangle.ApprovalWeights(weightProvider),
+		tangle.SolidifierConfig(tangle.SolidifierParams{MaxParentsTimeDifference: 30 * time.Minute}),
+		tangle.TipManagerConfig(tangle.TipManagerParams{MinParentsCount: 1, MaxParentsCount: 8}),
+	)
+	t.Cleanup(s.tangle.Shutdown)
+	s.tangle.Setup()
+
+	s.testFramework = tangle.NewMessageTestFramework(s.tangle, tangle.WithGenesisOutput("G", 500))
+
+	weightThreshold := hysteresis.NewWeightThreshold(s.tangle.LedgerState.BranchDAG, s.tangle.ApprovalWeightManager.WeightOfBranch, testingHysteresisDelta)
+	s.tangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(events.NewClosure(func(event *tangle.BranchWeightChangedEvent) {
+		weightThreshold.UpdateIncumbents(event.BranchID)
+	}))
+
+	s.mechanisms = map[string]consensus.Mechanism{
+		"otv": otv.NewOnTangleVoting(s.tangle.LedgerState.BranchDAG, s.tangle.ApprovalWeightManager.WeightOfBranch),
+		"firstSeen": firstseen.NewFirstSeenWins(s.tangle.LedgerState.BranchDAG, func(branchID ledgerstate.BranchID) (seenTime time.Time) {
+			s.tangle.LedgerState.TransactionMetadata(ledgerstate.TransactionID(branchID)).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+				seenTime = transactionMetadata.SolidificationTime()
+			})
+			return seenTime
+		}),
+		"hysteresis": weightThreshold,
+	}
+
+	return s
+}
+
+// issue creates and issues a message of the given node and waits until its approval weight has been processed.
+func (s *mechanismScenario) issue(messageAlias, node string, options ...tangle.MessageOption) {
+	s.testFramework.CreateMessage(messageAlias, append(options, tangle.WithIssuer(s.nodes[node].PublicKey()))...)
+	s.testFramework.IssueMessages(messageAlias).WaitApprovalWeightProcessed()
+}
+
+// branchID returns the BranchID of the transaction of the given message.
+func (s *mechanismScenario) branchID(messageAlias string) ledgerstate.BranchID {
+	return ledgerstate.NewBranchID(s.testFramework.TransactionID(messageAlias))
+}
+
+// assertLiked asserts that the given mechanism likes the expected branch instead of each of the given branches.
+func (s *mechanismScenario) assertLiked(t *testing.T, mechanismName string, expectedLiked ledgerstate.BranchID, branchIDs ...ledgerstate.BranchID) {
+	for _, branchID := range branchIDs {
+		liked, _, err := s.mechanisms[mechanismName].Opinion(ledgerstate.NewBranchIDs(branchID))
+		require.NoError(t, err)
+		assert.Equal(t, ledgerstate.NewBranchIDs(expectedLiked), liked, "%s: liked branches of %s", mechanismName, branchID)
+	}
+}
+
+// assertBranchWeights asserts the approval weights of the two conflicting branches of the scenario.
+func (s *mechanismScenario) assertBranchWeights(t *testing.T, weight1, weight2 float64) {
+	assert.InDelta(t, weight1, s.tangle.ApprovalWeightManager.WeightOfBranch(s.branchID("Message1")), 0.001)
+	assert.InDelta(t, weight2, s.tangle.ApprovalWeightManager.WeightOfBranch(s.branchID("Message2")), 0.001)
+}
+
+func TestMechanisms(t *testing.T) {
+	s := newMechanismScenario(t)
+
+	steps := []struct {
+		issue   func()
+		weights [2]float64
+		liked   map[string]string
+	}{
+		// A and B issue conflicting transactions, the one of A is seen first and is heavier
+		{
+			issue: func() {
+				s.issue("Message1", "A", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("A", 500))
+				s.issue("Message2", "B", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("B", 500))
+			},
+			weights: [2]float64{0.30, 0.15},
+			liked:   map[string]string{"otv": "Message1", "firstSeen": "Message1", "hysteresis": "Message1"},
+		},
+		// C supports the transaction of B, which becomes heavier but not by more than the delta
+		{
+			issue: func() {
+				s.issue("Message3", "C", tangle.WithStrongParents("Message2"))
+			},
+			weights: [2]float64{0.30, 0.40},
+			liked:   map[string]string{"otv": "Message2", "firstSeen": "Message1", "hysteresis": "Message1"},
+		},
+		// D supports the transaction of B, which exceeds the weight of the other one by more than the delta
+		{
+			issue: func() {
+				s.issue("Message4", "D", tangle.WithStrongParents("Message2"))
+			},
+			weights: [2]float64{0.30, 0.60},
+			liked:   map[string]string{"otv": "Message2", "firstSeen": "Message1", "hysteresis": "Message2"},
+		},
+		// D moves its support back to the transaction of A, which becomes heavier but not by more than the delta
+		{
+			issue: func() {
+				s.issue("Message5", "D", tangle.WithStrongParents("Message1"))
+			},
+			weights: [2]float64{0.50, 0.40},
+			liked:   map[string]string{"otv": "Message1", "firstSeen": "Message1", "hysteresis": "Message2"},
+		},
+		// C moves its support to the transaction of A, which exceeds the weight of the other one by more than the delta
+		{
+			issue: func() {
+				s.issue("Message6", "C", tangle.WithStrongParents("Message1"))
+			},
+			weights: [2]float64{0.75, 0.15},
+			liked:   map[string]string{"otv": "Message1", "firstSeen": "Message1", "hysteresis": "Message1"},
+		},
+	}
+
+	for i, step := range steps {
+		t.Logf("step %d", i)
+		step.issue()
+		s.assertBranchWeights(t, step.weights[0], step.weights[1])
+
+		// the opinion is retrieved repeatedly, as retrieving it must not change it
+		for j := 0; j < 2; j++ {
+			for mechanismName, likedMessage := range step.liked {
+				s.assertLiked(t, mechanismName, s.branchID(likedMessage), s.branchID("Message1"), s.branchID("Message2"))
+			}
+		}
+	}
+}
+
+func TestMechanisms_HysteresisPruneConflicts(t *testing.T) {
+	s := newMechanismScenario(t)
+	weightThreshold := s.mechanisms["hysteresis"].(*hysteresis.WeightThreshold)
+
+	s.issue("Message1", "A", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("A", 500))
+	s.issue("Message2", "B", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("B", 500))
+	s.issue("Message3", "C", tangle.WithStrongParents("Message2"))
+	s.assertBranchWeights(t, 0.30, 0.40)
+
+	// the transaction of A stays the incumbent of the conflict set
+	s.assertLiked(t, "hysteresis", s.branchID("Message1"), s.branchID("Message2"))
+
+	// once the conflict set is pruned, the heaviest branch is liked
+	weightThreshold.PruneConflicts(s.branchID("Message2"))
+	s.assertLiked(t, "hysteresis", s.branchID("Message2"), s.branchID("Message1"), s.branchID("Message2"))
+}
@@ -13,16 +13,38 @@ import (
 // Nakamoto consensus for the parallel-reality-based ledger state where the heaviest branch according to approval weight
 // is liked by any given node.
 type OnTangleVoting struct {
-	branchDAG  *ledgerstate.BranchDAG
-	weightFunc consensus.WeightFunc
+	branchDAG      *ledgerstate.BranchDAG
+	weightFunc     consensus.WeightFunc
+	preferenceFunc PreferenceFunc
+}
+
+// PreferenceFunc returns true if branchA is preferred over branchB, with which it conflicts in the given conflict set.
+type PreferenceFunc func(conflictID ledgerstate.ConflictID, branchA, branchB ledgerstate.BranchID) bool
+
+// Option is a function setting an option of OnTangleVoting.
+type Option func(o *OnTangleVoting)
+
+// WithPreferenceFunc returns an Option that replaces the approval weight based comparison of conflicting branches with
+// the given PreferenceFunc. This allows to reuse the traversal of the BranchDAG for other voting rules.
+func WithPreferenceFunc(preferenceFunc PreferenceFunc) Option {
+	return func(o *OnTangleVoting) {
+		o.preferenceFunc = preferenceFunc
+	}
 }
 
 // NewOnTangleVoting is the constructor for OnTangleVoting.
-func NewOnTangleVoting(branchDAG *ledgerstate.BranchDAG, weightFunc consensus.WeightFunc) *OnTangleVoting {
-	return &OnTangleVoting{
+func NewOnTangleVoting(branchDAG *ledgerstate.BranchDAG, weightFunc consensus.WeightFunc, opts ...Option) *OnTangleVoting {
+	o := &OnTangleVoting{
 		branchDAG:  branchDAG,
 		weightFunc: weightFunc,
 	}
+	o.preferenceFunc = func(_ ledgerstate.ConflictID, branchA, branchB ledgerstate.BranchID) bool {
+		return o.weighsMore(branchA, branchB)
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
 }
 
 // Opinion splits the given branch IDs by examining all the conflict sets for each branch and checking whether
@@ -125,7 +147,7 @@ func (o *OnTangleVoting) doILike(branchID ledgerstate.BranchID, visitedConflicts
 			}
 
 			if o.doILike(conflictBranchID, innerVisitedConflicts) {
-				if !o.weighsMore(branchID, conflictBranchID) {
+				if !o.preferenceFunc(conflictSet, branchID, conflictBranchID) {
 					cachedConflictMembers.Release()
 					return false
 				}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/objectstorage"
+)
+
+// region CachedObjects ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CachedObjects collects the objects that are kept in the caches of object storages, so they can be written to a
+// checkpoint of the database after it was created. Besides the objects that were not persisted, yet, it collects the
+// keys of the cached objects that are marked as deleted, as their stale copies are still part of the checkpoint.
+type CachedObjects struct {
+	source      kvstore.KVStore
+	objects     kvstore.KVStore
+	deletedKeys kvstore.KVStore
+}
+
+// NewCachedObjects creates a new CachedObjects for a checkpoint of the database with the given store.
+func NewCachedObjects(source kvstore.KVStore) *CachedObjects {
+	return &CachedObjects{
+		source:      source,
+		objects:     mapdb.NewMapDB(),
+		deletedKeys: mapdb.NewMapDB(),
+	}
+}
+
+// Set adds an entry that is not kept in an object storage (e.g. a counter of a component) to the collected objects.
+func (c *CachedObjects) Set(key kvstore.Key, value kvstore.Value) error {
+	return c.objects.Set(key, value)
+}
+
+// WriteTo writes the collected objects to the given store and deletes the objects that are marked as deleted from it.
+func (c *CachedObjects) WriteTo(target kvstore.KVStore) (err error) {
+	if _, err = CopyStore(target, c.objects, kvstore.EmptyPrefix); err != nil {
+		return errors.Errorf("failed to write cached objects: %w", err)
+	}
+
+	batch := target.Batched()
+	if iterateErr := c.deletedKeys.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		err = batch.Delete(copyBytes(key))
+
+		return err == nil
+	}); iterateErr != nil {
+		err = iterateErr
+	}
+	if err != nil {
+		batch.Cancel()
+		return errors.Errorf("failed to delete cached objects that are marked as deleted: %w", err)
+	}
+
+	if err = batch.Commit(); err != nil {
+		return errors.Errorf("failed to commit deleted cached objects: %w", err)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ExportCachedObjects adds the objects that are kept in the cache of the given ObjectStorage to the CachedObjects. The
+// realm has to be the one of the ObjectStorage (its package and storage prefix). It is used to complete checkpoints
+// with the objects that were not persisted, yet, as flushing the ObjectStorage is only possible when it is shut down.
+//
+// The iteration over the cache skips the objects that are marked as deleted, so the keys of the stored objects are
+// checked against the ObjectStorage to find them. The writes to the database have to be paused, so the deletions are
+// still pending in the cache.
+func ExportCachedObjects(cachedObjects *CachedObjects, realm kvstore.Realm, storage *objectstorage.ObjectStorage) (err error) {
+	objects := cachedObjects.objects.WithRealm(realm)
+	storage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		defer cachedObject.Release()
+
+		object := cachedObject.Get()
+		if !object.ShouldPersist() {
+			return true
+		}
+		if err = objects.Set(key, object.ObjectStorageValue()); err != nil {
+			err = errors.Errorf("failed to export cached object %x: %w", key, err)
+		}
+
+		return err == nil
+	}, objectstorage.WithIteratorSkipStorage(true))
+	if err != nil {
+		return err
+	}
+
+	deletedKeys := cachedObjects.deletedKeys.WithRealm(realm)
+	if iterateErr := cachedObjects.source.WithRealm(realm).IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		if storage.Contains(key) {
+			return true
+		}
+		if err = deletedKeys.Set(copyBytes(key), []byte{}); err != nil {
+			err = errors.Errorf("failed to export deleted cached object %x: %w", key, err)
+		}
+
+		return err == nil
+	}); iterateErr != nil && err == nil {
+		err = errors.Errorf("failed to iterate stored objects: %w", iterateErr)
+	}
+
+	return err
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+// exportCheckpoint copies all entries of the source DB to a new DB that is created in the given directory by the
+// constructor. It is used by the database engines that do not support native checkpoints.
+func exportCheckpoint(source DB, dirname string, newDB func(dirname string) (DB, error)) (err error) {
+	if _, err = os.Stat(dirname); err == nil {
+		return errors.Errorf("failed to create checkpoint in '%s': directory exists", dirname)
+	} else if !os.IsNotExist(err) {
+		return errors.Errorf("failed to check checkpoint directory '%s': %w", dirname, err)
+	}
+
+	target, err := newDB(dirname)
+	if err != nil {
+		return errors.Errorf("failed to create checkpoint database in '%s': %w", dirname, err)
+	}
+
+	if _, err = CopyStore(target.NewStore(), source.NewStore(), kvstore.EmptyPrefix); err != nil {
+		_ = target.Close()
+		return errors.Errorf("failed to export entries to checkpoint: %w", err)
+	}
+
+	if err = target.Close(); err != nil {
+		return errors.Errorf("failed to close checkpoint database: %w", err)
+	}
+
+	return nil
+}
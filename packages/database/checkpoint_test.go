@@ -0,0 +1,31 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCheckpoint(t *testing.T) {
+	source, err := NewMemDB()
+	require.NoError(t, err)
+	require.NoError(t, source.NewStore().Set([]byte{PrefixTangle, 1}, []byte("message")))
+	require.NoError(t, source.NewStore().Set([]byte{PrefixHealth}, []byte{1}))
+
+	dirname := filepath.Join(t.TempDir(), "checkpoint")
+	require.NoError(t, exportCheckpoint(source, dirname, NewPebbleDB))
+	assert.Error(t, exportCheckpoint(source, dirname, NewPebbleDB))
+
+	checkpoint, err := NewPebbleDB(dirname)
+	require.NoError(t, err)
+	defer checkpoint.Close()
+	entries, err := countEntries(checkpoint.NewStore(), []byte{PrefixTangle, PrefixHealth})
+	require.NoError(t, err)
+	assert.Equal(t, 2, entries)
+	value, err := checkpoint.NewStore().Get(kvstore.Key{PrefixTangle, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("message"), value)
+}
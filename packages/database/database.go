@@ -1,9 +1,28 @@
 package database
 
 import (
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/kvstore"
 )
 
+// Engine is the name of a database engine that can be used to persist the data of the node.
+type Engine string
+
+const (
+	// EngineRocksDB is the RocksDB database engine. It requires the node to be built with the "rocksdb" build tag.
+	EngineRocksDB Engine = "rocksdb"
+	// EnginePebble is the Pebble database engine. It is written in pure Go and does not require cgo.
+	EnginePebble Engine = "pebble"
+)
+
+var (
+	// ErrUnknownEngine is returned when a DB is created with an unknown database engine.
+	ErrUnknownEngine = errors.New("unknown database engine")
+
+	// ErrCheckpointNotSupported is returned when a checkpoint of a DB that is not persisted is requested.
+	ErrCheckpointNotSupported = errors.New("checkpoints are not supported by the database")
+)
+
 // DB represents a database abstraction.
 type DB interface {
 	// NewStore creates a new KVStore backed by the database.
@@ -15,4 +34,21 @@ type DB interface {
 	RequiresGC() bool
 	// GC runs the garbage collection to clean deleted database items.
 	GC() error
+	// Size returns the size of the database in bytes.
+	Size() (int64, error)
+	// Checkpoint writes a consistent copy of the database to the given directory, which must not exist, yet. It can be
+	// called while the database is in use.
+	Checkpoint(dirname string) error
+}
+
+// NewDBWithEngine returns a new persisting DB object that uses the given database engine.
+func NewDBWithEngine(dirname string, engine Engine) (DB, error) {
+	switch engine {
+	case EngineRocksDB:
+		return NewDB(dirname)
+	case EnginePebble:
+		return NewPebbleDB(dirname)
+	default:
+		return nil, errors.Errorf("failed to create database with engine '%s': %w", engine, ErrUnknownEngine)
+	}
 }
@@ -0,0 +1,159 @@
+package database_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+// engines contains the constructors of the databases that are compared by the benchmarks.
+var engines = map[string]func(dirname string) (database.DB, error){
+	"memdb": func(string) (database.DB, error) {
+		return database.NewMemDB()
+	},
+	string(database.EnginePebble): database.NewPebbleDB,
+}
+
+func TestPebbleDB(t *testing.T) {
+	dirname := t.TempDir()
+
+	db, err := database.NewDBWithEngine(dirname, database.EnginePebble)
+	require.NoError(t, err)
+	require.NoError(t, db.NewStore().WithRealm([]byte{database.PrefixHealth}).Set([]byte("key"), []byte("value")))
+	require.NoError(t, db.GC())
+	size, err := db.Size()
+	require.NoError(t, err)
+	assert.Greater(t, size, int64(0))
+	require.NoError(t, db.Close())
+
+	// the data is persisted
+	db, err = database.NewPebbleDB(dirname)
+	require.NoError(t, err)
+	defer db.Close()
+	value, err := db.NewStore().WithRealm([]byte{database.PrefixHealth}).Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	_, err = database.NewDBWithEngine(dirname, "unknown")
+	assert.ErrorIs(t, err, database.ErrUnknownEngine)
+}
+
+func TestDB_Checkpoint(t *testing.T) {
+	for name, newDB := range engines {
+		t.Run(name, func(t *testing.T) {
+			db, err := newDB(filepath.Join(t.TempDir(), "db"))
+			require.NoError(t, err)
+			defer db.Close()
+			store := db.NewStore().WithRealm([]byte{database.PrefixHealth})
+			require.NoError(t, store.Set([]byte("key"), []byte("value")))
+
+			checkpointDir := filepath.Join(t.TempDir(), "checkpoint")
+			if name == "memdb" {
+				assert.ErrorIs(t, db.Checkpoint(checkpointDir), database.ErrCheckpointNotSupported)
+				return
+			}
+			require.NoError(t, db.Checkpoint(checkpointDir))
+			assert.Error(t, db.Checkpoint(checkpointDir))
+
+			// changes after the checkpoint are not part of it
+			require.NoError(t, store.Set([]byte("key"), []byte("changed")))
+			checkpoint, err := newDB(checkpointDir)
+			require.NoError(t, err)
+			defer checkpoint.Close()
+			value, err := checkpoint.NewStore().WithRealm([]byte{database.PrefixHealth}).Get([]byte("key"))
+			require.NoError(t, err)
+			assert.Equal(t, []byte("value"), value)
+		})
+	}
+}
+
+func BenchmarkStore_Set(b *testing.B) {
+	for name, newDB := range engines {
+		b.Run(name, func(b *testing.B) {
+			store := newBenchmarkStore(b, newDB)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := store.Set([]byte(fmt.Sprintf("key%d", i)), make([]byte, 128)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkTangle_Booking(b *testing.B) {
+	for name, newDB := range engines {
+		b.Run(name, func(b *testing.B) {
+			tangleInstance := tangle.NewTestTangle(tangle.Store(newBenchmarkStore(b, newDB)), tangle.SolidifierConfig(tangle.SolidifierParams{
+				MaxParentsTimeDifference: time.Hour,
+			}))
+			defer tangleInstance.Shutdown()
+			tangleInstance.Storage.Setup()
+			tangleInstance.Solidifier.Setup()
+			tangleInstance.Booker.Setup()
+
+			var bookedWG sync.WaitGroup
+			tangleInstance.Booker.Events.MessageBooked.Attach(events.NewClosure(func(tangle.MessageID) {
+				bookedWG.Done()
+			}))
+			tangleInstance.Events.MessageInvalid.Attach(events.NewClosure(func(event *tangle.MessageInvalidEvent) {
+				b.Errorf("%s is invalid: %s", event.MessageID, event.Error)
+				bookedWG.Done()
+			}))
+
+			// every message approves the two previous messages
+			messages := make([]*tangle.Message, b.N)
+			issuingTime := time.Now()
+			for i := range messages {
+				parents := tangle.MessageIDs{tangle.EmptyMessageID}
+				if i > 1 {
+					parents = tangle.MessageIDs{messages[i-1].ID(), messages[i-2].ID()}
+				} else if i > 0 {
+					parents = tangle.MessageIDs{messages[i-1].ID()}
+				}
+
+				message, err := tangle.NewMessage(parents, nil, nil, nil, issuingTime.Add(time.Duration(i)*time.Microsecond), ed25519.PublicKey{}, uint64(i), payload.NewGenericDataPayload([]byte("benchmark")), 0, ed25519.Signature{})
+				if err != nil {
+					b.Fatal(err)
+				}
+				messages[i] = message
+			}
+
+			b.ResetTimer()
+			bookedWG.Add(b.N)
+			for _, message := range messages {
+				tangleInstance.Storage.StoreMessage(message)
+			}
+			bookedWG.Wait()
+		})
+	}
+}
+
+// newBenchmarkStore creates a KVStore of the given database in a temporary directory. The database is closed when the
+// benchmark finishes.
+func newBenchmarkStore(b *testing.B, newDB func(dirname string) (database.DB, error)) kvstore.KVStore {
+	db, err := newDB(b.TempDir())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			b.Error(err)
+		}
+	})
+
+	return db.NewStore()
+}
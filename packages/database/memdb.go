@@ -30,3 +30,13 @@ func (db *memDB) RequiresGC() bool {
 func (db *memDB) GC() error {
 	return nil
 }
+
+// Size returns 0 as the in-memory DB does not use any disk space.
+func (db *memDB) Size() (int64, error) {
+	return 0, nil
+}
+
+// Checkpoint returns ErrCheckpointNotSupported as the in-memory DB is not persisted.
+func (db *memDB) Checkpoint(string) error {
+	return ErrCheckpointNotSupported
+}
@@ -0,0 +1,232 @@
+package database
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/logger"
+)
+
+const (
+	// copyBatchSize defines the amount of entries that are written to the target store in a single batch.
+	copyBatchSize = 10000
+
+	// progressLogInterval defines how often the progress of a running migration is logged.
+	progressLogInterval = 5 * time.Second
+)
+
+var (
+	// ErrMigrationMissing is returned if there is no registered Migration for one of the versions between the version of
+	// the database and the supported version.
+	ErrMigrationMissing = errors.New("migration missing")
+
+	// ErrMigrationDowngrade is returned if the version of the database is newer than the supported version.
+	ErrMigrationDowngrade = errors.New("database can not be downgraded")
+)
+
+// region Migration ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Migration upgrades the schema of the database from the previous version to Version.
+type Migration struct {
+	// Version is the version of the database schema after the Migration.
+	Version byte
+
+	// Description is a human readable description of the changes of the Migration.
+	Description string
+
+	// Prefixes contains the storage prefixes (see prefix.go) whose entries are rewritten by the Migration. A Migration
+	// must not touch any other entries, as only these prefixes are copied for dry runs.
+	Prefixes []byte
+
+	// Migrate rewrites the entries of the affected prefixes. The store is not bound to a realm, so the keys start with
+	// the storage prefix. The progress function should be called with the amount of migrated entries from time to time.
+	Migrate func(store kvstore.KVStore, progress func(migratedEntries int)) error
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Migrator /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Migrator runs the registered Migrations that are required to upgrade a database to the supported schema version.
+type Migrator struct {
+	migrations map[byte]*Migration
+	log        *logger.Logger
+}
+
+// NewMigrator creates a new Migrator with the given Migrations. It returns an error if there is more than one
+// Migration for the same version.
+func NewMigrator(log *logger.Logger, migrations ...*Migration) (migrator *Migrator, err error) {
+	migrator = &Migrator{
+		migrations: make(map[byte]*Migration),
+		log:        log,
+	}
+	for _, migration := range migrations {
+		if _, exists := migrator.migrations[migration.Version]; exists {
+			return nil, errors.Errorf("failed to register migration '%s': there is another migration for version %d", migration.Description, migration.Version)
+		}
+		migrator.migrations[migration.Version] = migration
+	}
+
+	return migrator, nil
+}
+
+// Plan returns the Migrations that have to be run (in the returned order) to upgrade a database from fromVersion to
+// toVersion.
+func (m *Migrator) Plan(fromVersion, toVersion byte) (plan []*Migration, err error) {
+	if fromVersion > toVersion {
+		return nil, errors.Errorf("failed to plan migration from version %d to %d: %w", fromVersion, toVersion, ErrMigrationDowngrade)
+	}
+
+	for version := int(fromVersion) + 1; version <= int(toVersion); version++ {
+		migration, exists := m.migrations[byte(version)]
+		if !exists {
+			return nil, errors.Errorf("failed to plan migration from version %d to %d: no migration to version %d: %w", fromVersion, toVersion, version, ErrMigrationMissing)
+		}
+		plan = append(plan, migration)
+	}
+
+	return plan, nil
+}
+
+// Migrate upgrades the database from fromVersion to toVersion by running the Migrations sequentially. After every
+// Migration the new version is persisted with setVersion, so an interrupted upgrade does not repeat finished
+// Migrations.
+func (m *Migrator) Migrate(store kvstore.KVStore, fromVersion, toVersion byte, setVersion func(version byte) error) (err error) {
+	plan, err := m.Plan(fromVersion, toVersion)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range plan {
+		if err = m.run(store, migration); err != nil {
+			return err
+		}
+		if err = store.Flush(); err != nil {
+			return errors.Errorf("failed to flush the database after migrating to version %d: %w", migration.Version, err)
+		}
+		if err = setVersion(migration.Version); err != nil {
+			return errors.Errorf("failed to persist database version %d: %w", migration.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// DryRun runs the Migrations that are required to upgrade the database from fromVersion to toVersion on an in-memory
+// copy of the affected prefixes and logs the results. The database itself is not modified.
+func (m *Migrator) DryRun(store kvstore.KVStore, fromVersion, toVersion byte) (err error) {
+	plan, err := m.Plan(fromVersion, toVersion)
+	if err != nil {
+		return err
+	}
+
+	var entriesBefore, entriesAfter int
+	copiedPrefixes := make(map[byte]bool)
+	memStore := mapdb.NewMapDB()
+	for _, migration := range plan {
+		for _, prefix := range migration.Prefixes {
+			if copiedPrefixes[prefix] {
+				continue
+			}
+			if _, err = CopyStore(memStore, store, kvstore.KeyPrefix{prefix}); err != nil {
+				return errors.Errorf("failed to copy prefix %d for the dry run: %w", prefix, err)
+			}
+			copiedPrefixes[prefix] = true
+		}
+
+		if entriesBefore, err = countEntries(memStore, migration.Prefixes); err != nil {
+			return err
+		}
+		if err = m.run(memStore, migration); err != nil {
+			return err
+		}
+		if entriesAfter, err = countEntries(memStore, migration.Prefixes); err != nil {
+			return err
+		}
+		m.log.Infof("Dry run of migration to version %d succeeded: %d entries before, %d entries after", migration.Version, entriesBefore, entriesAfter)
+	}
+
+	return nil
+}
+
+// run runs a single Migration and logs its progress.
+func (m *Migrator) run(store kvstore.KVStore, migration *Migration) (err error) {
+	m.log.Infof("Migrating database to version %d (%s)...", migration.Version, migration.Description)
+
+	start := time.Now()
+	lastLog := start
+	if err = migration.Migrate(store, func(migratedEntries int) {
+		if time.Since(lastLog) >= progressLogInterval {
+			m.log.Infof("Migrating database to version %d... %d entries migrated", migration.Version, migratedEntries)
+			lastLog = time.Now()
+		}
+	}); err != nil {
+		return errors.Errorf("failed to migrate database to version %d: %w", migration.Version, err)
+	}
+
+	m.log.Infof("Migrating database to version %d... done, took %v", migration.Version, time.Since(start))
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// CopyStore copies all entries with the given prefix from the source to the target store and returns the amount of
+// copied entries. The entries are written in batches.
+func CopyStore(target, source kvstore.KVStore, prefix kvstore.KeyPrefix) (copiedEntries int, err error) {
+	batch := target.Batched()
+	if iterateErr := source.Iterate(prefix, func(key kvstore.Key, value kvstore.Value) bool {
+		if err = batch.Set(copyBytes(key), copyBytes(value)); err != nil {
+			return false
+		}
+
+		if copiedEntries++; copiedEntries%copyBatchSize == 0 {
+			if err = batch.Commit(); err != nil {
+				return false
+			}
+			batch = target.Batched()
+		}
+
+		return true
+	}); iterateErr != nil {
+		err = iterateErr
+	}
+	if err != nil {
+		batch.Cancel()
+		return copiedEntries, errors.Errorf("failed to copy entries: %w", err)
+	}
+
+	if err = batch.Commit(); err != nil {
+		return copiedEntries, errors.Errorf("failed to commit copied entries: %w", err)
+	}
+
+	return copiedEntries, nil
+}
+
+// countEntries returns the amount of entries with the given prefixes.
+func countEntries(store kvstore.KVStore, prefixes []byte) (entries int, err error) {
+	for _, prefix := range prefixes {
+		if err = store.IterateKeys(kvstore.KeyPrefix{prefix}, func(kvstore.Key) bool {
+			entries++
+			return true
+		}); err != nil {
+			return 0, errors.Errorf("failed to count entries with prefix %d: %w", prefix, err)
+		}
+	}
+
+	return entries, nil
+}
+
+// copyBytes returns a copy of the given bytes, as the iterators of some database engines reuse their buffers.
+func copyBytes(source []byte) (target []byte) {
+	target = make([]byte, len(source))
+	copy(target, source)
+
+	return target
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
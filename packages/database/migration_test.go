@@ -0,0 +1,110 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	// version 2 renames the keys of PrefixTangle, version 3 drops PrefixHealth
+	migrator, err := NewMigrator(logger.NewExampleLogger("migrator"), &Migration{
+		Version:     2,
+		Description: "rename tangle entries",
+		Prefixes:    []byte{PrefixTangle},
+		Migrate: func(store kvstore.KVStore, progress func(migratedEntries int)) error {
+			var keys []kvstore.Key
+			if err := store.IterateKeys(kvstore.KeyPrefix{PrefixTangle}, func(key kvstore.Key) bool {
+				keys = append(keys, copyBytes(key))
+				return true
+			}); err != nil {
+				return err
+			}
+			for i, key := range keys {
+				value, err := store.Get(key)
+				if err != nil {
+					return err
+				}
+				if err = store.Delete(key); err != nil {
+					return err
+				}
+				if err = store.Set(append(key, 'x'), value); err != nil {
+					return err
+				}
+				progress(i + 1)
+			}
+			return nil
+		},
+	}, &Migration{
+		Version:     3,
+		Description: "drop health",
+		Prefixes:    []byte{PrefixHealth},
+		Migrate: func(store kvstore.KVStore, _ func(int)) error {
+			return store.DeletePrefix(kvstore.KeyPrefix{PrefixHealth})
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = migrator.Plan(1, 4)
+	assert.ErrorIs(t, err, ErrMigrationMissing)
+	_, err = migrator.Plan(3, 2)
+	assert.ErrorIs(t, err, ErrMigrationDowngrade)
+	plan, err := migrator.Plan(2, 3)
+	require.NoError(t, err)
+	require.Len(t, plan, 1)
+	assert.Equal(t, byte(3), plan[0].Version)
+
+	store := mapdb.NewMapDB()
+	require.NoError(t, store.Set([]byte{PrefixTangle, 1}, []byte("message")))
+	require.NoError(t, store.Set([]byte{PrefixHealth, 1}, []byte("health")))
+	require.NoError(t, store.Set([]byte{PrefixLedgerState, 1}, []byte("ledger")))
+
+	// the dry run does not modify the database
+	require.NoError(t, migrator.DryRun(store, 1, 3))
+	value, err := store.Get([]byte{PrefixTangle, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("message"), value)
+	assert.True(t, hasKey(t, store, []byte{PrefixHealth, 1}))
+
+	var versions []byte
+	require.NoError(t, migrator.Migrate(store, 1, 3, func(version byte) error {
+		versions = append(versions, version)
+		return nil
+	}))
+	assert.Equal(t, []byte{2, 3}, versions)
+	value, err = store.Get([]byte{PrefixTangle, 1, 'x'})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("message"), value)
+	assert.False(t, hasKey(t, store, []byte{PrefixTangle, 1}))
+	assert.False(t, hasKey(t, store, []byte{PrefixHealth, 1}))
+	assert.True(t, hasKey(t, store, []byte{PrefixLedgerState, 1}))
+
+	_, err = NewMigrator(logger.NewExampleLogger("migrator"), &Migration{Version: 2}, &Migration{Version: 2})
+	assert.Error(t, err)
+}
+
+func TestCopyStore(t *testing.T) {
+	source, target := mapdb.NewMapDB(), mapdb.NewMapDB()
+	for i := 0; i < copyBatchSize+10; i++ {
+		require.NoError(t, source.Set([]byte{PrefixTangle, byte(i >> 8), byte(i)}, []byte{byte(i)}))
+	}
+	require.NoError(t, source.Set([]byte{PrefixHealth}, []byte{1}))
+
+	copiedEntries, err := CopyStore(target, source, kvstore.KeyPrefix{PrefixTangle})
+	require.NoError(t, err)
+	assert.Equal(t, copyBatchSize+10, copiedEntries)
+	entries, err := countEntries(target, []byte{PrefixTangle, PrefixHealth})
+	require.NoError(t, err)
+	assert.Equal(t, copyBatchSize+10, entries)
+}
+
+func hasKey(t *testing.T, store kvstore.KVStore, key kvstore.Key) bool {
+	has, err := store.Has(key)
+	require.NoError(t, err)
+
+	return has
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"sync"
+
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+// region PausableStore ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PausableStore is a KVStore whose write operations can be paused. The writes of all stores that are derived from it
+// (e.g. with a different realm) block while it is paused, which allows to capture a consistent state of the database
+// and the caches on top of it.
+type PausableStore struct {
+	kvstore.KVStore
+
+	mutex *sync.RWMutex
+}
+
+// NewPausableStore wraps the given store in a PausableStore.
+func NewPausableStore(store kvstore.KVStore) *PausableStore {
+	return &PausableStore{
+		KVStore: store,
+		mutex:   &sync.RWMutex{},
+	}
+}
+
+// Pause waits for the running write operations to finish and blocks all further ones until Resume is called.
+func (p *PausableStore) Pause() {
+	p.mutex.Lock()
+}
+
+// Resume continues the write operations that were blocked by Pause.
+func (p *PausableStore) Resume() {
+	p.mutex.Unlock()
+}
+
+// WithRealm returns a store with the given realm that is paused together with this store.
+func (p *PausableStore) WithRealm(realm kvstore.Realm) kvstore.KVStore {
+	return &PausableStore{
+		KVStore: p.KVStore.WithRealm(realm),
+		mutex:   p.mutex,
+	}
+}
+
+// Clear clears the realm.
+func (p *PausableStore) Clear() error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.KVStore.Clear()
+}
+
+// Set sets the given key and value.
+func (p *PausableStore) Set(key kvstore.Key, value kvstore.Value) error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.KVStore.Set(key, value)
+}
+
+// Delete deletes the entry for the given key.
+func (p *PausableStore) Delete(key kvstore.Key) error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.KVStore.Delete(key)
+}
+
+// DeletePrefix deletes all the entries matching the given key prefix.
+func (p *PausableStore) DeletePrefix(prefix kvstore.KeyPrefix) error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.KVStore.DeletePrefix(prefix)
+}
+
+// Batched returns BatchedMutations that are committed only while the store is not paused.
+func (p *PausableStore) Batched() kvstore.BatchedMutations {
+	return &pausableBatchedMutations{
+		BatchedMutations: p.KVStore.Batched(),
+		mutex:            p.mutex,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region pausableBatchedMutations /////////////////////////////////////////////////////////////////////////////////////
+
+// pausableBatchedMutations are the BatchedMutations of a PausableStore.
+type pausableBatchedMutations struct {
+	kvstore.BatchedMutations
+
+	mutex *sync.RWMutex
+}
+
+// Commit commits the mutations after the store was resumed.
+func (p *pausableBatchedMutations) Commit() error {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.BatchedMutations.Commit()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPausableStore(t *testing.T) {
+	store := NewPausableStore(mapdb.NewMapDB())
+	realm := store.WithRealm([]byte{PrefixTangle})
+
+	store.Pause()
+	written := make(chan error, 2)
+	go func() {
+		written <- realm.Set([]byte("key"), []byte("value"))
+	}()
+	go func() {
+		batch := realm.Batched()
+		require.NoError(t, batch.Set([]byte("batched"), []byte("value")))
+		written <- batch.Commit()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	has, err := realm.Has([]byte("key"))
+	require.NoError(t, err)
+	assert.False(t, has)
+	has, err = realm.Has([]byte("batched"))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	store.Resume()
+	require.NoError(t, <-written)
+	require.NoError(t, <-written)
+	for _, key := range []kvstore.Key{[]byte("key"), []byte("batched")} {
+		value, err := realm.Get(key)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+	}
+}
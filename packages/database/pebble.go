@@ -0,0 +1,60 @@
+package database
+
+import (
+	"runtime"
+
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+	"github.com/iotaledger/hive.go/kvstore"
+	pebbledb "github.com/iotaledger/hive.go/kvstore/pebble"
+)
+
+type pebbleDB struct {
+	*pebble.DB
+}
+
+// NewPebbleDB returns a new persisting DB object that is backed by Pebble (a pure Go key-value store that does not
+// require cgo).
+func NewPebbleDB(dirname string) (DB, error) {
+	db, err := pebbledb.CreateDB(dirname)
+	return &pebbleDB{DB: db}, err
+}
+
+func (db *pebbleDB) NewStore() kvstore.KVStore {
+	return pebbledb.New(db.DB)
+}
+
+// Close closes a DB. It's crucial to call it to ensure all the pending updates make their way to disk.
+func (db *pebbleDB) Close() error {
+	return db.DB.Close()
+}
+
+func (db *pebbleDB) RequiresGC() bool {
+	return true
+}
+
+// GC flushes the memtables to disk and triggers the go garbage collector to release the used memory. Deleted items are
+// removed by the compactions that Pebble runs in the background.
+func (db *pebbleDB) GC() error {
+	if err := db.DB.Flush(); err != nil {
+		return err
+	}
+
+	runtime.GC()
+	return nil
+}
+
+// Size returns the disk space used by the database (including the WAL and obsolete files that were not deleted, yet).
+func (db *pebbleDB) Size() (int64, error) {
+	return int64(db.DB.Metrics().DiskSpaceUsage()), nil
+}
+
+// Checkpoint uses the native checkpoints of Pebble, which hard link the immutable files of the database. The WAL is
+// flushed first, so the checkpoint contains all writes that happened before.
+func (db *pebbleDB) Checkpoint(dirname string) error {
+	if err := db.DB.Checkpoint(dirname, pebble.WithFlushedWAL()); err != nil {
+		return errors.Errorf("failed to create checkpoint in '%s': %w", dirname, err)
+	}
+
+	return nil
+}
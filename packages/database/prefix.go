@@ -21,4 +21,16 @@ const (
 
 	// PrefixEpochs defines the storage prefix for the epochs package.
 	PrefixEpochs
+
+	// PrefixDRNG defines the storage prefix for the drng package.
+	PrefixDRNG
+
+	// PrefixManualPeering defines the storage prefix for the manualpeering package.
+	PrefixManualPeering
+
+	// PrefixFaucet defines the storage prefix for the faucet plugin.
+	PrefixFaucet
+
+	// PrefixDRNGRotations defines the storage prefix for the committee rotations of the drng package.
+	PrefixDRNGRotations
 )
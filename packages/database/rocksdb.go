@@ -1,6 +1,8 @@
 package database
 
 import (
+	"os"
+	"path/filepath"
 	"runtime"
 
 	"github.com/iotaledger/hive.go/kvstore"
@@ -11,12 +13,13 @@ import (
 
 type rocksDB struct {
 	*rocksdb.RocksDB
+	dirname string
 }
 
 // NewDB returns a new persisting DB object.
 func NewDB(dirname string) (DB, error) {
 	db, err := rocksdb.CreateDB(dirname)
-	return &rocksDB{RocksDB: db}, err
+	return &rocksDB{RocksDB: db, dirname: dirname}, err
 }
 
 func (db *rocksDB) NewStore() kvstore.KVStore {
@@ -37,3 +40,17 @@ func (db *rocksDB) GC() error {
 	runtime.GC()
 	return nil
 }
+
+// Size returns the size of all files in the database directory.
+func (db *rocksDB) Size() (size int64, err error) {
+	err = filepath.Walk(db.dirname, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return err
+	})
+	return size, err
+}
@@ -0,0 +1,7 @@
+package database
+
+// Checkpoint exports all entries of the database to a new database in the given directory. hive.go does not expose the
+// handle of the RocksDB instance that is needed for its native checkpoints, so the entries are copied instead.
+func (db *rocksDB) Checkpoint(dirname string) error {
+	return exportCheckpoint(db, dirname, NewDB)
+}
@@ -0,0 +1,12 @@
+//go:build rocksdb
+// +build rocksdb
+
+package database_test
+
+import (
+	"github.com/iotaledger/goshimmer/packages/database"
+)
+
+func init() {
+	engines[string(database.EngineRocksDB)] = database.NewDB
+}
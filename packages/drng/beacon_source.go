@@ -0,0 +1,88 @@
+package drng
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+)
+
+// BeaconSource is the interface of a verifiable randomness scheme that produces beacons of a specific payload Type.
+// New schemes can be supported by implementing this interface and registering it with DRNG.RegisterBeaconSource.
+type BeaconSource interface {
+	// Type returns the payload Type of the beacons handled by the BeaconSource.
+	Type() Type
+
+	// Round returns the round of the given beacon.
+	Round(payload *Payload) (uint64, error)
+
+	// ProcessBeacon parses and verifies the given payload against the state of its dRNG instance and returns the
+	// resulting randomness.
+	ProcessBeacon(drng *DRNG, issuer ed25519.PublicKey, timestamp time.Time, payload *Payload) (*Randomness, error)
+}
+
+// region CollectiveBeaconSource ///////////////////////////////////////////////////////////////////////////////////////
+
+// CollectiveBeaconSource is the BeaconSource of the drand collective beacons verified with BLS threshold signatures.
+type CollectiveBeaconSource struct{}
+
+// Type returns the payload Type of the beacons handled by the BeaconSource.
+func (CollectiveBeaconSource) Type() Type {
+	return TypeCollectiveBeacon
+}
+
+// Round returns the round of the given collective beacon.
+func (CollectiveBeaconSource) Round(payload *Payload) (uint64, error) {
+	parsedPayload, err := CollectiveBeaconPayloadFromMarshalUtil(marshalutil.New(payload.Bytes()))
+	if err != nil {
+		return 0, err
+	}
+	return parsedPayload.Round, nil
+}
+
+// ProcessBeacon parses and verifies the given collective beacon and returns the resulting randomness.
+func (CollectiveBeaconSource) ProcessBeacon(drng *DRNG, issuer ed25519.PublicKey, timestamp time.Time, payload *Payload) (*Randomness, error) {
+	// parse as CollectiveBeaconType
+	marshalUtil := marshalutil.New(payload.Bytes())
+	parsedPayload, err := CollectiveBeaconPayloadFromMarshalUtil(marshalUtil)
+	if err != nil {
+		return nil, err
+	}
+	// trigger CollectiveBeacon Event
+	cbEvent := &CollectiveBeaconEvent{
+		IssuerPublicKey: issuer,
+		Timestamp:       timestamp,
+		InstanceID:      parsedPayload.Header.InstanceID,
+		Round:           parsedPayload.Round,
+		PrevSignature:   parsedPayload.PrevSignature,
+		Signature:       parsedPayload.Signature,
+		Dpk:             parsedPayload.Dpk,
+	}
+	drng.Events.CollectiveBeacon.Trigger(cbEvent)
+
+	// process collectiveBeacon
+	state := drng.LoadState(cbEvent.InstanceID)
+	if state == nil {
+		return nil, ErrInstanceIDMismatch
+	}
+	if err := VerifyCollectiveBeacon(state, cbEvent); err != nil {
+		return nil, err
+	}
+	randomness, err := ExtractRandomness(cbEvent.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// update the dpk (if not set) from the valid beacon
+	if len(state.Committee().DistributedPK) == 0 {
+		state.UpdateDPK(cbEvent.Dpk)
+	}
+
+	return &Randomness{
+		Round:      cbEvent.Round,
+		Randomness: randomness,
+		Timestamp:  cbEvent.Timestamp,
+	}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
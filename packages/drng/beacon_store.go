@@ -0,0 +1,264 @@
+package drng
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+)
+
+const (
+	// pruningInterval defines the number of rounds between two prunings of an instance.
+	pruningInterval = 100
+)
+
+var (
+	// ErrBeaconNotFound is returned if a beacon is not contained in the BeaconStore.
+	ErrBeaconNotFound = errors.New("beacon not found")
+	// ErrInvalidBeacon is returned if a stored beacon does not prove its randomness.
+	ErrInvalidBeacon = errors.New("invalid beacon")
+)
+
+// region Beacon ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Beacon is the randomness of a round together with the beacon payload it was extracted from, which allows anyone
+// knowing the committee to verify it.
+type Beacon struct {
+	// InstanceID of the dRNG instance.
+	InstanceID uint32
+	// Round of the beacon.
+	Round uint64
+	// Timestamp of the message containing the beacon.
+	Timestamp time.Time
+	// Randomness extracted from the beacon.
+	Randomness []byte
+	// Issuer of the message containing the beacon.
+	Issuer ed25519.PublicKey
+	// Payload is the serialized beacon payload.
+	Payload []byte
+}
+
+// BeaconFromBytes parses the serialized version of a Beacon.
+func BeaconFromBytes(data []byte) (beacon *Beacon, err error) {
+	marshalUtil := marshalutil.New(data)
+	beacon = &Beacon{}
+	if beacon.InstanceID, err = marshalUtil.ReadUint32(); err != nil {
+		return nil, fmt.Errorf("failed to parse instance ID of beacon: %w", err)
+	}
+	if beacon.Round, err = marshalUtil.ReadUint64(); err != nil {
+		return nil, fmt.Errorf("failed to parse round of beacon: %w", err)
+	}
+	if beacon.Timestamp, err = marshalUtil.ReadTime(); err != nil {
+		return nil, fmt.Errorf("failed to parse timestamp of beacon: %w", err)
+	}
+	randomnessLength, err := marshalUtil.ReadUint16()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse randomness length of beacon: %w", err)
+	}
+	if beacon.Randomness, err = marshalUtil.ReadBytes(int(randomnessLength)); err != nil {
+		return nil, fmt.Errorf("failed to parse randomness of beacon: %w", err)
+	}
+	if beacon.Issuer, err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+		return nil, fmt.Errorf("failed to parse issuer of beacon: %w", err)
+	}
+	payloadLength, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payload length of beacon: %w", err)
+	}
+	if beacon.Payload, err = marshalUtil.ReadBytes(int(payloadLength)); err != nil {
+		return nil, fmt.Errorf("failed to parse payload of beacon: %w", err)
+	}
+
+	return beacon, nil
+}
+
+// Bytes returns the serialized version of the Beacon.
+func (b *Beacon) Bytes() []byte {
+	return marshalutil.New().
+		WriteUint32(b.InstanceID).
+		WriteUint64(b.Round).
+		WriteTime(b.Timestamp).
+		WriteUint16(uint16(len(b.Randomness))).
+		WriteBytes(b.Randomness).
+		Write(b.Issuer).
+		WriteUint32(uint32(len(b.Payload))).
+		WriteBytes(b.Payload).
+		Bytes()
+}
+
+// VerifyBeacon checks that the beacon was issued by the given committee and that it proves its randomness.
+func VerifyBeacon(beacon *Beacon, committee Committee) error {
+	parsedPayload, err := PayloadFromMarshalUtil(marshalutil.New(beacon.Payload))
+	if err != nil {
+		return err
+	}
+	if parsedPayload.InstanceID != beacon.InstanceID || committee.InstanceID != beacon.InstanceID {
+		return fmt.Errorf("%w: beacon of instance %d, payload of instance %d, committee of instance %d", ErrInstanceIDMismatch, beacon.InstanceID, parsedPayload.InstanceID, committee.InstanceID)
+	}
+
+	var round uint64
+	var signature []byte
+	switch parsedPayload.PayloadType {
+	case TypeCollectiveBeacon:
+		collectiveBeacon, err := CollectiveBeaconPayloadFromMarshalUtil(marshalutil.New(beacon.Payload))
+		if err != nil {
+			return err
+		}
+		if len(committee.DistributedPK) == 0 {
+			return fmt.Errorf("%w: distributed public key of committee %d unknown", ErrInvalidBeacon, committee.InstanceID)
+		}
+		// verify against the distributed public key of the committee and not against the one in the payload
+		if err := verifySignature(&CollectiveBeaconEvent{
+			Round:         collectiveBeacon.Round,
+			PrevSignature: collectiveBeacon.PrevSignature,
+			Signature:     collectiveBeacon.Signature,
+			Dpk:           committee.DistributedPK,
+		}); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidBeacon, err)
+		}
+		round, signature = collectiveBeacon.Round, collectiveBeacon.Signature
+
+	case TypeED25519Beacon:
+		ed25519Beacon, err := ED25519BeaconPayloadFromMarshalUtil(marshalutil.New(beacon.Payload))
+		if err != nil {
+			return err
+		}
+		if err := verifyIssuer(NewState(SetCommittee(&committee)), beacon.Issuer); err != nil {
+			return err
+		}
+		if !beacon.Issuer.VerifySignature(ED25519BeaconMessage(ed25519Beacon.InstanceID, ed25519Beacon.Round), ed25519Beacon.Signature) {
+			return fmt.Errorf("%w: round %d of instance %d", ErrInvalidBeaconSignature, ed25519Beacon.Round, ed25519Beacon.InstanceID)
+		}
+		round, signature = ed25519Beacon.Round, ed25519Beacon.Signature.Bytes()
+
+	default:
+		return ErrUnsupportedBeaconType
+	}
+
+	if round != beacon.Round {
+		return fmt.Errorf("%w: beacon of round %d, payload of round %d", ErrInvalidRound, beacon.Round, round)
+	}
+	randomness, err := ExtractRandomness(signature)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(randomness, beacon.Randomness) {
+		return fmt.Errorf("%w: randomness does not match the signature", ErrInvalidBeacon)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BeaconStore //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// BeaconStore is a persistent store of the beacons of all the dRNG instances, indexed by their round.
+type BeaconStore struct {
+	store     kvstore.KVStore
+	retention uint64
+
+	lastPruned      map[uint32]uint64
+	lastPrunedMutex sync.Mutex
+}
+
+// NewBeaconStore creates a new BeaconStore that keeps the beacons of the latest retention rounds of each instance (0
+// means no pruning).
+func NewBeaconStore(store kvstore.KVStore, retention uint64) *BeaconStore {
+	return &BeaconStore{
+		store:      store.WithRealm([]byte{database.PrefixDRNG}),
+		retention:  retention,
+		lastPruned: make(map[uint32]uint64),
+	}
+}
+
+// Store stores the given beacon and prunes the beacons that are older than the retention period.
+func (b *BeaconStore) Store(beacon *Beacon) error {
+	if err := b.store.Set(beaconKey(beacon.InstanceID, beacon.Round), beacon.Bytes()); err != nil {
+		return fmt.Errorf("failed to store beacon of round %d of instance %d: %w", beacon.Round, beacon.InstanceID, err)
+	}
+
+	if b.retention == 0 || beacon.Round <= b.retention || !b.pruningDue(beacon.InstanceID, beacon.Round) {
+		return nil
+	}
+	return b.Prune(beacon.InstanceID, beacon.Round-b.retention)
+}
+
+// Beacon returns the beacon of the given round of the given instance.
+func (b *BeaconStore) Beacon(instanceID uint32, round uint64) (*Beacon, error) {
+	data, err := b.store.Get(beaconKey(instanceID, round))
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, fmt.Errorf("%w: round %d of instance %d", ErrBeaconNotFound, round, instanceID)
+		}
+		return nil, err
+	}
+	return BeaconFromBytes(data)
+}
+
+// Beacons returns the stored beacons of the given instance in the range of rounds [from, to], ordered by round.
+func (b *BeaconStore) Beacons(instanceID uint32, from, to uint64) (beacons []*Beacon, err error) {
+	for round := from; round <= to && round >= from; round++ {
+		beacon, err := b.Beacon(instanceID, round)
+		if err != nil {
+			if errors.Is(err, ErrBeaconNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		beacons = append(beacons, beacon)
+	}
+	return beacons, nil
+}
+
+// Prune removes the beacons of the given instance whose round is lower than minRound.
+func (b *BeaconStore) Prune(instanceID uint32, minRound uint64) error {
+	var keys []kvstore.Key
+	if err := b.store.IterateKeys(instanceKey(instanceID), func(key kvstore.Key) bool {
+		round, err := marshalutil.New(key[marshalutil.Uint32Size:]).ReadUint64()
+		if err == nil && round < minRound {
+			keys = append(keys, append(kvstore.Key{}, key...))
+		}
+		return true
+	}); err != nil {
+		return fmt.Errorf("failed to prune beacons of instance %d: %w", instanceID, err)
+	}
+
+	batch := b.store.Batched()
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			batch.Cancel()
+			return fmt.Errorf("failed to prune beacons of instance %d: %w", instanceID, err)
+		}
+	}
+	return batch.Commit()
+}
+
+// pruningDue returns true if the given instance was not pruned during the last pruningInterval rounds.
+func (b *BeaconStore) pruningDue(instanceID uint32, round uint64) bool {
+	b.lastPrunedMutex.Lock()
+	defer b.lastPrunedMutex.Unlock()
+
+	if lastPruned, pruned := b.lastPruned[instanceID]; pruned && round < lastPruned+pruningInterval {
+		return false
+	}
+	b.lastPruned[instanceID] = round
+	return true
+}
+
+func instanceKey(instanceID uint32) []byte {
+	return marshalutil.New(marshalutil.Uint32Size).WriteUint32(instanceID).Bytes()
+}
+
+func beaconKey(instanceID uint32, round uint64) []byte {
+	return marshalutil.New(marshalutil.Uint32Size + marshalutil.Uint64Size).WriteUint32(instanceID).WriteUint64(round).Bytes()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,74 @@
+package drng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+func TestBeaconStore(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	config := make(map[uint32][]Option)
+	config[1] = []Option{SetCommittee(committeeTest)}
+	config[2] = []Option{
+		SetCommittee(&Committee{InstanceID: 2, Identities: []ed25519.PublicKey{keyPair.PublicKey}}),
+		SetBeaconType(TypeED25519Beacon),
+	}
+	drng := New(config)
+	drng.SetBeaconStore(NewBeaconStore(mapdb.NewMapDB(), 150))
+
+	// collective beacon
+	parsedPayload, err := PayloadFromMarshalUtil(marshalutil.New(testPayload().Bytes()))
+	require.NoError(t, err)
+	require.NoError(t, drng.Dispatch(issuerPK, timestampTest, parsedPayload))
+
+	beacon, err := drng.BeaconStore().Beacon(1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, randomnessTest.Randomness, beacon.Randomness)
+	assert.NoError(t, VerifyBeacon(beacon, *committeeTest))
+
+	parsedBeacon, err := BeaconFromBytes(beacon.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, beacon.Bytes(), parsedBeacon.Bytes())
+
+	// ed25519 beacons
+	for round := uint64(1); round <= 300; round++ {
+		require.NoError(t, drng.Dispatch(keyPair.PublicKey, clock.SyncedTime(), ed25519BeaconPayload(t, &keyPair, 2, round)))
+	}
+
+	beacon, err = drng.BeaconStore().Beacon(2, 300)
+	require.NoError(t, err)
+	assert.NoError(t, VerifyBeacon(beacon, drng.State[2].Committee()))
+
+	beacons, err := drng.BeaconStore().Beacons(2, 295, 305)
+	require.NoError(t, err)
+	require.Len(t, beacons, 6)
+	assert.Equal(t, uint64(295), beacons[0].Round)
+	assert.Equal(t, uint64(300), beacons[5].Round)
+
+	// pruned beacons
+	_, err = drng.BeaconStore().Beacon(2, 100)
+	assert.True(t, errors.Is(err, ErrBeaconNotFound))
+	_, err = drng.BeaconStore().Beacon(1, 1)
+	assert.NoError(t, err)
+
+	// tampered beacons
+	tamperedBeacon := *beacon
+	tamperedBeacon.Randomness = randomnessTest.Randomness
+	assert.True(t, errors.Is(VerifyBeacon(&tamperedBeacon, drng.State[2].Committee()), ErrInvalidBeacon))
+
+	tamperedBeacon = *beacon
+	tamperedBeacon.Round = 299
+	assert.True(t, errors.Is(VerifyBeacon(&tamperedBeacon, drng.State[2].Committee()), ErrInvalidRound))
+
+	otherCommittee := drng.State[2].Committee()
+	otherCommittee.Identities = []ed25519.PublicKey{ed25519.GenerateKeyPair().PublicKey}
+	assert.True(t, errors.Is(VerifyBeacon(beacon, otherCommittee), ErrInvalidIssuer))
+}
@@ -0,0 +1,97 @@
+package drng
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// ErrInvalidCommittee is returned if a serialized committee cannot be parsed.
+var ErrInvalidCommittee = errors.New("invalid committee")
+
+// Bytes returns the serialized version of the Committee.
+func (c *Committee) Bytes() []byte {
+	return marshalutil.New().
+		WriteUint32(c.InstanceID).
+		WriteUint8(c.Threshold).
+		WriteUint8(uint8(len(c.DistributedPK))).
+		WriteBytes(c.DistributedPK).
+		WriteUint8(uint8(len(c.Identities))).
+		WriteBytes(publicKeys(c.Identities)).
+		Bytes()
+}
+
+// CommitteeFromBytes parses the serialized version of a Committee.
+func CommitteeFromBytes(bytes []byte) (committee *Committee, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if committee, err = CommitteeFromMarshalUtil(marshalUtil); err != nil {
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// CommitteeFromMarshalUtil parses a Committee using the given MarshalUtil.
+func CommitteeFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (committee *Committee, err error) {
+	committee = &Committee{}
+	if committee.InstanceID, err = marshalUtil.ReadUint32(); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse instance ID: %s", ErrInvalidCommittee, err)
+	}
+	if committee.Threshold, err = marshalUtil.ReadUint8(); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse threshold: %s", ErrInvalidCommittee, err)
+	}
+	dpkLength, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse distributed public key length: %s", ErrInvalidCommittee, err)
+	}
+	if committee.DistributedPK, err = marshalUtil.ReadBytes(int(dpkLength)); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse distributed public key: %s", ErrInvalidCommittee, err)
+	}
+	identitiesCount, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse identities count: %s", ErrInvalidCommittee, err)
+	}
+	committee.Identities = make([]ed25519.PublicKey, identitiesCount)
+	for i := range committee.Identities {
+		if committee.Identities[i], err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+			return nil, fmt.Errorf("%w: failed to parse identity: %s", ErrInvalidCommittee, err)
+		}
+	}
+
+	return committee, nil
+}
+
+// AliasStateData returns the state data of an alias output defining a committee with the given beacon type.
+func AliasStateData(beaconType Type, committee *Committee) []byte {
+	return marshalutil.New().
+		WriteUint8(beaconType).
+		WriteBytes(committee.Bytes()).
+		Bytes()
+}
+
+// CommitteeFromAliasOutput parses the committee and the beacon type defined in the state data of the given alias
+// output.
+func CommitteeFromAliasOutput(output *ledgerstate.AliasOutput) (beaconType Type, committee *Committee, err error) {
+	marshalUtil := marshalutil.New(output.GetStateData())
+	if beaconType, err = marshalUtil.ReadUint8(); err != nil {
+		return 0, nil, fmt.Errorf("%w: failed to parse beacon type of alias %s: %s", ErrInvalidCommittee, output.GetAliasAddress().Base58(), err)
+	}
+	if committee, err = CommitteeFromMarshalUtil(marshalUtil); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse committee of alias %s: %w", output.GetAliasAddress().Base58(), err)
+	}
+
+	return beaconType, committee, nil
+}
+
+func publicKeys(identities []ed25519.PublicKey) []byte {
+	bytes := make([]byte, 0, len(identities)*ed25519.PublicKeySize)
+	for _, identity := range identities {
+		bytes = append(bytes, identity.Bytes()...)
+	}
+	return bytes
+}
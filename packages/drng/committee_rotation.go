@@ -0,0 +1,318 @@
+package drng
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+var (
+	// ErrInvalidCommitteeRotation is returned if a committee rotation is malformed.
+	ErrInvalidCommitteeRotation = errors.New("invalid committee rotation")
+	// ErrInsufficientSignatures is returned if a committee rotation is not signed by a threshold of the committee.
+	ErrInsufficientSignatures = errors.New("insufficient committee signatures")
+)
+
+// region CommitteeRotationPayload /////////////////////////////////////////////////////////////////////////////////////
+
+// CommitteeSignature is the signature of a committee member.
+type CommitteeSignature struct {
+	// Identity of the committee member.
+	Issuer ed25519.PublicKey
+	// Signature of the committee member.
+	Signature ed25519.Signature
+}
+
+// CommitteeRotationPayload announces the committee taking over a dRNG instance from the given round on. It must be
+// signed by a threshold of the members of the current committee.
+type CommitteeRotationPayload struct {
+	Header
+
+	// Round from which on the beacons are issued by the new committee
+	Round uint64
+	// The new committee
+	Committee *Committee
+	// Signatures of the members of the current committee
+	Signatures []CommitteeSignature
+
+	bytes      []byte
+	bytesMutex sync.RWMutex
+}
+
+// NewCommitteeRotationPayload creates a new CommitteeRotationPayload.
+func NewCommitteeRotationPayload(instanceID uint32, round uint64, committee *Committee, signatures []CommitteeSignature) *CommitteeRotationPayload {
+	return &CommitteeRotationPayload{
+		Header:     NewHeader(TypeCommitteeRotation, instanceID),
+		Round:      round,
+		Committee:  committee,
+		Signatures: signatures,
+	}
+}
+
+// SignCommitteeRotation returns the CommitteeSignature of the given committee member for the rotation.
+func SignCommitteeRotation(keyPair *ed25519.KeyPair, instanceID uint32, round uint64, committee *Committee) CommitteeSignature {
+	return CommitteeSignature{
+		Issuer:    keyPair.PublicKey,
+		Signature: keyPair.PrivateKey.Sign(CommitteeRotationMessage(instanceID, round, committee)),
+	}
+}
+
+// CommitteeRotationMessage returns the message that is signed by the members of the current committee.
+func CommitteeRotationMessage(instanceID uint32, round uint64, committee *Committee) []byte {
+	return marshalutil.New().
+		WriteUint32(instanceID).
+		WriteUint64(round).
+		WriteBytes(committee.Bytes()).
+		Bytes()
+}
+
+// CommitteeRotationPayloadFromMarshalUtil is a wrapper for simplified unmarshaling in a byte stream using the marshalUtil package.
+func CommitteeRotationPayloadFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*CommitteeRotationPayload, error) {
+	unmarshalledPayload, err := marshalUtil.Parse(func(data []byte) (interface{}, int, error) { return CommitteeRotationPayloadFromBytes(data) })
+	if err != nil {
+		err = fmt.Errorf("failed to parse committee rotation payload: %w", err)
+		return nil, err
+	}
+
+	return unmarshalledPayload.(*CommitteeRotationPayload), nil
+}
+
+// CommitteeRotationPayloadFromBytes parses the marshaled version of a CommitteeRotationPayload into an object.
+func CommitteeRotationPayloadFromBytes(bytes []byte) (result *CommitteeRotationPayload, consumedBytes int, err error) {
+	// initialize helper
+	marshalUtil := marshalutil.New(bytes)
+
+	// read information that are required to identify the payload from the outside
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = fmt.Errorf("failed to parse payload size of committee rotation payload: %w", err)
+		return
+	}
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = fmt.Errorf("failed to parse payload type of committee rotation payload: %w", err)
+		return
+	}
+
+	// parse header
+	result = &CommitteeRotationPayload{}
+	if result.Header, err = HeaderFromMarshalUtil(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse header of committee rotation payload: %w", err)
+		return
+	}
+
+	// parse round
+	if result.Round, err = marshalUtil.ReadUint64(); err != nil {
+		err = fmt.Errorf("failed to parse round of committee rotation payload: %w", err)
+		return
+	}
+
+	// parse committee
+	if result.Committee, err = CommitteeFromMarshalUtil(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse committee of committee rotation payload: %w", err)
+		return
+	}
+
+	// parse signatures
+	signaturesCount, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = fmt.Errorf("failed to parse signatures count of committee rotation payload: %w", err)
+		return
+	}
+	result.Signatures = make([]CommitteeSignature, signaturesCount)
+	for i := range result.Signatures {
+		if result.Signatures[i].Issuer, err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+			err = fmt.Errorf("failed to parse signature issuer of committee rotation payload: %w", err)
+			return
+		}
+		if result.Signatures[i].Signature, err = ed25519.ParseSignature(marshalUtil); err != nil {
+			err = fmt.Errorf("failed to parse signature of committee rotation payload: %w", err)
+			return
+		}
+	}
+
+	// return the number of bytes we processed
+	consumedBytes = marshalUtil.ReadOffset()
+
+	// store bytes, so we don't have to marshal manually
+	result.bytes = bytes[:consumedBytes]
+
+	return
+}
+
+// Bytes returns the committee rotation payload bytes.
+func (p *CommitteeRotationPayload) Bytes() (bytes []byte) {
+	// acquire lock for reading bytes
+	p.bytesMutex.RLock()
+
+	// return if bytes have been determined already
+	if bytes = p.bytes; bytes != nil {
+		p.bytesMutex.RUnlock()
+		return
+	}
+
+	// switch to write lock
+	p.bytesMutex.RUnlock()
+	p.bytesMutex.Lock()
+	defer p.bytesMutex.Unlock()
+
+	// return if bytes have been determined in the mean time
+	if bytes = p.bytes; bytes != nil {
+		return
+	}
+
+	// marshal fields
+	payloadBytes := marshalutil.New().
+		WriteBytes(p.Header.Bytes()).
+		WriteUint64(p.Round).
+		WriteBytes(p.Committee.Bytes()).
+		WriteUint8(uint8(len(p.Signatures)))
+	for _, signature := range p.Signatures {
+		payloadBytes.Write(signature.Issuer)
+		payloadBytes.Write(signature.Signature)
+	}
+
+	marshalUtil := marshalutil.New(marshalutil.Uint32Size + marshalutil.Uint32Size + payloadBytes.WriteOffset())
+	marshalUtil.WriteUint32(payload.TypeLength + uint32(payloadBytes.WriteOffset()))
+	marshalUtil.WriteBytes(PayloadType.Bytes())
+	marshalUtil.WriteBytes(payloadBytes.Bytes())
+
+	bytes = marshalUtil.Bytes()
+
+	// store result
+	p.bytes = bytes
+
+	return
+}
+
+func (p *CommitteeRotationPayload) String() string {
+	return stringify.Struct("CommitteeRotationPayload",
+		stringify.StructField("type", uint64(p.Header.PayloadType)),
+		stringify.StructField("instance", uint64(p.Header.InstanceID)),
+		stringify.StructField("round", p.Round),
+		stringify.StructField("threshold", p.Committee.Threshold),
+		stringify.StructField("identities", len(p.Committee.Identities)),
+		stringify.StructField("signatures", len(p.Signatures)),
+	)
+}
+
+// Type returns the committee rotation payload type.
+func (p *CommitteeRotationPayload) Type() payload.Type {
+	return PayloadType
+}
+
+// Marshal marshals the committee rotation payload into bytes.
+func (p *CommitteeRotationPayload) Marshal() (bytes []byte, err error) {
+	return p.Bytes(), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CommitteeRotationEvent ///////////////////////////////////////////////////////////////////////////////////////
+
+// CommitteeRotationEvent holds data about a committee rotation event.
+type CommitteeRotationEvent struct {
+	// Public key of the issuer.
+	IssuerPublicKey ed25519.PublicKey
+	// Timestamp when the rotation was issued.
+	Timestamp time.Time
+	// InstanceID of the rotated committee.
+	InstanceID uint32
+	// Round from which on the new committee is in charge.
+	Round uint64
+	// The new committee.
+	Committee *Committee
+}
+
+// CommitteeRotationReceived returns the data of a committee rotation event.
+func CommitteeRotationReceived(handler interface{}, params ...interface{}) {
+	handler.(func(*CommitteeRotationEvent))(params[0].(*CommitteeRotationEvent))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// processCommitteeRotation verifies the given committee rotation and schedules it in the state of its instance.
+func (d *DRNG) processCommitteeRotation(issuer ed25519.PublicKey, timestamp time.Time, payload *Payload) error {
+	rotation, err := CommitteeRotationPayloadFromMarshalUtil(marshalutil.New(payload.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	state := d.LoadState(rotation.InstanceID)
+	if state == nil {
+		return ErrInstanceIDMismatch
+	}
+	if err := VerifyCommitteeRotation(state, issuer, rotation); err != nil {
+		return err
+	}
+
+	state.ScheduleCommitteeRotation(rotation.Round, rotation.Committee)
+	if d.rotationStore != nil {
+		if err := d.rotationStore.StoreScheduledRotation(rotation.InstanceID, rotation.Round, rotation.Committee); err != nil {
+			return err
+		}
+	}
+
+	d.Events.CommitteeRotation.Trigger(&CommitteeRotationEvent{
+		IssuerPublicKey: issuer,
+		Timestamp:       timestamp,
+		InstanceID:      rotation.InstanceID,
+		Round:           rotation.Round,
+		Committee:       rotation.Committee,
+	})
+
+	return nil
+}
+
+// VerifyCommitteeRotation checks that the rotation is well-formed and signed by a threshold of the current committee.
+func VerifyCommitteeRotation(state *State, issuer ed25519.PublicKey, rotation *CommitteeRotationPayload) error {
+	if err := verifyIssuer(state, issuer); err != nil {
+		return err
+	}
+	if rotation.Round <= state.Randomness().Round {
+		return fmt.Errorf("%w: committee rotation round is %d, but current state is %d", ErrInvalidRound, rotation.Round, state.Randomness().Round)
+	}
+
+	committee := rotation.Committee
+	if committee.InstanceID != rotation.InstanceID {
+		return fmt.Errorf("%w: instanceID of the new committee is %d, expected %d", ErrInvalidCommitteeRotation, committee.InstanceID, rotation.InstanceID)
+	}
+	if len(committee.Identities) == 0 || int(committee.Threshold) > len(committee.Identities) {
+		return fmt.Errorf("%w: threshold %d with %d identities", ErrInvalidCommitteeRotation, committee.Threshold, len(committee.Identities))
+	}
+	if l := len(committee.DistributedPK); l != 0 && l != PublicKeySize {
+		return fmt.Errorf("%w: invalid distributed public key length: %d, need %d", ErrInvalidCommitteeRotation, l, PublicKeySize)
+	}
+
+	currentCommittee := state.Committee()
+	members := make(map[ed25519.PublicKey]bool, len(currentCommittee.Identities))
+	for _, identity := range currentCommittee.Identities {
+		members[identity] = true
+	}
+
+	message := CommitteeRotationMessage(rotation.InstanceID, rotation.Round, committee)
+	validSignatures := 0
+	for _, signature := range rotation.Signatures {
+		if !members[signature.Issuer] || !signature.Issuer.VerifySignature(message, signature.Signature) {
+			continue
+		}
+		// count every member only once
+		delete(members, signature.Issuer)
+		validSignatures++
+	}
+
+	required := int(currentCommittee.Threshold)
+	if required == 0 {
+		required = 1
+	}
+	if validSignatures < required {
+		return fmt.Errorf("%w: %d valid signatures, need %d", ErrInsufficientSignatures, validSignatures, required)
+	}
+
+	return nil
+}
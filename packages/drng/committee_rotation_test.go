@@ -0,0 +1,110 @@
+package drng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+func committeeRotationPayload(t *testing.T, round uint64, committee *Committee, signers ...ed25519.KeyPair) *Payload {
+	signatures := make([]CommitteeSignature, len(signers))
+	for i := range signers {
+		signatures[i] = SignCommitteeRotation(&signers[i], committee.InstanceID, round, committee)
+	}
+
+	parsedPayload, err := PayloadFromMarshalUtil(marshalutil.New(NewCommitteeRotationPayload(committee.InstanceID, round, committee, signatures).Bytes()))
+	require.NoError(t, err)
+	return parsedPayload
+}
+
+func TestCommitteeRotationPayload(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	committee := &Committee{InstanceID: 5, Threshold: 1, Identities: []ed25519.PublicKey{keyPair.PublicKey}, DistributedPK: dpkTest}
+	rotation := NewCommitteeRotationPayload(5, 10, committee, []CommitteeSignature{SignCommitteeRotation(&keyPair, 5, 10, committee)})
+
+	parsedRotation, err := CommitteeRotationPayloadFromMarshalUtil(marshalutil.New(rotation.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, TypeCommitteeRotation, parsedRotation.PayloadType)
+	assert.Equal(t, uint64(10), parsedRotation.Round)
+	assert.Equal(t, committee, parsedRotation.Committee)
+	assert.Equal(t, rotation.Signatures, parsedRotation.Signatures)
+	assert.Equal(t, rotation.Bytes(), parsedRotation.Bytes())
+}
+
+func TestCommitteeRotation(t *testing.T) {
+	currentMembers := []ed25519.KeyPair{ed25519.GenerateKeyPair(), ed25519.GenerateKeyPair(), ed25519.GenerateKeyPair()}
+	newMember := ed25519.GenerateKeyPair()
+	timestamp := clock.SyncedTime()
+
+	config := make(map[uint32][]Option)
+	config[2] = []Option{
+		SetCommittee(&Committee{
+			InstanceID: 2,
+			Threshold:  2,
+			Identities: []ed25519.PublicKey{currentMembers[0].PublicKey, currentMembers[1].PublicKey, currentMembers[2].PublicKey},
+		}),
+		SetBeaconType(TypeED25519Beacon),
+	}
+	drng := New(config)
+
+	var rotationEvent *CommitteeRotationEvent
+	drng.Events.CommitteeRotation.Attach(events.NewClosure(func(event *CommitteeRotationEvent) {
+		rotationEvent = event
+	}))
+	rotated := 0
+	drng.Events.CommitteeRotated.Attach(events.NewClosure(func(*State) {
+		rotated++
+	}))
+
+	require.NoError(t, drng.Dispatch(currentMembers[0].PublicKey, timestamp, ed25519BeaconPayload(t, &currentMembers[0], 2, 1)))
+
+	newCommittee := &Committee{InstanceID: 2, Threshold: 1, Identities: []ed25519.PublicKey{newMember.PublicKey}, DistributedPK: []byte{}}
+
+	// not enough signatures of the current committee
+	err := drng.Dispatch(currentMembers[0].PublicKey, timestamp, committeeRotationPayload(t, 3, newCommittee, currentMembers[0], currentMembers[0]))
+	assert.True(t, errors.Is(err, ErrInsufficientSignatures))
+
+	// signed by the new committee only
+	err = drng.Dispatch(currentMembers[0].PublicKey, timestamp, committeeRotationPayload(t, 3, newCommittee, newMember))
+	assert.True(t, errors.Is(err, ErrInsufficientSignatures))
+
+	// rotation in the past
+	err = drng.Dispatch(currentMembers[0].PublicKey, timestamp, committeeRotationPayload(t, 1, newCommittee, currentMembers[0], currentMembers[1]))
+	assert.True(t, errors.Is(err, ErrInvalidRound))
+	assert.Nil(t, rotationEvent)
+
+	// valid rotation
+	require.NoError(t, drng.Dispatch(currentMembers[0].PublicKey, timestamp, committeeRotationPayload(t, 3, newCommittee, currentMembers[0], currentMembers[1])))
+	require.NotNil(t, rotationEvent)
+	assert.Equal(t, uint64(3), rotationEvent.Round)
+	assert.Equal(t, newCommittee, rotationEvent.Committee)
+	round, scheduledCommittee, scheduled := drng.State[2].ScheduledCommitteeRotation()
+	assert.True(t, scheduled)
+	assert.Equal(t, uint64(3), round)
+	assert.Equal(t, newCommittee, scheduledCommittee)
+
+	// the current committee is in charge until the rotation round
+	require.NoError(t, drng.Dispatch(currentMembers[1].PublicKey, timestamp, ed25519BeaconPayload(t, &currentMembers[1], 2, 2)))
+	assert.Equal(t, 0, rotated)
+
+	// beacons of the old committee are rejected from the rotation round on
+	err = drng.Dispatch(currentMembers[1].PublicKey, timestamp, ed25519BeaconPayload(t, &currentMembers[1], 2, 3))
+	assert.True(t, errors.Is(err, ErrInvalidIssuer))
+	assert.Equal(t, 0, rotated)
+	assert.Len(t, drng.State[2].Committee().Identities, 3)
+
+	// the new committee takes over
+	require.NoError(t, drng.Dispatch(newMember.PublicKey, timestamp, ed25519BeaconPayload(t, &newMember, 2, 3)))
+	assert.Equal(t, 1, rotated)
+	assert.Equal(t, *newCommittee, drng.State[2].Committee())
+	assert.Equal(t, uint64(3), drng.State[2].Randomness().Round)
+	_, _, scheduled = drng.State[2].ScheduledCommitteeRotation()
+	assert.False(t, scheduled)
+}
@@ -0,0 +1,26 @@
+package drng
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitteeBytes(t *testing.T) {
+	committee := &Committee{
+		InstanceID:    42,
+		Threshold:     2,
+		Identities:    []ed25519.PublicKey{ed25519.GenerateKeyPair().PublicKey, ed25519.GenerateKeyPair().PublicKey},
+		DistributedPK: dpkTest,
+	}
+
+	parsedCommittee, consumedBytes, err := CommitteeFromBytes(committee.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(committee.Bytes()), consumedBytes)
+	assert.Equal(t, committee, parsedCommittee)
+
+	_, _, err = CommitteeFromBytes(committee.Bytes()[:10])
+	assert.Error(t, err)
+}
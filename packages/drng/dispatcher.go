@@ -1,54 +1,78 @@
 package drng
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/marshalutil"
+)
+
+var (
+	// ErrUnsupportedBeaconType is returned if no BeaconSource is registered for the payload type.
+	ErrUnsupportedBeaconType = errors.New("subtype not implemented")
+	// ErrBeaconTypeMismatch is returned if the payload type does not match the beacon type of the dRNG instance.
+	ErrBeaconTypeMismatch = errors.New("beacon type does not match")
 )
 
 // Dispatch parses a DRNG message and processes it based on its subtype
 func (d *DRNG) Dispatch(issuer ed25519.PublicKey, timestamp time.Time, payload *Payload) error {
-	switch payload.PayloadType {
-	case TypeCollectiveBeacon:
-		// parse as CollectiveBeaconType
-		marshalUtil := marshalutil.New(payload.Bytes())
-		parsedPayload, err := CollectiveBeaconPayloadFromMarshalUtil(marshalUtil)
+	if payload.PayloadType == TypeCommitteeRotation {
+		return d.processCommitteeRotation(issuer, timestamp, payload)
+	}
+
+	source, exists := d.sources[payload.PayloadType]
+	if !exists {
+		return ErrUnsupportedBeaconType
+	}
+
+	state := d.LoadState(payload.InstanceID)
+	if state != nil && state.BeaconType() != payload.PayloadType {
+		return fmt.Errorf("%w: instance %d expects beacon type %d, got %d", ErrBeaconTypeMismatch, payload.InstanceID, state.BeaconType(), payload.PayloadType)
+	}
+
+	// hand over to the scheduled committee if the beacon belongs to its rounds, and hand back if it is not valid
+	var previousCommittee *Committee
+	if state != nil {
+		round, err := source.Round(payload)
 		if err != nil {
 			return err
 		}
-		// trigger CollectiveBeacon Event
-		cbEvent := &CollectiveBeaconEvent{
-			IssuerPublicKey: issuer,
-			Timestamp:       timestamp,
-			InstanceID:      parsedPayload.Header.InstanceID,
-			Round:           parsedPayload.Round,
-			PrevSignature:   parsedPayload.PrevSignature,
-			Signature:       parsedPayload.Signature,
-			Dpk:             parsedPayload.Dpk,
-		}
-		d.Events.CollectiveBeacon.Trigger(cbEvent)
+		previousCommittee = state.applyCommitteeRotation(round)
+	}
 
-		// process collectiveBeacon
-		if _, ok := d.State[cbEvent.InstanceID]; !ok {
-			return ErrInstanceIDMismatch
-		}
-		if err := ProcessBeacon(d.State[cbEvent.InstanceID], cbEvent); err != nil {
-			return err
+	randomness, err := source.ProcessBeacon(d, issuer, timestamp, payload)
+	if err != nil {
+		if previousCommittee != nil {
+			state.revertCommitteeRotation(previousCommittee)
 		}
+		return err
+	}
 
-		// update the dpk (if not set) from the valid beacon
-		if len(d.State[cbEvent.InstanceID].committee.DistributedPK) == 0 {
-			d.State[cbEvent.InstanceID].UpdateDPK(cbEvent.Dpk)
+	if previousCommittee != nil {
+		if d.rotationStore != nil {
+			committee := state.Committee()
+			if err := d.rotationStore.StoreRotatedCommittee(&committee); err != nil {
+				return err
+			}
 		}
+		d.Events.CommitteeRotated.Trigger(state)
+	}
 
-		// trigger RandomnessEvent
-		d.Events.Randomness.Trigger(d.State[cbEvent.InstanceID])
+	state.UpdateRandomness(randomness)
 
-		return nil
+	// trigger RandomnessEvent
+	d.Events.Randomness.Trigger(state)
 
-	default:
-		return errors.New("subtype not implemented")
+	if d.beaconStore == nil {
+		return nil
 	}
+	return d.beaconStore.Store(&Beacon{
+		InstanceID: payload.InstanceID,
+		Round:      randomness.Round,
+		Timestamp:  randomness.Timestamp,
+		Randomness: randomness.Randomness,
+		Issuer:     issuer,
+		Payload:    payload.Bytes(),
+	})
 }
@@ -8,26 +8,81 @@ import (
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 )
 
+// DefaultHistorySize defines the default number of past randomness values kept in memory by each State.
+const DefaultHistorySize = 100
+
 // DRNG holds the state and events of a drng instance.
 type DRNG struct {
 	State  map[uint32]*State // The state of the DRNG.
 	Events *Event            // The events fired on the DRNG.
+
+	sources       map[Type]BeaconSource
+	beaconStore   *BeaconStore
+	rotationStore *RotationStore
 }
 
 // New creates a new DRNG instance.
 func New(config map[uint32][]Option) *DRNG {
 	drng := &DRNG{
-		State:  make(map[uint32]*State),
-		Events: newEvent(),
+		State:   make(map[uint32]*State),
+		Events:  newEvent(),
+		sources: make(map[Type]BeaconSource),
 	}
 
 	for id, setters := range config {
 		drng.State[id] = NewState(setters...)
 	}
 
+	drng.RegisterBeaconSource(CollectiveBeaconSource{})
+	drng.RegisterBeaconSource(ED25519BeaconSource{})
+
 	return drng
 }
 
+// RegisterBeaconSource registers the BeaconSource for the payload type it handles. It replaces any BeaconSource
+// previously registered for the same type.
+func (d *DRNG) RegisterBeaconSource(source BeaconSource) {
+	d.sources[source.Type()] = source
+}
+
+// SetBeaconStore sets the BeaconStore used to persist the processed beacons.
+func (d *DRNG) SetBeaconStore(beaconStore *BeaconStore) {
+	d.beaconStore = beaconStore
+}
+
+// BeaconStore returns the BeaconStore used to persist the processed beacons, or nil if beacons are not persisted.
+func (d *DRNG) BeaconStore() *BeaconStore {
+	return d.beaconStore
+}
+
+// SetRotationStore sets the RotationStore used to persist the committee rotations.
+func (d *DRNG) SetRotationStore(rotationStore *RotationStore) {
+	d.rotationStore = rotationStore
+}
+
+// RestoreCommitteeRotations restores the persisted committee rotations of the given instances, or of all the
+// instances if none is given.
+func (d *DRNG) RestoreCommitteeRotations(instanceIDs ...uint32) error {
+	if d.rotationStore == nil {
+		return nil
+	}
+	if len(instanceIDs) == 0 {
+		for instanceID := range d.State {
+			instanceIDs = append(instanceIDs, instanceID)
+		}
+	}
+	for _, instanceID := range instanceIDs {
+		state := d.LoadState(instanceID)
+		if state == nil {
+			continue
+		}
+		if err := d.rotationStore.Restore(instanceID, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // LoadState returns the pointer to the state associated to the given instanceID.
 func (d *DRNG) LoadState(instanceID uint32) *State {
 	s, ok := d.State[instanceID]
@@ -43,6 +98,10 @@ type Options struct {
 	Committee *Committee
 	// The initial randomness of the DRNG.
 	Randomness *Randomness
+	// The type of the beacons accepted by the DRNG.
+	BeaconType Type
+	// The number of past randomness values kept in memory.
+	HistorySize int
 }
 
 // Option is a function which sets the given option.
@@ -62,6 +121,20 @@ func SetRandomness(r *Randomness) Option {
 	}
 }
 
+// SetBeaconType sets the type of the beacons accepted by the DRNG instance.
+func SetBeaconType(t Type) Option {
+	return func(args *Options) {
+		args.BeaconType = t
+	}
+}
+
+// SetHistorySize sets the number of past randomness values kept in memory.
+func SetHistorySize(size int) Option {
+	return func(args *Options) {
+		args.HistorySize = size
+	}
+}
+
 // Randomness defines the current randomness state of a DRNG instance.
 type Randomness struct {
 	// Round holds the current DRNG round.
@@ -91,22 +164,33 @@ type Committee struct {
 
 // State represents the state of the DRNG.
 type State struct {
-	randomness *Randomness
-	committee  *Committee
+	randomness  *Randomness
+	committee   *Committee
+	beaconType  Type
+	history     []Randomness
+	historySize int
+
+	rotationRound    uint64
+	rotatedCommittee *Committee
 
 	mutex sync.RWMutex
 }
 
 // NewState creates a new State with the given optional options
 func NewState(setters ...Option) *State {
-	args := &Options{}
+	args := &Options{
+		BeaconType:  TypeCollectiveBeacon,
+		HistorySize: DefaultHistorySize,
+	}
 
 	for _, setter := range setters {
 		setter(args)
 	}
 	return &State{
-		randomness: args.Randomness,
-		committee:  args.Committee,
+		randomness:  args.Randomness,
+		committee:   args.Committee,
+		beaconType:  args.BeaconType,
+		historySize: args.HistorySize,
 	}
 }
 
@@ -115,6 +199,77 @@ func (s *State) UpdateRandomness(r *Randomness) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	s.randomness = r
+
+	if s.historySize <= 0 {
+		return
+	}
+	if len(s.history) >= s.historySize {
+		s.history = s.history[len(s.history)-s.historySize+1:]
+	}
+	s.history = append(s.history, *r)
+}
+
+// History returns the past randomness values of the DRNG state, ordered from the oldest to the latest.
+func (s *State) History() []Randomness {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	history := make([]Randomness, len(s.history))
+	copy(history, s.history)
+	return history
+}
+
+// BeaconType returns the type of the beacons accepted by the DRNG state.
+func (s *State) BeaconType() Type {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.beaconType
+}
+
+// UpdateBeaconType updates the type of the beacons accepted by the DRNG state
+func (s *State) UpdateBeaconType(t Type) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.beaconType = t
+}
+
+// ScheduleCommitteeRotation schedules the given committee to take over the DRNG state from the given round on. It
+// replaces any previously scheduled rotation.
+func (s *State) ScheduleCommitteeRotation(round uint64, c *Committee) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rotationRound = round
+	s.rotatedCommittee = c
+}
+
+// ScheduledCommitteeRotation returns the scheduled committee rotation of the DRNG state, if any.
+func (s *State) ScheduledCommitteeRotation() (round uint64, c *Committee, scheduled bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.rotationRound, s.rotatedCommittee, s.rotatedCommittee != nil
+}
+
+// applyCommitteeRotation replaces the committee with the scheduled one if it is in charge of the given round. It
+// returns the replaced committee, or nil if no rotation took place.
+func (s *State) applyCommitteeRotation(round uint64) (previous *Committee) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.rotatedCommittee == nil || round < s.rotationRound {
+		return nil
+	}
+	previous = s.committee
+	if previous == nil {
+		previous = &Committee{}
+	}
+	s.committee, s.rotatedCommittee = s.rotatedCommittee, nil
+	return previous
+}
+
+// revertCommitteeRotation restores the given committee and schedules the current one again.
+func (s *State) revertCommitteeRotation(previous *Committee) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.committee, s.rotatedCommittee = previous, s.committee
 }
 
 // Randomness returns the randomness of the DRNG state
@@ -0,0 +1,223 @@
+package drng
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+// ErrInvalidBeaconSignature is returned if the signature of an ED25519BeaconPayload is invalid.
+var ErrInvalidBeaconSignature = errors.New("invalid beacon signature")
+
+// region ED25519BeaconPayload /////////////////////////////////////////////////////////////////////////////////////////
+
+// ED25519BeaconPayload is a beacon payload issued by a single node. The randomness is derived from the deterministic
+// ed25519 signature of the instance ID and the round, which makes it verifiable by anyone knowing the public key of
+// the node. It is intended for test networks that do not run a drand committee.
+type ED25519BeaconPayload struct {
+	Header
+
+	// Round of the current beacon
+	Round uint64
+	// Signature of the beacon message of the round
+	Signature ed25519.Signature
+
+	bytes      []byte
+	bytesMutex sync.RWMutex
+}
+
+// NewED25519BeaconPayload creates a new ED25519BeaconPayload.
+func NewED25519BeaconPayload(instanceID uint32, round uint64, signature ed25519.Signature) *ED25519BeaconPayload {
+	return &ED25519BeaconPayload{
+		Header:    NewHeader(TypeED25519Beacon, instanceID),
+		Round:     round,
+		Signature: signature,
+	}
+}
+
+// SignED25519Beacon creates a new ED25519BeaconPayload for the given round signed with the given key pair.
+func SignED25519Beacon(keyPair *ed25519.KeyPair, instanceID uint32, round uint64) *ED25519BeaconPayload {
+	return NewED25519BeaconPayload(instanceID, round, keyPair.PrivateKey.Sign(ED25519BeaconMessage(instanceID, round)))
+}
+
+// ED25519BeaconMessage returns the message that is signed by the issuer of an ED25519BeaconPayload.
+func ED25519BeaconMessage(instanceID uint32, round uint64) []byte {
+	return marshalutil.New(marshalutil.Uint32Size + marshalutil.Uint64Size).
+		WriteUint32(instanceID).
+		WriteUint64(round).
+		Bytes()
+}
+
+// ED25519BeaconPayloadFromMarshalUtil is a wrapper for simplified unmarshaling in a byte stream using the marshalUtil package.
+func ED25519BeaconPayloadFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*ED25519BeaconPayload, error) {
+	unmarshalledPayload, err := marshalUtil.Parse(func(data []byte) (interface{}, int, error) { return ED25519BeaconPayloadFromBytes(data) })
+	if err != nil {
+		err = fmt.Errorf("failed to parse ed25519 beacon payload: %w", err)
+		return nil, err
+	}
+
+	return unmarshalledPayload.(*ED25519BeaconPayload), nil
+}
+
+// ED25519BeaconPayloadFromBytes parses the marshaled version of an ED25519BeaconPayload into an object.
+func ED25519BeaconPayloadFromBytes(bytes []byte) (result *ED25519BeaconPayload, consumedBytes int, err error) {
+	// initialize helper
+	marshalUtil := marshalutil.New(bytes)
+
+	// read information that are required to identify the payload from the outside
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = fmt.Errorf("failed to parse payload size of ed25519 beacon payload: %w", err)
+		return
+	}
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = fmt.Errorf("failed to parse payload type of ed25519 beacon payload: %w", err)
+		return
+	}
+
+	// parse header
+	result = &ED25519BeaconPayload{}
+	if result.Header, err = HeaderFromMarshalUtil(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse header of ed25519 beacon payload: %w", err)
+		return
+	}
+
+	// parse round
+	if result.Round, err = marshalUtil.ReadUint64(); err != nil {
+		err = fmt.Errorf("failed to parse round of ed25519 beacon payload: %w", err)
+		return
+	}
+
+	// parse signature
+	if result.Signature, err = ed25519.ParseSignature(marshalUtil); err != nil {
+		err = fmt.Errorf("failed to parse signature of ed25519 beacon payload: %w", err)
+		return
+	}
+
+	// return the number of bytes we processed
+	consumedBytes = marshalUtil.ReadOffset()
+
+	// store bytes, so we don't have to marshal manually
+	result.bytes = bytes[:consumedBytes]
+
+	return
+}
+
+// Bytes returns the ed25519 beacon payload bytes.
+func (p *ED25519BeaconPayload) Bytes() (bytes []byte) {
+	// acquire lock for reading bytes
+	p.bytesMutex.RLock()
+
+	// return if bytes have been determined already
+	if bytes = p.bytes; bytes != nil {
+		p.bytesMutex.RUnlock()
+		return
+	}
+
+	// switch to write lock
+	p.bytesMutex.RUnlock()
+	p.bytesMutex.Lock()
+	defer p.bytesMutex.Unlock()
+
+	// return if bytes have been determined in the mean time
+	if bytes = p.bytes; bytes != nil {
+		return
+	}
+
+	// marshal fields
+	payloadLength := HeaderLength + marshalutil.Uint64Size + ed25519.SignatureSize
+	marshalUtil := marshalutil.New(marshalutil.Uint32Size + marshalutil.Uint32Size + payloadLength)
+	marshalUtil.WriteUint32(payload.TypeLength + uint32(payloadLength))
+	marshalUtil.WriteBytes(PayloadType.Bytes())
+	marshalUtil.WriteBytes(p.Header.Bytes())
+	marshalUtil.WriteUint64(p.Round)
+	marshalUtil.Write(p.Signature)
+
+	bytes = marshalUtil.Bytes()
+
+	// store result
+	p.bytes = bytes
+
+	return
+}
+
+func (p *ED25519BeaconPayload) String() string {
+	return stringify.Struct("ED25519BeaconPayload",
+		stringify.StructField("type", uint64(p.Header.PayloadType)),
+		stringify.StructField("instance", uint64(p.Header.InstanceID)),
+		stringify.StructField("round", p.Round),
+		stringify.StructField("signature", p.Signature),
+	)
+}
+
+// Type returns the ed25519 beacon payload type.
+func (p *ED25519BeaconPayload) Type() payload.Type {
+	return PayloadType
+}
+
+// Marshal marshals the ed25519 beacon payload into bytes.
+func (p *ED25519BeaconPayload) Marshal() (bytes []byte, err error) {
+	return p.Bytes(), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ED25519BeaconSource //////////////////////////////////////////////////////////////////////////////////////////
+
+// ED25519BeaconSource is the BeaconSource of the beacons issued by a single node with ed25519 signatures.
+type ED25519BeaconSource struct{}
+
+// Type returns the payload Type of the beacons handled by the BeaconSource.
+func (ED25519BeaconSource) Type() Type {
+	return TypeED25519Beacon
+}
+
+// Round returns the round of the given ed25519 beacon.
+func (ED25519BeaconSource) Round(payload *Payload) (uint64, error) {
+	parsedPayload, err := ED25519BeaconPayloadFromMarshalUtil(marshalutil.New(payload.Bytes()))
+	if err != nil {
+		return 0, err
+	}
+	return parsedPayload.Round, nil
+}
+
+// ProcessBeacon parses and verifies the given ed25519 beacon and returns the resulting randomness.
+func (ED25519BeaconSource) ProcessBeacon(drng *DRNG, issuer ed25519.PublicKey, timestamp time.Time, payload *Payload) (*Randomness, error) {
+	parsedPayload, err := ED25519BeaconPayloadFromMarshalUtil(marshalutil.New(payload.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	state := drng.LoadState(parsedPayload.InstanceID)
+	if state == nil {
+		return nil, ErrInstanceIDMismatch
+	}
+	if err := verifyIssuer(state, issuer); err != nil {
+		return nil, err
+	}
+	if parsedPayload.Round <= state.Randomness().Round {
+		return nil, fmt.Errorf("%w: ed25519 beacon round is %d, but current state is %d", ErrInvalidRound, parsedPayload.Round, state.Randomness().Round)
+	}
+	if !issuer.VerifySignature(ED25519BeaconMessage(parsedPayload.InstanceID, parsedPayload.Round), parsedPayload.Signature) {
+		return nil, fmt.Errorf("%w: round %d of instance %d", ErrInvalidBeaconSignature, parsedPayload.Round, parsedPayload.InstanceID)
+	}
+
+	randomness, err := ExtractRandomness(parsedPayload.Signature.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Randomness{
+		Round:      parsedPayload.Round,
+		Randomness: randomness,
+		Timestamp:  timestamp,
+	}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
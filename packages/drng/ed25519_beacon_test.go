@@ -0,0 +1,78 @@
+package drng
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+func ed25519BeaconPayload(t *testing.T, keyPair *ed25519.KeyPair, instanceID uint32, round uint64) *Payload {
+	parsedPayload, err := PayloadFromMarshalUtil(marshalutil.New(SignED25519Beacon(keyPair, instanceID, round).Bytes()))
+	require.NoError(t, err)
+	return parsedPayload
+}
+
+func TestED25519BeaconPayload(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	beacon := SignED25519Beacon(&keyPair, 2, 10)
+
+	parsedBeacon, err := ED25519BeaconPayloadFromMarshalUtil(marshalutil.New(beacon.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, TypeED25519Beacon, parsedBeacon.PayloadType)
+	assert.Equal(t, uint32(2), parsedBeacon.InstanceID)
+	assert.Equal(t, uint64(10), parsedBeacon.Round)
+	assert.Equal(t, beacon.Signature, parsedBeacon.Signature)
+	assert.Equal(t, beacon.Bytes(), parsedBeacon.Bytes())
+}
+
+func TestED25519BeaconDispatch(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	otherKeyPair := ed25519.GenerateKeyPair()
+	timestamp := clock.SyncedTime()
+
+	config := make(map[uint32][]Option)
+	config[2] = []Option{
+		SetCommittee(&Committee{InstanceID: 2, Identities: []ed25519.PublicKey{keyPair.PublicKey}}),
+		SetBeaconType(TypeED25519Beacon),
+		SetHistorySize(2),
+	}
+	config[1] = []Option{SetCommittee(committeeTest)}
+	drng := New(config)
+
+	// valid beacons
+	for round := uint64(1); round <= 3; round++ {
+		require.NoError(t, drng.Dispatch(keyPair.PublicKey, timestamp, ed25519BeaconPayload(t, &keyPair, 2, round)))
+		assert.Equal(t, round, drng.State[2].Randomness().Round)
+	}
+	history := drng.State[2].History()
+	require.Len(t, history, 2)
+	assert.Equal(t, uint64(2), history[0].Round)
+	assert.Equal(t, uint64(3), history[1].Round)
+
+	expectedRandomness, err := ExtractRandomness(SignED25519Beacon(&keyPair, 2, 3).Signature.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, expectedRandomness, drng.State[2].Randomness().Randomness)
+
+	// replayed round
+	err = drng.Dispatch(keyPair.PublicKey, timestamp, ed25519BeaconPayload(t, &keyPair, 2, 3))
+	assert.True(t, errors.Is(err, ErrInvalidRound))
+
+	// issuer not in the committee
+	err = drng.Dispatch(otherKeyPair.PublicKey, timestamp, ed25519BeaconPayload(t, &otherKeyPair, 2, 4))
+	assert.True(t, errors.Is(err, ErrInvalidIssuer))
+
+	// signature of a different key
+	err = drng.Dispatch(keyPair.PublicKey, timestamp, ed25519BeaconPayload(t, &otherKeyPair, 2, 4))
+	assert.True(t, errors.Is(err, ErrInvalidBeaconSignature))
+
+	// beacon type not accepted by the instance
+	err = drng.Dispatch(keyPair.PublicKey, timestamp, ed25519BeaconPayload(t, &keyPair, 1, 4))
+	assert.True(t, errors.Is(err, ErrBeaconTypeMismatch))
+	assert.Equal(t, uint64(3), drng.State[2].Randomness().Round)
+}
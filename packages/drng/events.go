@@ -36,12 +36,18 @@ type Event struct {
 	CollectiveBeacon *events.Event
 	// Randomness is triggered each time we receive a new and valid CollectiveBeacon message.
 	Randomness *events.Event
+	// CommitteeRotation is triggered each time a valid committee rotation has been scheduled.
+	CommitteeRotation *events.Event
+	// CommitteeRotated is triggered each time a scheduled committee rotation takes effect.
+	CommitteeRotated *events.Event
 }
 
 func newEvent() *Event {
 	return &Event{
-		CollectiveBeacon: events.NewEvent(CollectiveBeaconReceived),
-		Randomness:       events.NewEvent(randomnessReceived),
+		CollectiveBeacon:  events.NewEvent(CollectiveBeaconReceived),
+		Randomness:        events.NewEvent(randomnessReceived),
+		CommitteeRotation: events.NewEvent(CommitteeRotationReceived),
+		CommitteeRotated:  events.NewEvent(randomnessReceived),
 	}
 }
 
@@ -10,6 +10,10 @@ type Type = byte
 const (
 	// TypeCollectiveBeacon defines a CollectiveBeacon payload type
 	TypeCollectiveBeacon Type = 1
+	// TypeED25519Beacon defines an ED25519Beacon payload type
+	TypeED25519Beacon Type = 2
+	// TypeCommitteeRotation defines a CommitteeRotation payload type
+	TypeCommitteeRotation Type = 3
 )
 
 // HeaderLength defines the length of a DRNG header
@@ -0,0 +1,104 @@
+package drng
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+)
+
+const (
+	// rotatedCommitteeKey is the key suffix of the committee that took over an instance through a rotation.
+	rotatedCommitteeKey byte = iota
+	// scheduledRotationKey is the key suffix of the committee rotation that is scheduled for an instance.
+	scheduledRotationKey
+)
+
+// region RotationStore ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RotationStore is a persistent store of the committee rotations of the dRNG instances. It keeps, for every instance,
+// the committee that took over through the latest applied rotation and the rotation that is still scheduled, so that
+// a restarted node hands over to the same committees as before.
+type RotationStore struct {
+	store kvstore.KVStore
+}
+
+// NewRotationStore creates a new RotationStore.
+func NewRotationStore(store kvstore.KVStore) *RotationStore {
+	return &RotationStore{
+		store: store.WithRealm([]byte{database.PrefixDRNGRotations}),
+	}
+}
+
+// StoreScheduledRotation stores the committee that is scheduled to take over the given instance from the given round on.
+func (r *RotationStore) StoreScheduledRotation(instanceID uint32, round uint64, committee *Committee) error {
+	value := marshalutil.New().WriteUint64(round).WriteBytes(committee.Bytes()).Bytes()
+	if err := r.store.Set(rotationKey(instanceID, scheduledRotationKey), value); err != nil {
+		return fmt.Errorf("failed to store committee rotation of instance %d: %w", instanceID, err)
+	}
+	return nil
+}
+
+// StoreRotatedCommittee stores the committee that took over its instance and removes the applied scheduled rotation.
+func (r *RotationStore) StoreRotatedCommittee(committee *Committee) error {
+	batch := r.store.Batched()
+	if err := batch.Set(rotationKey(committee.InstanceID, rotatedCommitteeKey), committee.Bytes()); err != nil {
+		batch.Cancel()
+		return fmt.Errorf("failed to store rotated committee of instance %d: %w", committee.InstanceID, err)
+	}
+	if err := batch.Delete(rotationKey(committee.InstanceID, scheduledRotationKey)); err != nil {
+		batch.Cancel()
+		return fmt.Errorf("failed to store rotated committee of instance %d: %w", committee.InstanceID, err)
+	}
+	return batch.Commit()
+}
+
+// Restore replaces the committee of the given state with the stored rotated committee of its instance and schedules the
+// stored committee rotation again.
+func (r *RotationStore) Restore(instanceID uint32, state *State) error {
+	data, err := r.get(rotationKey(instanceID, rotatedCommitteeKey))
+	if err != nil {
+		return err
+	}
+	if data != nil {
+		committee, _, err := CommitteeFromBytes(data)
+		if err != nil {
+			return fmt.Errorf("failed to restore rotated committee of instance %d: %w", instanceID, err)
+		}
+		state.UpdateCommittee(committee)
+	}
+
+	if data, err = r.get(rotationKey(instanceID, scheduledRotationKey)); err != nil || data == nil {
+		return err
+	}
+	marshalUtil := marshalutil.New(data)
+	round, err := marshalUtil.ReadUint64()
+	if err != nil {
+		return fmt.Errorf("failed to restore committee rotation of instance %d: %w", instanceID, err)
+	}
+	committee, err := CommitteeFromMarshalUtil(marshalUtil)
+	if err != nil {
+		return fmt.Errorf("failed to restore committee rotation of instance %d: %w", instanceID, err)
+	}
+	state.ScheduleCommitteeRotation(round, committee)
+
+	return nil
+}
+
+// get returns the value stored under the given key, or nil if there is none.
+func (r *RotationStore) get(key kvstore.Key) ([]byte, error) {
+	data, err := r.store.Get(key)
+	if errors.Is(err, kvstore.ErrKeyNotFound) {
+		return nil, nil
+	}
+	return data, err
+}
+
+func rotationKey(instanceID uint32, suffix byte) []byte {
+	return marshalutil.New(marshalutil.Uint32Size + 1).WriteUint32(instanceID).WriteByte(suffix).Bytes()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
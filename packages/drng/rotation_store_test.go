@@ -0,0 +1,52 @@
+package drng
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+func TestRotationStore(t *testing.T) {
+	currentMember := ed25519.GenerateKeyPair()
+	newMember := ed25519.GenerateKeyPair()
+	timestamp := clock.SyncedTime()
+	store := mapdb.NewMapDB()
+
+	currentCommittee := &Committee{InstanceID: 2, Threshold: 1, Identities: []ed25519.PublicKey{currentMember.PublicKey}, DistributedPK: []byte{}}
+	newCommittee := &Committee{InstanceID: 2, Threshold: 1, Identities: []ed25519.PublicKey{newMember.PublicKey}, DistributedPK: []byte{}}
+
+	// restart creates a new DRNG with the configured committee and restores the persisted rotations
+	restart := func() *DRNG {
+		config := make(map[uint32][]Option)
+		config[2] = []Option{SetCommittee(currentCommittee), SetBeaconType(TypeED25519Beacon)}
+		drng := New(config)
+		drng.SetRotationStore(NewRotationStore(store))
+		require.NoError(t, drng.RestoreCommitteeRotations())
+		return drng
+	}
+
+	drng := restart()
+	require.NoError(t, drng.Dispatch(currentMember.PublicKey, timestamp, ed25519BeaconPayload(t, &currentMember, 2, 1)))
+	require.NoError(t, drng.Dispatch(currentMember.PublicKey, timestamp, committeeRotationPayload(t, 3, newCommittee, currentMember)))
+
+	// the scheduled rotation survives a restart
+	drng = restart()
+	round, scheduledCommittee, scheduled := drng.State[2].ScheduledCommitteeRotation()
+	assert.True(t, scheduled)
+	assert.Equal(t, uint64(3), round)
+	assert.Equal(t, newCommittee, scheduledCommittee)
+	assert.Equal(t, *currentCommittee, drng.State[2].Committee())
+
+	// the committee that took over survives a restart
+	require.NoError(t, drng.Dispatch(newMember.PublicKey, timestamp, ed25519BeaconPayload(t, &newMember, 2, 3)))
+	drng = restart()
+	_, _, scheduled = drng.State[2].ScheduledCommitteeRotation()
+	assert.False(t, scheduled)
+	assert.Equal(t, *newCommittee, drng.State[2].Committee())
+	require.NoError(t, drng.Dispatch(newMember.PublicKey, timestamp, ed25519BeaconPayload(t, &newMember, 2, 4)))
+}
@@ -0,0 +1,307 @@
+package epochs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/stringify"
+	"golang.org/x/crypto/blake2b"
+)
+
+// region ID ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// IDLength contains the amount of bytes that a marshaled version of the ID contains.
+const IDLength = marshalutil.Uint64Size
+
+// ID is the index of an epoch. Epoch 0 starts at the genesis time and every epoch lasts for the same interval.
+type ID uint64
+
+// IDFromBytes unmarshals an ID from a sequence of bytes.
+func IDFromBytes(bytes []byte) (id ID, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if id, err = IDFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse ID from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// IDFromString parses the decimal representation of an ID.
+func IDFromString(idString string) (id ID, err error) {
+	parsedID, err := strconv.ParseUint(idString, 10, 64)
+	if err != nil {
+		err = errors.Errorf("failed to parse ID from string '%s' (%v): %w", idString, err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return ID(parsedID), nil
+}
+
+// IDFromMarshalUtil unmarshals an ID using a MarshalUtil (for easier unmarshaling).
+func IDFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (id ID, err error) {
+	untypedID, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = errors.Errorf("failed to parse ID (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return ID(untypedID), nil
+}
+
+// Bytes returns a marshaled version of the ID.
+func (i ID) Bytes() []byte {
+	return marshalutil.New(IDLength).WriteUint64(uint64(i)).Bytes()
+}
+
+// String returns a human readable version of the ID.
+func (i ID) String() string {
+	return fmt.Sprintf("EpochID(%d)", uint64(i))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Commitment ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Commitment represents the agreed upon state change of an epoch. It contains the MerkleRoots of the confirmed
+// Messages, the created and spent Outputs and the consensus mana diff of the epoch and chains to the Commitment of the
+// previous epoch, so the Root of a Commitment commits to the whole history of the ledger since the first Commitment.
+type Commitment struct {
+	epochID            ID
+	previousRoot       MerkleRoot
+	messagesRoot       MerkleRoot
+	createdOutputsRoot MerkleRoot
+	spentOutputsRoot   MerkleRoot
+	manaRoot           MerkleRoot
+	messageCount       uint32
+	createdOutputCount uint32
+	spentOutputCount   uint32
+	manaDiffCount      uint32
+
+	objectstorage.StorableObjectFlags
+}
+
+// newCommitment creates a new Commitment of the given epoch from the previous Commitment and the diff of the epoch.
+func newCommitment(epochID ID, previousRoot MerkleRoot, diff *epochDiff) *Commitment {
+	messageLeaves, createdOutputLeaves, spentOutputLeaves, manaLeaves := diff.leaves()
+
+	return &Commitment{
+		epochID:            epochID,
+		previousRoot:       previousRoot,
+		messagesRoot:       NewMerkleRoot(messageLeaves),
+		createdOutputsRoot: NewMerkleRoot(createdOutputLeaves),
+		spentOutputsRoot:   NewMerkleRoot(spentOutputLeaves),
+		manaRoot:           NewMerkleRoot(manaLeaves),
+		messageCount:       uint32(len(messageLeaves)),
+		createdOutputCount: uint32(len(createdOutputLeaves)),
+		spentOutputCount:   uint32(len(spentOutputLeaves)),
+		manaDiffCount:      uint32(len(manaLeaves)),
+	}
+}
+
+// CommitmentFromBytes unmarshals a Commitment from a sequence of bytes.
+func CommitmentFromBytes(bytes []byte) (commitment *Commitment, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if commitment, err = CommitmentFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Commitment from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// CommitmentFromMarshalUtil unmarshals a Commitment using a MarshalUtil (for easier unmarshaling).
+func CommitmentFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (commitment *Commitment, err error) {
+	commitment = &Commitment{}
+	if commitment.epochID, err = IDFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse epoch ID: %w", err)
+		return
+	}
+	for _, merkleRoot := range []*MerkleRoot{&commitment.previousRoot, &commitment.messagesRoot, &commitment.createdOutputsRoot, &commitment.spentOutputsRoot, &commitment.manaRoot} {
+		if *merkleRoot, err = MerkleRootFromMarshalUtil(marshalUtil); err != nil {
+			err = errors.Errorf("failed to parse MerkleRoot: %w", err)
+			return
+		}
+	}
+	for _, count := range []*uint32{&commitment.messageCount, &commitment.createdOutputCount, &commitment.spentOutputCount, &commitment.manaDiffCount} {
+		if *count, err = marshalUtil.ReadUint32(); err != nil {
+			err = errors.Errorf("failed to parse count (%v): %w", err, cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
+
+	return
+}
+
+// CommitmentFromObjectStorage restores a Commitment that was stored in the object storage.
+func CommitmentFromObjectStorage(key []byte, data []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = CommitmentFromBytes(byteutils.ConcatBytes(key, data)); err != nil {
+		err = errors.Errorf("failed to parse Commitment from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// EpochID returns the ID of the epoch that the Commitment belongs to.
+func (c *Commitment) EpochID() ID {
+	return c.epochID
+}
+
+// Root returns the hash of the Commitment that identifies the state of the ledger at the end of its epoch.
+func (c *Commitment) Root() MerkleRoot {
+	return blake2b.Sum256(c.Bytes())
+}
+
+// PreviousRoot returns the Root of the Commitment of the previous epoch.
+func (c *Commitment) PreviousRoot() MerkleRoot {
+	return c.previousRoot
+}
+
+// MessagesRoot returns the MerkleRoot of the IDs of the Messages that were confirmed in the epoch.
+func (c *Commitment) MessagesRoot() MerkleRoot {
+	return c.messagesRoot
+}
+
+// CreatedOutputsRoot returns the MerkleRoot of the IDs of the Outputs that were created by the Transactions that were
+// confirmed in the epoch.
+func (c *Commitment) CreatedOutputsRoot() MerkleRoot {
+	return c.createdOutputsRoot
+}
+
+// SpentOutputsRoot returns the MerkleRoot of the IDs of the Outputs that were spent by the Transactions that were
+// confirmed in the epoch.
+func (c *Commitment) SpentOutputsRoot() MerkleRoot {
+	return c.spentOutputsRoot
+}
+
+// ManaRoot returns the MerkleRoot of the changes of the consensus base mana of the nodes in the epoch.
+func (c *Commitment) ManaRoot() MerkleRoot {
+	return c.manaRoot
+}
+
+// MessageCount returns the amount of Messages that were confirmed in the epoch.
+func (c *Commitment) MessageCount() uint32 {
+	return c.messageCount
+}
+
+// CreatedOutputCount returns the amount of Outputs that were created in the epoch.
+func (c *Commitment) CreatedOutputCount() uint32 {
+	return c.createdOutputCount
+}
+
+// SpentOutputCount returns the amount of Outputs that were spent in the epoch.
+func (c *Commitment) SpentOutputCount() uint32 {
+	return c.spentOutputCount
+}
+
+// ManaDiffCount returns the amount of nodes whose consensus base mana changed in the epoch.
+func (c *Commitment) ManaDiffCount() uint32 {
+	return c.manaDiffCount
+}
+
+// Bytes returns a marshaled version of the Commitment.
+func (c *Commitment) Bytes() []byte {
+	return byteutils.ConcatBytes(c.ObjectStorageKey(), c.ObjectStorageValue())
+}
+
+// String returns a human readable version of the Commitment.
+func (c *Commitment) String() string {
+	return stringify.Struct("Commitment",
+		stringify.StructField("epochID", c.epochID),
+		stringify.StructField("root", c.Root()),
+		stringify.StructField("previousRoot", c.previousRoot),
+		stringify.StructField("messagesRoot", c.messagesRoot),
+		stringify.StructField("createdOutputsRoot", c.createdOutputsRoot),
+		stringify.StructField("spentOutputsRoot", c.spentOutputsRoot),
+		stringify.StructField("manaRoot", c.manaRoot),
+		stringify.StructField("messageCount", c.messageCount),
+		stringify.StructField("createdOutputCount", c.createdOutputCount),
+		stringify.StructField("spentOutputCount", c.spentOutputCount),
+		stringify.StructField("manaDiffCount", c.manaDiffCount),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (c *Commitment) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (c *Commitment) ObjectStorageKey() []byte {
+	return c.epochID.Bytes()
+}
+
+// ObjectStorageValue marshals the Commitment into a sequence of bytes that are used as the value part in the object
+// storage.
+func (c *Commitment) ObjectStorageValue() []byte {
+	return marshalutil.New(5*MerkleRootLength + 4*marshalutil.Uint32Size).
+		Write(c.previousRoot).
+		Write(c.messagesRoot).
+		Write(c.createdOutputsRoot).
+		Write(c.spentOutputsRoot).
+		Write(c.manaRoot).
+		WriteUint32(c.messageCount).
+		WriteUint32(c.createdOutputCount).
+		WriteUint32(c.spentOutputCount).
+		WriteUint32(c.manaDiffCount).
+		Bytes()
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &Commitment{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedCommitment /////////////////////////////////////////////////////////////////////////////////////////////
+
+// CachedCommitment is a wrapper for the generic CachedObject returned by the object storage that overrides the accessor
+// methods with a type-casted one.
+type CachedCommitment struct {
+	objectstorage.CachedObject
+}
+
+// Retain marks the CachedObject to still be in use by the program.
+func (c *CachedCommitment) Retain() *CachedCommitment {
+	return &CachedCommitment{c.CachedObject.Retain()}
+}
+
+// Unwrap is the type-casted equivalent of Get. It returns nil if the object does not exist.
+func (c *CachedCommitment) Unwrap() *Commitment {
+	untypedObject := c.Get()
+	if untypedObject == nil {
+		return nil
+	}
+
+	typedObject := untypedObject.(*Commitment)
+	if typedObject == nil || typedObject.IsDeleted() {
+		return nil
+	}
+
+	return typedObject
+}
+
+// Consume unwraps the CachedObject and passes a type-casted version to the consumer (if the object is not empty - it
+// exists). It automatically releases the object when the consumer finishes.
+func (c *CachedCommitment) Consume(consumer func(commitment *Commitment), forceRelease ...bool) (consumed bool) {
+	return c.CachedObject.Consume(func(object objectstorage.StorableObject) {
+		consumer(object.(*Commitment))
+	}, forceRelease...)
+}
+
+// String returns a human readable version of the CachedCommitment.
+func (c *CachedCommitment) String() string {
+	return stringify.Struct("CachedCommitment",
+		stringify.StructField("CachedObject", c.Unwrap()),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,212 @@
+package epochs
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/types"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	// messageDiffKey is the key type of the Messages of a pending epoch.
+	messageDiffKey byte = iota
+	// createdOutputDiffKey is the key type of the created Outputs of a pending epoch.
+	createdOutputDiffKey
+	// spentOutputDiffKey is the key type of the spent Outputs of a pending epoch.
+	spentOutputDiffKey
+	// manaDiffKey is the key type of the consensus mana changes of a pending epoch.
+	manaDiffKey
+)
+
+// region diffStore ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// diffStore persists the diffs of the epochs that are not committed yet together with the latest Commitment. The
+// Commitment of an epoch replaces its diff in a single batch, so a restarted Manager always continues from a consistent
+// state.
+type diffStore struct {
+	store kvstore.KVStore
+}
+
+// newDiffStore creates a new diffStore that persists its data in the given store.
+func newDiffStore(store kvstore.KVStore) *diffStore {
+	return &diffStore{
+		store: store.WithRealm([]byte{database.PrefixEpochs}),
+	}
+}
+
+// storeMessage persists that the given Message was confirmed in the given epoch.
+func (d *diffStore) storeMessage(epochID ID, messageID tangle.MessageID) error {
+	if err := d.store.Set(diffKey(epochID, messageDiffKey, messageID.Bytes()), []byte{}); err != nil {
+		return errors.Errorf("failed to store message %s of %s: %w", messageID, epochID, err)
+	}
+
+	return nil
+}
+
+// storeTransaction persists the Outputs of a confirmed Transaction and the resulting consensus mana changes of the given
+// epoch.
+func (d *diffStore) storeTransaction(epochID ID, diff *epochDiff, createdOutputs, spentOutputs []ledgerstate.OutputID, nodeIDs []identity.ID) error {
+	batch := d.store.Batched()
+	for _, outputID := range createdOutputs {
+		if err := batch.Set(diffKey(epochID, createdOutputDiffKey, outputID.Bytes()), []byte{}); err != nil {
+			batch.Cancel()
+			return errors.Errorf("failed to store created output %s of %s: %w", outputID, epochID, err)
+		}
+	}
+	for _, outputID := range spentOutputs {
+		if err := batch.Set(diffKey(epochID, spentOutputDiffKey, outputID.Bytes()), []byte{}); err != nil {
+			batch.Cancel()
+			return errors.Errorf("failed to store spent output %s of %s: %w", outputID, epochID, err)
+		}
+	}
+	for _, nodeID := range nodeIDs {
+		if err := batch.Set(diffKey(epochID, manaDiffKey, nodeID.Bytes()), marshalutil.New(marshalutil.Int64Size).WriteInt64(diff.manaDiff[nodeID]).Bytes()); err != nil {
+			batch.Cancel()
+			return errors.Errorf("failed to store mana diff of %s in %s: %w", nodeID, epochID, err)
+		}
+	}
+
+	return batch.Commit()
+}
+
+// storeCommitment persists the given Commitment as the latest one and removes the diff that it commits to.
+func (d *diffStore) storeCommitment(commitment *Commitment, diff *epochDiff) error {
+	batch := d.store.Batched()
+	if err := batch.Set([]byte{PrefixLatestCommitment}, commitment.Bytes()); err != nil {
+		batch.Cancel()
+		return errors.Errorf("failed to store latest commitment: %w", err)
+	}
+	var keys [][]byte
+	for messageID := range diff.messages {
+		keys = append(keys, diffKey(commitment.EpochID(), messageDiffKey, messageID.Bytes()))
+	}
+	for outputID := range diff.createdOutputs {
+		keys = append(keys, diffKey(commitment.EpochID(), createdOutputDiffKey, outputID.Bytes()))
+	}
+	for outputID := range diff.spentOutputs {
+		keys = append(keys, diffKey(commitment.EpochID(), spentOutputDiffKey, outputID.Bytes()))
+	}
+	for nodeID := range diff.manaDiff {
+		keys = append(keys, diffKey(commitment.EpochID(), manaDiffKey, nodeID.Bytes()))
+	}
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			batch.Cancel()
+			return errors.Errorf("failed to delete diff of %s: %w", commitment.EpochID(), err)
+		}
+	}
+
+	return batch.Commit()
+}
+
+// latestCommitment returns the persisted latest Commitment or nil if no epoch has been committed yet.
+func (d *diffStore) latestCommitment() (commitment *Commitment, err error) {
+	data, err := d.store.Get([]byte{PrefixLatestCommitment})
+	if errors.Is(err, kvstore.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Errorf("failed to load latest commitment: %w", err)
+	}
+	if commitment, _, err = CommitmentFromBytes(data); err != nil {
+		return nil, errors.Errorf("failed to load latest commitment: %w", err)
+	}
+
+	return commitment, nil
+}
+
+// pendingEpochs returns the persisted diffs of all epochs starting at the given one and deletes the diffs of the
+// epochs before it.
+func (d *diffStore) pendingEpochs(nextEpoch ID) (pendingEpochs map[ID]*epochDiff, err error) {
+	pendingEpochs = make(map[ID]*epochDiff)
+	var staleKeys [][]byte
+	if iterateErr := d.store.Iterate([]byte{PrefixPendingDiff}, func(key kvstore.Key, value kvstore.Value) bool {
+		marshalUtil := marshalutil.New(key[1:])
+		var epochID ID
+		if epochID, err = IDFromMarshalUtil(marshalUtil); err != nil {
+			return false
+		}
+		if epochID < nextEpoch {
+			staleKeys = append(staleKeys, append([]byte{}, key...))
+			return true
+		}
+
+		diff, exists := pendingEpochs[epochID]
+		if !exists {
+			diff = newEpochDiff()
+			pendingEpochs[epochID] = diff
+		}
+		err = diff.restore(marshalUtil, value)
+
+		return err == nil
+	}); iterateErr != nil {
+		err = iterateErr
+	}
+	if err != nil {
+		return nil, errors.Errorf("failed to load pending epochs: %w", err)
+	}
+
+	for _, key := range staleKeys {
+		if err = d.store.Delete(key); err != nil {
+			return nil, errors.Errorf("failed to delete diff of committed epoch: %w", err)
+		}
+	}
+
+	return pendingEpochs, nil
+}
+
+// diffKey returns the key of an element of the diff of the given epoch.
+func diffKey(epochID ID, keyType byte, element []byte) []byte {
+	return marshalutil.New(2 + IDLength + len(element)).
+		WriteByte(PrefixPendingDiff).
+		Write(epochID).
+		WriteByte(keyType).
+		WriteBytes(element).
+		Bytes()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// restore adds the persisted element (the remainder of its key and its value) to the epochDiff.
+func (e *epochDiff) restore(marshalUtil *marshalutil.MarshalUtil, value []byte) (err error) {
+	keyType, err := marshalUtil.ReadByte()
+	if err != nil {
+		return errors.Errorf("failed to parse key type: %w", err)
+	}
+
+	switch keyType {
+	case messageDiffKey:
+		messageID, err := tangle.ReferenceFromMarshalUtil(marshalUtil)
+		if err != nil {
+			return err
+		}
+		e.messages[messageID] = types.Void
+	case createdOutputDiffKey, spentOutputDiffKey:
+		outputID, err := ledgerstate.OutputIDFromMarshalUtil(marshalUtil)
+		if err != nil {
+			return err
+		}
+		if keyType == createdOutputDiffKey {
+			e.createdOutputs[outputID] = types.Void
+		} else {
+			e.spentOutputs[outputID] = types.Void
+		}
+	case manaDiffKey:
+		nodeID, err := identity.IDFromMarshalUtil(marshalUtil)
+		if err != nil {
+			return err
+		}
+		if e.manaDiff[nodeID], err = marshalutil.New(value).ReadInt64(); err != nil {
+			return errors.Errorf("failed to parse mana diff of %s: %w", nodeID, err)
+		}
+	default:
+		return errors.Errorf("unknown key type %d", keyType)
+	}
+
+	return nil
+}
@@ -0,0 +1,492 @@
+package epochs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/objectstorage"
+	"github.com/iotaledger/hive.go/types"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	// PrefixCommitment defines the storage prefix for the Commitments of the epochs.
+	PrefixCommitment byte = iota
+
+	// PrefixPendingDiff defines the storage prefix for the diffs of the epochs that are not committed yet.
+	PrefixPendingDiff
+
+	// PrefixLatestCommitment defines the storage prefix for the latest Commitment that the chain continues from.
+	PrefixLatestCommitment
+
+	// DefaultInterval defines the default duration of an epoch.
+	DefaultInterval = 1 * time.Minute
+
+	// DefaultCommitmentDelay defines how long the Manager waits (in tangle time) after the end of an epoch for late
+	// confirmations before it commits the epoch. Transactions are assigned by the timestamp of their essence, which can
+	// be up to tangle.MaxReattachmentTimeMin older than the Message that carries them, so the delay has to be at least
+	// that long.
+	DefaultCommitmentDelay = tangle.MaxReattachmentTimeMin + 30*time.Second
+
+	// cacheTime defines the time that a Commitment stays in the cache of the object storage.
+	cacheTime = 10 * time.Second
+)
+
+// region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Manager assigns the confirmed Messages and Transactions of the Tangle to time based epochs and commits to the diff of
+// every epoch once the tangle time passed its end (plus a delay for late confirmations). Messages are assigned by their
+// issuing time and Transactions by the timestamp of their essence, so every node assigns them to the same epoch.
+//
+// The chain of Commitments starts at a fixed epoch that has to be the same on all nodes of the network, and the diffs of
+// the epochs that are not committed yet are persisted, so nodes that start at different times or restart still commit
+// to the same diffs. Confirmations that belong to an epoch that is committed already are ignored.
+//
+// The epochs are committed by a background worker, so a long gap of empty epochs (e.g. after a restart) does not block
+// the confirmation of Messages.
+type Manager struct {
+	Events *Events
+
+	tangle            *tangle.Tangle
+	options           *Options
+	commitmentStorage *objectstorage.ObjectStorage
+	diffStore         *diffStore
+
+	pendingEpochs    map[ID]*epochDiff
+	nextEpoch        ID
+	latestCommitment *Commitment
+	tangleTime       time.Time
+	mutex            sync.Mutex
+
+	commitSignal   chan types.Empty
+	shutdownSignal chan types.Empty
+	shutdownOnce   sync.Once
+	workerWG       sync.WaitGroup
+
+	messageConfirmedClosure     *events.Closure
+	transactionConfirmedClosure *events.Closure
+}
+
+// NewManager creates a new Manager that stores its Commitments in the store of the given Tangle.
+func NewManager(tangleInstance *tangle.Tangle, options ...Option) (manager *Manager) {
+	osFactory := objectstorage.NewFactory(tangleInstance.Options.Store, database.PrefixEpochs)
+
+	manager = &Manager{
+		Events: &Events{
+			EpochCommitted: events.NewEvent(CommitmentCaller),
+			Error:          events.NewEvent(events.ErrorCaller),
+		},
+		tangle:            tangleInstance,
+		options:           newOptions(options...),
+		commitmentStorage: osFactory.New(PrefixCommitment, CommitmentFromObjectStorage, tangleInstance.Options.CacheTimeProvider.CacheTime(cacheTime), objectstorage.LeakDetectionEnabled(false)),
+		diffStore:         newDiffStore(tangleInstance.Options.Store),
+		pendingEpochs:     make(map[ID]*epochDiff),
+		commitSignal:      make(chan types.Empty, 1),
+		shutdownSignal:    make(chan types.Empty),
+	}
+	manager.nextEpoch = manager.options.StartEpoch
+	manager.messageConfirmedClosure = events.NewClosure(manager.onMessageConfirmed)
+	manager.transactionConfirmedClosure = events.NewClosure(manager.onTransactionConfirmed)
+	if err := manager.loadState(); err != nil {
+		panic(err)
+	}
+
+	manager.workerWG.Add(1)
+	go manager.commitLoop()
+
+	return manager
+}
+
+// Setup sets up the behavior of the component by making it attach to the relevant events of other components.
+func (m *Manager) Setup() {
+	m.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(m.messageConfirmedClosure)
+	m.tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(m.transactionConfirmedClosure)
+}
+
+// IDFromTime returns the ID of the epoch that contains the given time. Times before the genesis belong to epoch 0.
+func (m *Manager) IDFromTime(t time.Time) ID {
+	if !t.After(m.options.GenesisTime) {
+		return 0
+	}
+
+	return ID(t.Sub(m.options.GenesisTime) / m.options.Interval)
+}
+
+// StartTime returns the time at which the given epoch starts.
+func (m *Manager) StartTime(epochID ID) time.Time {
+	return m.options.GenesisTime.Add(time.Duration(epochID) * m.options.Interval)
+}
+
+// EndTime returns the time at which the given epoch ends (and the next one starts).
+func (m *Manager) EndTime(epochID ID) time.Time {
+	return m.StartTime(epochID + 1)
+}
+
+// Interval returns the duration of an epoch.
+func (m *Manager) Interval() time.Duration {
+	return m.options.Interval
+}
+
+// Commitment retrieves the Commitment of the given epoch from the object storage.
+func (m *Manager) Commitment(epochID ID) *CachedCommitment {
+	return &CachedCommitment{CachedObject: m.commitmentStorage.Load(epochID.Bytes())}
+}
+
+// NextEpoch returns the ID of the next epoch that the Manager commits to.
+func (m *Manager) NextEpoch() ID {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.nextEpoch
+}
+
+// LatestCommitment returns the Commitment of the latest committed epoch. The second return value indicates if any
+// epoch has been committed yet.
+func (m *Manager) LatestCommitment() (commitment *Commitment, exists bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.latestCommitment, m.latestCommitment != nil
+}
+
+// ExportCachedObjects adds the Commitments that are kept in the cache of the Manager to the given CachedObjects.
+func (m *Manager) ExportCachedObjects(cachedObjects *database.CachedObjects) (err error) {
+	return database.ExportCachedObjects(cachedObjects, []byte{database.PrefixEpochs, PrefixCommitment}, m.commitmentStorage)
+}
+
+// Shutdown shuts down the Manager and persists its state. Epochs that are due but not committed yet are committed after
+// the next start.
+func (m *Manager) Shutdown() {
+	m.shutdownOnce.Do(func() {
+		m.tangle.ConfirmationOracle.Events().MessageConfirmed.Detach(m.messageConfirmedClosure)
+		m.tangle.ConfirmationOracle.Events().TransactionConfirmed.Detach(m.transactionConfirmedClosure)
+
+		close(m.shutdownSignal)
+		m.workerWG.Wait()
+
+		m.commitmentStorage.Shutdown()
+	})
+}
+
+// onMessageConfirmed adds the confirmed Message to its epoch and signals the worker to commit all epochs that ended
+// before the tangle time.
+func (m *Manager) onMessageConfirmed(messageID tangle.MessageID) {
+	var issuingTime time.Time
+	if !m.tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		issuingTime = message.IssuingTime()
+	}) {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	epochID := m.IDFromTime(issuingTime)
+	if diff := m.pendingEpoch(epochID); diff != nil {
+		diff.messages[messageID] = types.Void
+		if err := m.diffStore.storeMessage(epochID, messageID); err != nil {
+			m.Events.Error.Trigger(err)
+		}
+	}
+
+	if issuingTime.After(m.tangleTime) {
+		m.tangleTime = issuingTime
+		select {
+		case m.commitSignal <- types.Void:
+		default:
+		}
+	}
+}
+
+// onTransactionConfirmed adds the Outputs and the consensus mana changes of the confirmed Transaction to its epoch.
+func (m *Manager) onTransactionConfirmed(transactionID ledgerstate.TransactionID) {
+	var timestamp time.Time
+	var createdOutputs, spentOutputs []ledgerstate.OutputID
+	manaDiff := make(map[identity.ID]int64)
+	if !m.tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		timestamp = transaction.Essence().Timestamp()
+
+		for i, output := range transaction.Essence().Outputs() {
+			createdOutputs = append(createdOutputs, ledgerstate.NewOutputID(transactionID, uint16(i)))
+			manaDiff[transaction.Essence().ConsensusPledgeID()] += int64(totalBalance(output))
+		}
+
+		for _, input := range transaction.Essence().Inputs() {
+			referencedOutputID := input.(*ledgerstate.UTXOInput).ReferencedOutputID()
+			spentOutputs = append(spentOutputs, referencedOutputID)
+
+			m.tangle.LedgerState.CachedOutput(referencedOutputID).Consume(func(output ledgerstate.Output) {
+				m.tangle.LedgerState.Transaction(referencedOutputID.TransactionID()).Consume(func(inputTransaction *ledgerstate.Transaction) {
+					manaDiff[inputTransaction.Essence().ConsensusPledgeID()] -= int64(totalBalance(output))
+				})
+			})
+		}
+	}) {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	epochID := m.IDFromTime(timestamp)
+	diff := m.pendingEpoch(epochID)
+	if diff == nil {
+		return
+	}
+	for _, outputID := range createdOutputs {
+		diff.createdOutputs[outputID] = types.Void
+	}
+	for _, outputID := range spentOutputs {
+		diff.spentOutputs[outputID] = types.Void
+	}
+	nodeIDs := make([]identity.ID, 0, len(manaDiff))
+	for nodeID, delta := range manaDiff {
+		diff.manaDiff[nodeID] += delta
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	if err := m.diffStore.storeTransaction(epochID, diff, createdOutputs, spentOutputs, nodeIDs); err != nil {
+		m.Events.Error.Trigger(err)
+	}
+}
+
+// pendingEpoch returns the diff of the given epoch or nil if the epoch is committed already (or before the start of the
+// chain).
+func (m *Manager) pendingEpoch(epochID ID) (diff *epochDiff) {
+	if epochID < m.nextEpoch {
+		return nil
+	}
+
+	if diff = m.pendingEpochs[epochID]; diff == nil {
+		diff = newEpochDiff()
+		m.pendingEpochs[epochID] = diff
+	}
+
+	return diff
+}
+
+// commitLoop commits the due epochs whenever the tangle time advanced until the Manager is shut down.
+func (m *Manager) commitLoop() {
+	defer m.workerWG.Done()
+
+	for {
+		select {
+		case <-m.commitSignal:
+			m.commitEpochs()
+		case <-m.shutdownSignal:
+			return
+		}
+	}
+}
+
+// commitEpochs commits all epochs whose end (plus the commitment delay) is before the tangle time. The mutex is only held
+// for the commitment of a single epoch, so confirmations are not blocked while a long gap of epochs is committed.
+func (m *Manager) commitEpochs() {
+	for {
+		select {
+		case <-m.shutdownSignal:
+			return
+		default:
+		}
+
+		if !m.commitNextEpoch() {
+			return
+		}
+	}
+}
+
+// commitNextEpoch commits the next epoch if its end (plus the commitment delay) is before the tangle time. It returns
+// true if the epoch was committed.
+func (m *Manager) commitNextEpoch() (committed bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.EndTime(m.nextEpoch).Add(m.options.CommitmentDelay).After(m.tangleTime) {
+		return false
+	}
+
+	diff, exists := m.pendingEpochs[m.nextEpoch]
+	if !exists {
+		diff = newEpochDiff()
+	}
+	delete(m.pendingEpochs, m.nextEpoch)
+
+	previousRoot := EmptyMerkleRoot
+	if m.latestCommitment != nil {
+		previousRoot = m.latestCommitment.Root()
+	}
+	commitment := newCommitment(m.nextEpoch, previousRoot, diff)
+	if err := m.diffStore.storeCommitment(commitment, diff); err != nil {
+		m.Events.Error.Trigger(err)
+	}
+	if cachedCommitment, stored := m.commitmentStorage.StoreIfAbsent(commitment); stored {
+		cachedCommitment.Release()
+	}
+
+	m.latestCommitment = commitment
+	m.nextEpoch++
+	m.Events.EpochCommitted.Trigger(commitment)
+
+	return true
+}
+
+// loadState restores the latest Commitment and the diffs of the pending epochs, so the Manager continues the chain of
+// Commitments after a restart.
+func (m *Manager) loadState() (err error) {
+	if m.latestCommitment, err = m.diffStore.latestCommitment(); err != nil {
+		return err
+	}
+	if m.latestCommitment != nil {
+		m.nextEpoch = m.latestCommitment.EpochID() + 1
+		if cachedCommitment, stored := m.commitmentStorage.StoreIfAbsent(m.latestCommitment); stored {
+			cachedCommitment.Release()
+		}
+	}
+
+	m.pendingEpochs, err = m.diffStore.pendingEpochs(m.nextEpoch)
+
+	return err
+}
+
+// totalBalance returns the sum of the balances of all colors of the Output.
+func totalBalance(output ledgerstate.Output) (balance uint64) {
+	output.Balances().ForEach(func(_ ledgerstate.Color, colorBalance uint64) bool {
+		balance += colorBalance
+		return true
+	})
+
+	return balance
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region epochDiff ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// epochDiff collects the changes of an epoch that is not committed yet.
+type epochDiff struct {
+	messages       map[tangle.MessageID]types.Empty
+	createdOutputs map[ledgerstate.OutputID]types.Empty
+	spentOutputs   map[ledgerstate.OutputID]types.Empty
+	manaDiff       map[identity.ID]int64
+}
+
+// newEpochDiff returns an empty epochDiff.
+func newEpochDiff() *epochDiff {
+	return &epochDiff{
+		messages:       make(map[tangle.MessageID]types.Empty),
+		createdOutputs: make(map[ledgerstate.OutputID]types.Empty),
+		spentOutputs:   make(map[ledgerstate.OutputID]types.Empty),
+		manaDiff:       make(map[identity.ID]int64),
+	}
+}
+
+// leaves returns the leaves of the Merkle trees that the Commitment of the epoch consists of. Nodes whose consensus
+// mana did not change in total are omitted.
+func (e *epochDiff) leaves() (messageLeaves, createdOutputLeaves, spentOutputLeaves, manaLeaves [][]byte) {
+	for messageID := range e.messages {
+		messageLeaves = append(messageLeaves, messageID.Bytes())
+	}
+	for outputID := range e.createdOutputs {
+		createdOutputLeaves = append(createdOutputLeaves, outputID.Bytes())
+	}
+	for outputID := range e.spentOutputs {
+		spentOutputLeaves = append(spentOutputLeaves, outputID.Bytes())
+	}
+	for nodeID, delta := range e.manaDiff {
+		if delta == 0 {
+			continue
+		}
+		manaLeaves = append(manaLeaves, marshalutil.New(identity.IDLength+marshalutil.Int64Size).
+			WriteBytes(nodeID.Bytes()).
+			WriteInt64(delta).
+			Bytes())
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option represents the return type of optional parameters that can be handed into the constructor of the Manager
+// to configure its behavior.
+type Option func(*Options)
+
+// Options is a container for all configurable parameters of the Manager.
+type Options struct {
+	GenesisTime     time.Time
+	Interval        time.Duration
+	CommitmentDelay time.Duration
+	StartEpoch      ID
+}
+
+// newOptions returns the Options with the default values, overridden by the given Option.
+func newOptions(optionalOptions ...Option) (options *Options) {
+	options = &Options{
+		GenesisTime:     time.Unix(tangle.DefaultGenesisTime, 0),
+		Interval:        DefaultInterval,
+		CommitmentDelay: DefaultCommitmentDelay,
+	}
+	for _, option := range optionalOptions {
+		option(options)
+	}
+
+	return options
+}
+
+// GenesisTime is an Option for the Manager that defines the time (Unix in seconds) at which epoch 0 starts.
+func GenesisTime(genesisTime int64) Option {
+	return func(options *Options) {
+		options.GenesisTime = time.Unix(genesisTime, 0)
+	}
+}
+
+// Interval is an Option for the Manager that defines the duration of an epoch.
+func Interval(interval time.Duration) Option {
+	return func(options *Options) {
+		options.Interval = interval
+	}
+}
+
+// CommitmentDelay is an Option for the Manager that defines how long it waits (in tangle time) after the end of an
+// epoch for late confirmations before it commits the epoch. It has to be at least tangle.MaxReattachmentTimeMin, so
+// that all Transactions of an epoch are confirmed before it is committed.
+func CommitmentDelay(commitmentDelay time.Duration) Option {
+	return func(options *Options) {
+		options.CommitmentDelay = commitmentDelay
+	}
+}
+
+// StartEpoch is an Option for the Manager that defines the epoch at which the chain of Commitments starts (i.e. the
+// epoch of the snapshot that the network started from). It has to be the same on all nodes of the network.
+func StartEpoch(epochID ID) Option {
+	return func(options *Options) {
+		options.StartEpoch = epochID
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events represents events happening in the Manager.
+type Events struct {
+	// EpochCommitted is triggered when the Commitment of an epoch is created.
+	EpochCommitted *events.Event
+
+	// Error is triggered when the Manager fails to persist its state.
+	Error *events.Event
+}
+
+// CommitmentCaller is the caller function for events that hand over a Commitment.
+func CommitmentCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*Commitment))(params[0].(*Commitment))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
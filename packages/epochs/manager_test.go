@@ -0,0 +1,203 @@
+package epochs
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestManager(t *testing.T) {
+	tangleInstance := tangle.NewTestTangle()
+	defer tangleInstance.Shutdown()
+
+	genesisTime := time.Now().Add(-time.Hour).Unix()
+	epochTime := func(offset time.Duration) time.Time {
+		return time.Unix(genesisTime, 0).Add(offset)
+	}
+
+	nodeA, nodeB := identity.GenerateIdentity().ID(), identity.GenerateIdentity().ID()
+	genesisTransaction := testTransaction(epochTime(0), nodeA, ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))
+	transaction := testTransaction(epochTime(100*time.Second), nodeB, ledgerstate.NewOutputID(genesisTransaction.ID(), 0))
+	require.NoError(t, tangleInstance.LedgerState.LoadSnapshot(&ledgerstate.Snapshot{
+		Transactions: map[ledgerstate.TransactionID]ledgerstate.Record{
+			genesisTransaction.ID(): {Essence: genesisTransaction.Essence(), UnlockBlocks: genesisTransaction.UnlockBlocks(), UnspentOutputs: []bool{true}},
+			transaction.ID():        {Essence: transaction.Essence(), UnlockBlocks: transaction.UnlockBlocks(), UnspentOutputs: []bool{true}},
+		},
+	}))
+
+	manager := NewManager(tangleInstance, GenesisTime(genesisTime), Interval(time.Minute), CommitmentDelay(10*time.Second))
+	collected := collectCommitments(manager)
+	assert.Equal(t, ID(1), manager.IDFromTime(epochTime(90*time.Second)))
+	assert.Equal(t, ID(0), manager.IDFromTime(epochTime(-time.Hour)))
+	assert.Equal(t, epochTime(time.Minute), manager.EndTime(0))
+
+	message0 := testMessage(t, tangleInstance, epochTime(30*time.Second))
+	message1 := testMessage(t, tangleInstance, epochTime(90*time.Second))
+	message3 := testMessage(t, tangleInstance, epochTime(3*time.Minute))
+	lateMessage := testMessage(t, tangleInstance, epochTime(20*time.Second))
+
+	manager.onMessageConfirmed(message0)
+	_, exists := manager.LatestCommitment()
+	assert.False(t, exists)
+
+	// the tangle time passed the end of epoch 0 plus the commitment delay
+	manager.onMessageConfirmed(message1)
+	committed := collected.waitFor(t, 1)
+	assert.Equal(t, ID(0), committed[0].EpochID())
+	assert.Equal(t, EmptyMerkleRoot, committed[0].PreviousRoot())
+	assert.Equal(t, NewMerkleRoot([][]byte{message0.Bytes()}), committed[0].MessagesRoot())
+	assert.Equal(t, uint32(1), committed[0].MessageCount())
+
+	// confirmations of committed epochs are ignored
+	manager.onMessageConfirmed(lateMessage)
+	manager.onTransactionConfirmed(transaction.ID())
+	manager.onMessageConfirmed(message3)
+	committed = collected.waitFor(t, 2)
+	assert.Equal(t, ID(1), committed[1].EpochID())
+	assert.Equal(t, committed[0].Root(), committed[1].PreviousRoot())
+	assert.Equal(t, NewMerkleRoot([][]byte{message1.Bytes()}), committed[1].MessagesRoot())
+	assert.Equal(t, NewMerkleRoot([][]byte{ledgerstate.NewOutputID(transaction.ID(), 0).Bytes()}), committed[1].CreatedOutputsRoot())
+	assert.Equal(t, NewMerkleRoot([][]byte{ledgerstate.NewOutputID(genesisTransaction.ID(), 0).Bytes()}), committed[1].SpentOutputsRoot())
+	assert.Equal(t, uint32(1), committed[1].CreatedOutputCount())
+	assert.Equal(t, uint32(1), committed[1].SpentOutputCount())
+	assert.Equal(t, uint32(2), committed[1].ManaDiffCount())
+
+	latestCommitment, exists := manager.LatestCommitment()
+	require.True(t, exists)
+	assert.Equal(t, committed[1], latestCommitment)
+	assert.True(t, manager.Commitment(0).Consume(func(commitment *Commitment) {
+		assert.Equal(t, committed[0].Root(), commitment.Root())
+	}))
+	assert.False(t, manager.Commitment(2).Consume(func(*Commitment) {}))
+
+	// a restarted Manager continues the chain of Commitments
+	manager.Shutdown()
+	restartedManager := NewManager(tangleInstance, GenesisTime(genesisTime), Interval(time.Minute), CommitmentDelay(10*time.Second))
+	defer restartedManager.Shutdown()
+	latestCommitment, exists = restartedManager.LatestCommitment()
+	require.True(t, exists)
+	assert.Equal(t, committed[1].Root(), latestCommitment.Root())
+	restartedCollected := collectCommitments(restartedManager)
+	restartedManager.onMessageConfirmed(testMessage(t, tangleInstance, epochTime(4*time.Minute)))
+	latestCommitment = restartedCollected.waitFor(t, 1)[0]
+	assert.Equal(t, ID(2), latestCommitment.EpochID())
+	assert.Equal(t, committed[1].Root(), latestCommitment.PreviousRoot())
+
+	// the diffs of the pending epochs survive the restart
+	restartedManager.onMessageConfirmed(testMessage(t, tangleInstance, epochTime(5*time.Minute)))
+	latestCommitment = restartedCollected.waitFor(t, 2)[1]
+	assert.Equal(t, ID(3), latestCommitment.EpochID())
+	assert.Equal(t, NewMerkleRoot([][]byte{message3.Bytes()}), latestCommitment.MessagesRoot())
+}
+
+func TestManager_StartEpoch(t *testing.T) {
+	tangleInstance := tangle.NewTestTangle()
+	defer tangleInstance.Shutdown()
+
+	genesisTime := time.Now().Add(-time.Hour).Unix()
+	manager := NewManager(tangleInstance, GenesisTime(genesisTime), Interval(time.Minute), CommitmentDelay(10*time.Second), StartEpoch(1))
+	defer manager.Shutdown()
+
+	collected := collectCommitments(manager)
+
+	// confirmations before the start epoch are ignored and the chain starts at the start epoch (not at the epoch of
+	// the first confirmation)
+	manager.onMessageConfirmed(testMessage(t, tangleInstance, time.Unix(genesisTime, 0).Add(30*time.Second)))
+	manager.onMessageConfirmed(testMessage(t, tangleInstance, time.Unix(genesisTime, 0).Add(5*time.Minute)))
+	committed := collected.waitFor(t, 3)
+	assert.Equal(t, ID(1), committed[0].EpochID())
+	assert.Equal(t, EmptyMerkleRoot, committed[0].PreviousRoot())
+	assert.Equal(t, uint32(0), committed[0].MessageCount())
+	assert.Equal(t, ID(3), committed[2].EpochID())
+}
+
+func TestCommitment_Bytes(t *testing.T) {
+	diff := newEpochDiff()
+	diff.messages[tangle.EmptyMessageID] = types.Void
+	diff.manaDiff[identity.ID{1}] = -10
+	diff.manaDiff[identity.ID{2}] = 0
+	commitment := newCommitment(7, NewMerkleRoot([][]byte{{1}, {2}}), diff)
+	assert.Equal(t, uint32(1), commitment.ManaDiffCount())
+
+	restoredCommitment, consumedBytes, err := CommitmentFromBytes(commitment.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(commitment.Bytes()), consumedBytes)
+	assert.Equal(t, commitment.Root(), restoredCommitment.Root())
+	assert.Equal(t, commitment.String(), restoredCommitment.String())
+
+	_, _, err = CommitmentFromBytes(commitment.Bytes()[:IDLength+MerkleRootLength])
+	assert.Error(t, err)
+}
+
+func TestNewMerkleRoot(t *testing.T) {
+	assert.Equal(t, EmptyMerkleRoot, NewMerkleRoot(nil))
+	assert.Equal(t, NewMerkleRoot([][]byte{{1}, {2}, {3}}), NewMerkleRoot([][]byte{{3}, {1}, {2}}))
+	assert.NotEqual(t, NewMerkleRoot([][]byte{{1}, {2}}), NewMerkleRoot([][]byte{{1}, {2}, {3}}))
+	assert.NotEqual(t, NewMerkleRoot([][]byte{{1}}), NewMerkleRoot([][]byte{{1}, {1}}))
+}
+
+// commitments collects the Commitments that a Manager triggers from its worker.
+type commitments struct {
+	committed []*Commitment
+	mutex     sync.Mutex
+}
+
+// collectCommitments returns the commitments that collects the Commitments of the given Manager.
+func collectCommitments(manager *Manager) (c *commitments) {
+	c = &commitments{}
+	manager.Events.EpochCommitted.Attach(events.NewClosure(func(commitment *Commitment) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		c.committed = append(c.committed, commitment)
+	}))
+
+	return c
+}
+
+// waitFor waits until exactly the given amount of Commitments was collected and returns them.
+func (c *commitments) waitFor(t *testing.T, count int) (committed []*Commitment) {
+	require.Eventually(t, func() bool {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		committed = append([]*Commitment{}, c.committed...)
+		return len(committed) >= count
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, committed, count)
+
+	return committed
+}
+
+// testMessage stores a Message with the given issuing time in the Tangle and returns its ID.
+func testMessage(t *testing.T, tangleInstance *tangle.Tangle, issuingTime time.Time) tangle.MessageID {
+	message, err := tangle.NewMessage(tangle.MessageIDs{tangle.EmptyMessageID}, nil, nil, nil, issuingTime, ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte(issuingTime.String())), 0, ed25519.Signature{})
+	require.NoError(t, err)
+	tangleInstance.Storage.StoreMessage(message)
+
+	return message.ID()
+}
+
+// testTransaction creates a Transaction that spends the given Output and pledges its consensus mana to the given node.
+func testTransaction(timestamp time.Time, pledgeID identity.ID, inputID ledgerstate.OutputID) *ledgerstate.Transaction {
+	keyPair := ed25519.GenerateKeyPair()
+	essence := ledgerstate.NewTransactionEssence(0, timestamp, pledgeID, pledgeID,
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(inputID)),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(100, ledgerstate.NewED25519Address(keyPair.PublicKey))),
+	)
+
+	return ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
+		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
+	})
+}
@@ -0,0 +1,96 @@
+package epochs
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// MerkleRootLength contains the amount of bytes that a marshaled version of the MerkleRoot contains.
+	MerkleRootLength = blake2b.Size256
+
+	// merkleLeafPrefix is prepended to the leaves of the tree before hashing them to prevent second preimage attacks.
+	merkleLeafPrefix byte = 0x00
+
+	// merkleNodePrefix is prepended to the inner nodes of the tree before hashing them.
+	merkleNodePrefix byte = 0x01
+)
+
+// region MerkleRoot ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MerkleRoot is the root of a binary Merkle tree (as defined in RFC 6962) over a set of leaves.
+type MerkleRoot [MerkleRootLength]byte
+
+// EmptyMerkleRoot is the MerkleRoot of an empty set of leaves.
+var EmptyMerkleRoot = MerkleRoot(blake2b.Sum256(nil))
+
+// NewMerkleRoot computes the MerkleRoot of the given leaves. The leaves are sorted before they are hashed, so the
+// MerkleRoot commits to the set of leaves independently of the order in which they were collected.
+func NewMerkleRoot(leaves [][]byte) MerkleRoot {
+	sortedLeaves := make([][]byte, len(leaves))
+	copy(sortedLeaves, leaves)
+	sort.Slice(sortedLeaves, func(i, j int) bool {
+		return bytes.Compare(sortedLeaves[i], sortedLeaves[j]) < 0
+	})
+
+	if len(sortedLeaves) == 0 {
+		return EmptyMerkleRoot
+	}
+
+	return merkleTreeHash(sortedLeaves)
+}
+
+// MerkleRootFromMarshalUtil unmarshals a MerkleRoot using a MarshalUtil (for easier unmarshaling).
+func MerkleRootFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (merkleRoot MerkleRoot, err error) {
+	merkleRootBytes, err := marshalUtil.ReadBytes(MerkleRootLength)
+	if err != nil {
+		err = errors.Errorf("failed to parse MerkleRoot (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(merkleRoot[:], merkleRootBytes)
+
+	return
+}
+
+// Bytes returns a marshaled version of the MerkleRoot.
+func (m MerkleRoot) Bytes() []byte {
+	return m[:]
+}
+
+// Base58 returns a base58 encoded version of the MerkleRoot.
+func (m MerkleRoot) Base58() string {
+	return base58.Encode(m.Bytes())
+}
+
+// String returns a human readable version of the MerkleRoot.
+func (m MerkleRoot) String() string {
+	return "MerkleRoot(" + m.Base58() + ")"
+}
+
+// merkleTreeHash recursively computes the hash of the (non-empty) list of leaves by splitting it at the largest power
+// of two that is smaller than the amount of leaves.
+func merkleTreeHash(leaves [][]byte) MerkleRoot {
+	if len(leaves) == 1 {
+		return blake2b.Sum256(append([]byte{merkleLeafPrefix}, leaves[0]...))
+	}
+
+	split := 1
+	for split*2 < len(leaves) {
+		split *= 2
+	}
+	left, right := merkleTreeHash(leaves[:split]), merkleTreeHash(leaves[split:])
+
+	return blake2b.Sum256(marshalutil.New(1 + 2*MerkleRootLength).
+		WriteByte(merkleNodePrefix).
+		WriteBytes(left.Bytes()).
+		WriteBytes(right.Bytes()).
+		Bytes())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -19,12 +19,32 @@ const (
 	payloadType = 2
 )
 
+// RequestVersion defines the layout of a faucet Request.
+type RequestVersion uint8
+
+const (
+	// RequestVersionIOTA is the version of requests for the default amount of IOTA. It does not carry its version.
+	RequestVersionIOTA RequestVersion = iota + 1
+	// RequestVersionColored is the version of requests for a custom amount of tokens of any color.
+	RequestVersionColored
+)
+
+const (
+	// requestIOTALength is the length of the content of a Request of version RequestVersionIOTA.
+	requestIOTALength = payload.TypeLength + ledgerstate.AddressLength + identity.IDLength + identity.IDLength + pow.NonceBytes
+	// requestColoredLength is the length of the content of a Request of version RequestVersionColored.
+	requestColoredLength = requestIOTALength + 1 + ledgerstate.ColorLength + marshalutil.Uint64Size
+)
+
 // Request represents a faucet request which contains an address for the faucet to send funds to.
 type Request struct {
 	payloadType           payload.Type
+	version               RequestVersion
 	address               ledgerstate.Address
 	accessManaPledgeID    identity.ID
 	consensusManaPledgeID identity.ID
+	color                 ledgerstate.Color
+	amount                uint64
 	nonce                 uint64
 }
 
@@ -37,7 +57,9 @@ var (
 func NewRequest(addr ledgerstate.Address, accessManaPledgeID, consensusManaPledgeID identity.ID, nonce uint64) *Request {
 	p := &Request{
 		payloadType:           Type,
+		version:               RequestVersionIOTA,
 		address:               addr,
+		color:                 ledgerstate.ColorIOTA,
 		accessManaPledgeID:    accessManaPledgeID,
 		consensusManaPledgeID: consensusManaPledgeID,
 		nonce:                 nonce,
@@ -46,13 +68,32 @@ func NewRequest(addr ledgerstate.Address, accessManaPledgeID, consensusManaPledg
 	return p
 }
 
+// NewColoredRequest creates a new Request for the given amount of tokens of the given color. An amount of zero requests
+// the default amount the faucet dispenses for the color.
+func NewColoredRequest(addr ledgerstate.Address, color ledgerstate.Color, amount uint64, accessManaPledgeID, consensusManaPledgeID identity.ID, nonce uint64) *Request {
+	return &Request{
+		payloadType:           Type,
+		version:               RequestVersionColored,
+		address:               addr,
+		accessManaPledgeID:    accessManaPledgeID,
+		consensusManaPledgeID: consensusManaPledgeID,
+		color:                 color,
+		amount:                amount,
+		nonce:                 nonce,
+	}
+}
+
 // FromBytes parses the marshaled version of a Request into a request object.
 func FromBytes(bytes []byte) (result *Request, consumedBytes int, err error) {
 	// initialize helper
 	marshalUtil := marshalutil.New(bytes)
 
-	result = &Request{}
-	if _, err = marshalUtil.ReadUint32(); err != nil {
+	result = &Request{
+		version: RequestVersionIOTA,
+		color:   ledgerstate.ColorIOTA,
+	}
+	payloadSize, err := marshalUtil.ReadUint32()
+	if err != nil {
 		err = errors.Errorf("failed to parse payload size (%v): %w", err, cerrors.ErrParseBytesFailed)
 		return
 	}
@@ -81,6 +122,11 @@ func FromBytes(bytes []byte) (result *Request, consumedBytes int, err error) {
 		err = errors.Errorf("failed to unmarshal consensus mana pledge ID of faucet request (%v): %w", err, cerrors.ErrParseBytesFailed)
 		return
 	}
+	if payloadSize != requestIOTALength {
+		if err = result.readColoredFields(marshalUtil); err != nil {
+			return
+		}
+	}
 	result.nonce, err = marshalUtil.ReadUint64()
 	if err != nil {
 		err = errors.Errorf("failed to unmarshal nonce of faucet request (%v): %w", err, cerrors.ErrParseBytesFailed)
@@ -91,6 +137,24 @@ func FromBytes(bytes []byte) (result *Request, consumedBytes int, err error) {
 	return
 }
 
+// readColoredFields parses the fields of a Request of version RequestVersionColored.
+func (p *Request) readColoredFields(marshalUtil *marshalutil.MarshalUtil) (err error) {
+	version, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return errors.Errorf("failed to parse version of faucet request (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	if p.version = RequestVersion(version); p.version != RequestVersionColored {
+		return errors.Errorf("unsupported version %d of faucet request: %w", version, cerrors.ErrParseBytesFailed)
+	}
+	if p.color, err = ledgerstate.ColorFromMarshalUtil(marshalUtil); err != nil {
+		return errors.Errorf("failed to parse color of faucet request (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	if p.amount, err = marshalUtil.ReadUint64(); err != nil {
+		return errors.Errorf("failed to parse amount of faucet request (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	return nil
+}
+
 // Type returns the type of the faucet Request.
 func (p *Request) Type() payload.Type {
 	return p.payloadType
@@ -101,6 +165,21 @@ func (p *Request) Address() ledgerstate.Address {
 	return p.address
 }
 
+// Version returns the version of the faucet Request.
+func (p *Request) Version() RequestVersion {
+	return p.version
+}
+
+// Color returns the color of the tokens requested by the faucet Request.
+func (p *Request) Color() ledgerstate.Color {
+	return p.color
+}
+
+// Amount returns the amount of tokens requested by the faucet Request. Zero requests the default amount.
+func (p *Request) Amount() uint64 {
+	return p.amount
+}
+
 // AccessManaPledgeID returns the access mana pledge ID of the faucet request.
 func (p *Request) AccessManaPledgeID() identity.ID {
 	return p.accessManaPledgeID
@@ -117,11 +196,20 @@ func (p *Request) Bytes() []byte {
 	marshalUtil := marshalutil.New()
 
 	// marshal the payload specific information
-	marshalUtil.WriteUint32(payload.TypeLength + uint32(ledgerstate.AddressLength+identity.IDLength+identity.IDLength+pow.NonceBytes))
+	if p.version == RequestVersionColored {
+		marshalUtil.WriteUint32(requestColoredLength)
+	} else {
+		marshalUtil.WriteUint32(requestIOTALength)
+	}
 	marshalUtil.WriteBytes(p.Type().Bytes())
 	marshalUtil.WriteBytes(p.address.Bytes())
 	marshalUtil.WriteBytes(p.accessManaPledgeID.Bytes())
 	marshalUtil.WriteBytes(p.consensusManaPledgeID.Bytes())
+	if p.version == RequestVersionColored {
+		marshalUtil.WriteUint8(uint8(p.version))
+		marshalUtil.WriteBytes(p.color.Bytes())
+		marshalUtil.WriteUint64(p.amount)
+	}
 	marshalUtil.WriteUint64(p.nonce)
 
 	// return result
@@ -131,7 +219,10 @@ func (p *Request) Bytes() []byte {
 // String returns a human readable version of faucet Request payload (for debug purposes).
 func (p *Request) String() string {
 	return stringify.Struct("FaucetPayload",
+		stringify.StructField("version", uint8(p.version)),
 		stringify.StructField("address", p.Address().Base58()),
+		stringify.StructField("color", p.color.Base58()),
+		stringify.StructField("amount", p.amount),
 		stringify.StructField("accessManaPledgeID", p.accessManaPledgeID.String()),
 		stringify.StructField("consensusManaPledgeID", p.consensusManaPledgeID.String()),
 	)
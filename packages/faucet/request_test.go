@@ -64,6 +64,36 @@ func TestRequest(t *testing.T) {
 	assert.Equal(t, originalRequest.Address(), clonedRequest2.Address())
 }
 
+func TestColoredRequest(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	address := ledgerstate.NewED25519Address(keyPair.PublicKey)
+	access, _ := identity.RandomID()
+	color := ledgerstate.Color{1, 2, 3}
+
+	originalRequest := NewColoredRequest(address, color, 42, access, identity.ID{}, 7)
+
+	clonedRequest, consumedBytes, err := FromBytes(originalRequest.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, len(originalRequest.Bytes()), consumedBytes)
+	assert.Equal(t, RequestVersionColored, clonedRequest.Version())
+	assert.Equal(t, originalRequest.Address(), clonedRequest.Address())
+	assert.Equal(t, color, clonedRequest.Color())
+	assert.Equal(t, uint64(42), clonedRequest.Amount())
+	assert.Equal(t, originalRequest.AccessManaPledgeID(), clonedRequest.AccessManaPledgeID())
+	assert.Equal(t, originalRequest.Bytes(), clonedRequest.Bytes())
+
+	// the nonce is always the last field, so the PoW covers all the other fields
+	requestBytes := originalRequest.Bytes()
+	assert.Equal(t, byte(7), requestBytes[len(requestBytes)-8])
+
+	// requests of the first version default to IOTA
+	iotaRequest, _, err := FromBytes(NewRequest(address, access, identity.ID{}, 0).Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, RequestVersionIOTA, iotaRequest.Version())
+	assert.Equal(t, ledgerstate.ColorIOTA, iotaRequest.Color())
+	assert.Zero(t, iotaRequest.Amount())
+}
+
 func TestIsFaucetReq(t *testing.T) {
 	keyPair := ed25519.GenerateKeyPair()
 	address := ledgerstate.NewED25519Address(keyPair.PublicKey)
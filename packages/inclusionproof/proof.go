@@ -0,0 +1,187 @@
+package inclusionproof
+
+import (
+	"math"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// MaxPathLength defines the maximum amount of Messages in the path of a Proof.
+const MaxPathLength = 64
+
+// region Proof ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Proof proves that a Message (and the Transaction it attaches) is part of the Tangle. It contains the path of strong
+// (or like) parent references from the proven Message up to an anchor Message, which is a confirmed marker of the
+// Tangle, plus the GradeOfFinality and the approval weight of the anchor as reported by the node that created the
+// Proof. The reported values are not authenticated, so the trust in a Proof has to come from its anchor (see Verifier).
+//
+// The MessageID is the hash of the serialized Message, so every Message of the path commits to the ID of its
+// predecessor and the anchor commits to the whole path.
+type Proof struct {
+	path                  []*tangle.Message
+	anchorGradeOfFinality gof.GradeOfFinality
+	anchorApprovalWeight  float64
+}
+
+// NewProof creates a new Proof from the path of Messages that starts with the proven Message and ends with the anchor.
+func NewProof(path []*tangle.Message, anchorGradeOfFinality gof.GradeOfFinality, anchorApprovalWeight float64) (proof *Proof, err error) {
+	if len(path) == 0 || len(path) > MaxPathLength {
+		return nil, errors.Errorf("the path of a Proof needs to contain between 1 and %d Messages", MaxPathLength)
+	}
+
+	return &Proof{
+		path:                  path,
+		anchorGradeOfFinality: anchorGradeOfFinality,
+		anchorApprovalWeight:  anchorApprovalWeight,
+	}, nil
+}
+
+// FromBytes unmarshals a Proof from a sequence of bytes.
+func FromBytes(bytes []byte) (proof *Proof, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if proof, err = FromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Proof from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FromBase58EncodedString creates a Proof from a base58 encoded string.
+func FromBase58EncodedString(base58String string) (proof *Proof, err error) {
+	bytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = errors.Errorf("error while decoding base58 encoded Proof (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+		return
+	}
+
+	if proof, _, err = FromBytes(bytes); err != nil {
+		err = errors.Errorf("failed to parse Proof from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// FromMarshalUtil unmarshals a Proof using a MarshalUtil (for easier unmarshaling).
+func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (proof *Proof, err error) {
+	pathLength, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = errors.Errorf("failed to parse path length (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if pathLength == 0 || pathLength > MaxPathLength {
+		err = errors.Errorf("path length %d is not between 1 and %d: %w", pathLength, MaxPathLength, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	proof = &Proof{path: make([]*tangle.Message, pathLength)}
+	for i := range proof.path {
+		if proof.path[i], err = tangle.MessageFromMarshalUtil(marshalUtil); err != nil {
+			err = errors.Errorf("failed to parse Message %d of the path: %w", i, err)
+			return
+		}
+	}
+
+	gradeOfFinality, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = errors.Errorf("failed to parse GradeOfFinality of anchor (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	proof.anchorGradeOfFinality = gof.GradeOfFinality(gradeOfFinality)
+	if proof.anchorGradeOfFinality > gof.High {
+		err = errors.Errorf("invalid GradeOfFinality %d of anchor: %w", gradeOfFinality, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	approvalWeightBits, err := marshalUtil.ReadUint64()
+	if err != nil {
+		err = errors.Errorf("failed to parse approval weight of anchor (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	proof.anchorApprovalWeight = math.Float64frombits(approvalWeightBits)
+
+	return
+}
+
+// Message returns the Message that is proven to be part of the Tangle.
+func (p *Proof) Message() *tangle.Message {
+	return p.path[0]
+}
+
+// TransactionID returns the ID of the Transaction that is attached by the proven Message. The second return value
+// indicates if the Message carries a Transaction at all.
+func (p *Proof) TransactionID() (transactionID ledgerstate.TransactionID, exists bool) {
+	transaction, exists := p.Message().Payload().(*ledgerstate.Transaction)
+	if !exists {
+		return
+	}
+
+	return transaction.ID(), true
+}
+
+// Anchor returns the confirmed Message that the path ends with.
+func (p *Proof) Anchor() *tangle.Message {
+	return p.path[len(p.path)-1]
+}
+
+// Path returns the Messages that lead from the proven Message to the anchor.
+func (p *Proof) Path() []*tangle.Message {
+	return p.path
+}
+
+// AnchorGradeOfFinality returns the GradeOfFinality of the anchor as reported by the node that created the Proof. The
+// value is not authenticated and only serves as information.
+func (p *Proof) AnchorGradeOfFinality() gof.GradeOfFinality {
+	return p.anchorGradeOfFinality
+}
+
+// AnchorApprovalWeight returns the approval weight of the marker of the anchor as reported by the node that created
+// the Proof. The value is not authenticated and only serves as information.
+func (p *Proof) AnchorApprovalWeight() float64 {
+	return p.anchorApprovalWeight
+}
+
+// Bytes returns a marshaled version of the Proof.
+func (p *Proof) Bytes() []byte {
+	marshalUtil := marshalutil.New()
+	marshalUtil.WriteUint8(uint8(len(p.path)))
+	for _, message := range p.path {
+		marshalUtil.Write(message)
+	}
+	marshalUtil.WriteUint8(uint8(p.anchorGradeOfFinality))
+	marshalUtil.WriteUint64(math.Float64bits(p.anchorApprovalWeight))
+
+	return marshalUtil.Bytes()
+}
+
+// Base58 returns a base58 encoded version of the Proof.
+func (p *Proof) Base58() string {
+	return base58.Encode(p.Bytes())
+}
+
+// String returns a human readable version of the Proof.
+func (p *Proof) String() string {
+	path := make(tangle.MessageIDs, len(p.path))
+	for i, message := range p.path {
+		path[i] = message.ID()
+	}
+
+	return stringify.Struct("Proof",
+		stringify.StructField("path", path),
+		stringify.StructField("anchorGradeOfFinality", p.anchorGradeOfFinality),
+		stringify.StructField("anchorApprovalWeight", p.anchorApprovalWeight),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,42 @@
+package inclusionproof
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestProof_Bytes(t *testing.T) {
+	issuer := ed25519.GenerateKeyPair()
+	transaction := testTransaction()
+	target := testMessage(t, issuer, tangle.MessageIDs{tangle.EmptyMessageID}, transaction)
+	anchor := testMessage(t, issuer, tangle.MessageIDs{target.ID()}, transaction)
+
+	proof, err := NewProof([]*tangle.Message{target, anchor}, gof.High, 0.75)
+	require.NoError(t, err)
+
+	restoredProof, consumedBytes, err := FromBytes(proof.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(proof.Bytes()), consumedBytes)
+	assert.Equal(t, target.ID(), restoredProof.Message().ID())
+	assert.Equal(t, anchor.ID(), restoredProof.Anchor().ID())
+	assert.Equal(t, gof.High, restoredProof.AnchorGradeOfFinality())
+	assert.Equal(t, 0.75, restoredProof.AnchorApprovalWeight())
+	transactionID, exists := restoredProof.TransactionID()
+	assert.True(t, exists)
+	assert.Equal(t, transaction.ID(), transactionID)
+
+	restoredProof, err = FromBase58EncodedString(proof.Base58())
+	require.NoError(t, err)
+	assert.Equal(t, proof.Bytes(), restoredProof.Bytes())
+
+	_, err = NewProof(nil, gof.High, 0)
+	assert.Error(t, err)
+	_, _, err = FromBytes(proof.Bytes()[:len(proof.Bytes())-1])
+	assert.Error(t, err)
+}
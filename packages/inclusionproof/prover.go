@@ -0,0 +1,163 @@
+package inclusionproof
+
+import (
+	"math"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/types"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// maxVisitedMessages defines how many Messages of the future cone the Prover walks at most to find an anchor.
+const maxVisitedMessages = 10000
+
+var (
+	// ErrNotFound is returned if the Message or Transaction that should be proven is unknown.
+	ErrNotFound = errors.New("not found")
+	// ErrNoAnchor is returned if no confirmed anchor is found in the future cone of the proven Message.
+	ErrNoAnchor = errors.New("no confirmed anchor found")
+)
+
+// region Prover ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Prover creates Proofs from the Tangle of a node.
+type Prover struct {
+	tangle *tangle.Tangle
+}
+
+// NewProver creates a new Prover for the given Tangle.
+func NewProver(tangleInstance *tangle.Tangle) *Prover {
+	return &Prover{
+		tangle: tangleInstance,
+	}
+}
+
+// TransactionProof creates a Proof for the Transaction with the given ID. It uses the attachment of the Transaction
+// with the shortest path to an anchor. If anchorIssuers are given, the anchor has to be issued by one of them.
+func (p *Prover) TransactionProof(transactionID ledgerstate.TransactionID, anchorIssuers ...ed25519.PublicKey) (proof *Proof, err error) {
+	attachments := p.tangle.Storage.AttachmentMessageIDs(transactionID)
+	if len(attachments) == 0 {
+		return nil, errors.Errorf("transaction %s is not attached by any message: %w", transactionID.Base58(), ErrNotFound)
+	}
+
+	for _, messageID := range attachments {
+		attachmentProof, attachmentErr := p.MessageProof(messageID, anchorIssuers...)
+		if attachmentErr != nil {
+			err = attachmentErr
+			continue
+		}
+		if proof == nil || len(attachmentProof.Path()) < len(proof.Path()) {
+			proof = attachmentProof
+		}
+	}
+	if proof == nil {
+		return nil, errors.Errorf("failed to create proof for transaction %s: %w", transactionID.Base58(), err)
+	}
+
+	return proof, nil
+}
+
+// MessageProof creates a Proof for the Message with the given ID. It walks the strong approvers of the Message until
+// it finds the closest confirmed marker, which becomes the anchor of the Proof. If anchorIssuers are given, the anchor
+// has to be issued by one of them.
+func (p *Prover) MessageProof(messageID tangle.MessageID, anchorIssuers ...ed25519.PublicKey) (proof *Proof, err error) {
+	if !p.tangle.Storage.Message(messageID).Consume(func(*tangle.Message) {}) {
+		return nil, errors.Errorf("message %s is unknown: %w", messageID, ErrNotFound)
+	}
+
+	path, anchorMetadata, err := p.pathToAnchor(messageID, anchorIssuers)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*tangle.Message, len(path))
+	for i, pathMessageID := range path {
+		if !p.tangle.Storage.Message(pathMessageID).Consume(func(message *tangle.Message) {
+			messages[i] = message
+		}) {
+			return nil, errors.Errorf("message %s of the path is unknown: %w", pathMessageID, ErrNotFound)
+		}
+	}
+
+	anchorApprovalWeight := float64(0)
+	if p.tangle.WeightProvider != nil {
+		anchorApprovalWeight = p.tangle.ApprovalWeightManager.WeightOfMarker(anchorMetadata.StructureDetails().PastMarkers.Marker(), messages[len(messages)-1].IssuingTime())
+	}
+	// the weight is undefined if the total weight of the network is unknown (e.g. right after startup)
+	if math.IsNaN(anchorApprovalWeight) {
+		anchorApprovalWeight = 0
+	}
+
+	return NewProof(messages, anchorMetadata.GradeOfFinality(), anchorApprovalWeight)
+}
+
+// pathToAnchor performs a breadth-first search through the strong approvers of the Message to find the shortest path
+// to a confirmed marker. The strong approvers include the Messages that reference the Message with a like reference,
+// which the Verifier accepts as well.
+func (p *Prover) pathToAnchor(messageID tangle.MessageID, anchorIssuers []ed25519.PublicKey) (path tangle.MessageIDs, anchorMetadata *tangle.MessageMetadata, err error) {
+	issuers := make(map[ed25519.PublicKey]types.Empty)
+	for _, issuer := range anchorIssuers {
+		issuers[issuer] = types.Void
+	}
+
+	predecessors := map[tangle.MessageID]tangle.MessageID{messageID: messageID}
+	depths := map[tangle.MessageID]int{messageID: 1}
+	queue := tangle.MessageIDs{messageID}
+	for len(queue) != 0 && len(predecessors) <= maxVisitedMessages {
+		currentMessageID := queue[0]
+		queue = queue[1:]
+
+		if metadata := p.anchorMetadata(currentMessageID, issuers); metadata != nil {
+			for pathMessageID := currentMessageID; ; pathMessageID = predecessors[pathMessageID] {
+				path = append(tangle.MessageIDs{pathMessageID}, path...)
+				if pathMessageID == messageID {
+					return path, metadata, nil
+				}
+			}
+		}
+
+		if depths[currentMessageID] == MaxPathLength {
+			continue
+		}
+		p.tangle.Storage.Approvers(currentMessageID, tangle.StrongApprover).Consume(func(approver *tangle.Approver) {
+			approverMessageID := approver.ApproverMessageID()
+			if _, visited := predecessors[approverMessageID]; visited {
+				return
+			}
+			predecessors[approverMessageID] = currentMessageID
+			depths[approverMessageID] = depths[currentMessageID] + 1
+			queue = append(queue, approverMessageID)
+		})
+	}
+
+	return nil, nil, errors.Errorf("failed to find anchor within %d messages of %s: %w", MaxPathLength, messageID, ErrNoAnchor)
+}
+
+// anchorMetadata returns the MessageMetadata of the Message if it is a confirmed marker issued by one of the issuers
+// (if any are given) or nil otherwise.
+func (p *Prover) anchorMetadata(messageID tangle.MessageID, issuers map[ed25519.PublicKey]types.Empty) (anchorMetadata *tangle.MessageMetadata) {
+	p.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		structureDetails := messageMetadata.StructureDetails()
+		if messageMetadata.GradeOfFinality() < gof.High || structureDetails == nil || !structureDetails.IsPastMarker {
+			return
+		}
+		anchorMetadata = messageMetadata
+	})
+	if anchorMetadata == nil || len(issuers) == 0 {
+		return anchorMetadata
+	}
+
+	p.tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		if _, trusted := issuers[message.IssuerPublicKey()]; !trusted {
+			anchorMetadata = nil
+		}
+	})
+
+	return anchorMetadata
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
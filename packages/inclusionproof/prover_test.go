@@ -0,0 +1,172 @@
+package inclusionproof
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestProver_TransactionProof(t *testing.T) {
+	tangleInstance := tangle.NewTestTangle()
+	defer tangleInstance.Shutdown()
+
+	issuer, anchorIssuer := ed25519.GenerateKeyPair(), ed25519.GenerateKeyPair()
+	transaction := testTransaction()
+
+	// target <- approver <- anchor, the side branch of the target is not approved by the anchor
+	target := testMessage(t, issuer, tangle.MessageIDs{tangle.EmptyMessageID}, transaction)
+	sideBranch := testMessage(t, issuer, tangle.MessageIDs{target.ID()}, payload.NewGenericDataPayload([]byte("side")))
+	approver := testMessage(t, issuer, tangle.MessageIDs{target.ID()}, payload.NewGenericDataPayload([]byte("approver")))
+	anchor := testMessage(t, anchorIssuer, tangle.MessageIDs{approver.ID()}, payload.NewGenericDataPayload([]byte("anchor")))
+	for _, message := range []*tangle.Message{target, sideBranch, approver, anchor} {
+		tangleInstance.Storage.StoreMessage(message)
+	}
+	cachedAttachment, _ := tangleInstance.Storage.StoreAttachment(transaction.ID(), target.ID())
+	cachedAttachment.Release()
+
+	prover := NewProver(tangleInstance)
+
+	// there is no confirmed anchor, yet
+	_, err := prover.TransactionProof(transaction.ID())
+	assert.ErrorIs(t, err, ErrNoAnchor)
+	_, err = prover.TransactionProof(ledgerstate.GenesisTransactionID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	tangleInstance.Storage.MessageMetadata(anchor.ID()).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		messageMetadata.SetStructureDetails(&markers.StructureDetails{
+			IsPastMarker:  true,
+			PastMarkers:   markers.NewMarkers(markers.NewMarker(1, 3)),
+			FutureMarkers: markers.NewMarkers(),
+		})
+		messageMetadata.SetGradeOfFinality(gof.High)
+	})
+
+	proof, err := prover.TransactionProof(transaction.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []*tangle.Message{target, approver, anchor}, proof.Path())
+	assert.Equal(t, gof.High, proof.AnchorGradeOfFinality())
+
+	// only the trusted issuer of the anchor makes the proof valid
+	assert.NoError(t, NewVerifier(WithTrustedIssuers(anchorIssuer.PublicKey)).VerifyTransaction(proof, transaction.ID()))
+	assert.NoError(t, NewVerifier(WithTrustedAnchors(anchor.ID())).VerifyMessage(proof, target.ID()))
+	assert.ErrorIs(t, NewVerifier(WithTrustedIssuers(issuer.PublicKey)).VerifyTransaction(proof, transaction.ID()), ErrUntrustedAnchor)
+	assert.ErrorIs(t, NewVerifier().VerifyTransaction(proof, transaction.ID()), ErrUntrustedAnchor)
+	assert.ErrorIs(t, NewVerifier(WithTrustedIssuers(anchorIssuer.PublicKey)).VerifyMessage(proof, approver.ID()), ErrInvalidProof)
+
+	// the reported finality of the anchor is not authenticated and does not affect the verification
+	unconfirmedProof, err := NewProof(proof.Path(), gof.None, 0)
+	require.NoError(t, err)
+	assert.NoError(t, NewVerifier(WithTrustedIssuers(anchorIssuer.PublicKey)).VerifyTransaction(unconfirmedProof, transaction.ID()))
+
+	// the anchor has to be issued by one of the requested issuers
+	_, err = prover.MessageProof(target.ID(), issuer.PublicKey)
+	assert.ErrorIs(t, err, ErrNoAnchor)
+	proof, err = prover.MessageProof(target.ID(), anchorIssuer.PublicKey)
+	require.NoError(t, err)
+	assert.Len(t, proof.Path(), 3)
+}
+
+func TestProver_LikeReference(t *testing.T) {
+	tangleInstance := tangle.NewTestTangle()
+	defer tangleInstance.Shutdown()
+
+	issuer := ed25519.GenerateKeyPair()
+
+	// target <- likeApprover <- anchor, the like approver references the target only with a like reference
+	target := testMessage(t, issuer, tangle.MessageIDs{tangle.EmptyMessageID}, payload.NewGenericDataPayload([]byte("target")))
+	likeApprover, err := tangle.NewMessage(tangle.MessageIDs{tangle.EmptyMessageID}, nil, nil, tangle.MessageIDs{target.ID()}, time.Now(), issuer.PublicKey, 0, payload.NewGenericDataPayload([]byte("like")), 0, ed25519.Signature{})
+	require.NoError(t, err)
+	likeApprover = signMessage(t, issuer, likeApprover)
+	anchor := testMessage(t, issuer, tangle.MessageIDs{likeApprover.ID()}, payload.NewGenericDataPayload([]byte("anchor")))
+	for _, message := range []*tangle.Message{target, likeApprover, anchor} {
+		tangleInstance.Storage.StoreMessage(message)
+	}
+	tangleInstance.Storage.MessageMetadata(anchor.ID()).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		messageMetadata.SetStructureDetails(&markers.StructureDetails{
+			IsPastMarker:  true,
+			PastMarkers:   markers.NewMarkers(markers.NewMarker(1, 3)),
+			FutureMarkers: markers.NewMarkers(),
+		})
+		messageMetadata.SetGradeOfFinality(gof.High)
+	})
+
+	// the prover follows the like reference and the verifier accepts it
+	proof, err := NewProver(tangleInstance).MessageProof(target.ID())
+	require.NoError(t, err)
+	assert.Equal(t, []*tangle.Message{target, likeApprover, anchor}, proof.Path())
+	assert.NoError(t, NewVerifier(WithTrustedAnchors(anchor.ID())).VerifyMessage(proof, target.ID()))
+}
+
+func TestVerifier_BrokenPath(t *testing.T) {
+	issuer := ed25519.GenerateKeyPair()
+	target := testMessage(t, issuer, tangle.MessageIDs{tangle.EmptyMessageID}, payload.NewGenericDataPayload([]byte("target")))
+	unrelated := testMessage(t, issuer, tangle.MessageIDs{tangle.EmptyMessageID}, payload.NewGenericDataPayload([]byte("unrelated")))
+	weakApprover, err := tangle.NewMessage(tangle.MessageIDs{tangle.EmptyMessageID}, tangle.MessageIDs{target.ID()}, nil, nil, time.Now(), issuer.PublicKey, 0, payload.NewGenericDataPayload([]byte("weak")), 0, ed25519.Signature{})
+	require.NoError(t, err)
+	weakApprover = signMessage(t, issuer, weakApprover)
+	verifier := NewVerifier(WithTrustedIssuers(issuer.PublicKey))
+
+	proof, err := NewProof([]*tangle.Message{target, unrelated}, gof.High, 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, verifier.VerifyMessage(proof, target.ID()), ErrInvalidProof)
+
+	// weak references do not include the past cone of the referenced message
+	proof, err = NewProof([]*tangle.Message{target, weakApprover}, gof.High, 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, verifier.VerifyMessage(proof, target.ID()), ErrInvalidProof)
+
+	// a message proof does not prove a transaction
+	proof, err = NewProof([]*tangle.Message{target}, gof.High, 1)
+	require.NoError(t, err)
+	assert.NoError(t, verifier.VerifyMessage(proof, target.ID()))
+	assert.ErrorIs(t, verifier.VerifyTransaction(proof, ledgerstate.GenesisTransactionID), ErrInvalidProof)
+
+	// the issuer has to sign the messages of the path
+	forged, err := tangle.NewMessage(tangle.MessageIDs{target.ID()}, nil, nil, nil, time.Now(), issuer.PublicKey, 0, payload.NewGenericDataPayload([]byte("forged")), 0, ed25519.Signature{})
+	require.NoError(t, err)
+	proof, err = NewProof([]*tangle.Message{target, forged}, gof.High, 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, verifier.VerifyMessage(proof, target.ID()), ErrInvalidProof)
+}
+
+// testMessage creates a Message that is signed by the given issuer.
+func testMessage(t *testing.T, issuer ed25519.KeyPair, strongParents tangle.MessageIDs, messagePayload payload.Payload) *tangle.Message {
+	message, err := tangle.NewMessage(strongParents, nil, nil, nil, time.Now(), issuer.PublicKey, 0, messagePayload, 0, ed25519.Signature{})
+	require.NoError(t, err)
+
+	return signMessage(t, issuer, message)
+}
+
+// signMessage returns a copy of the Message that is signed by the given issuer.
+func signMessage(t *testing.T, issuer ed25519.KeyPair, message *tangle.Message) *tangle.Message {
+	messageBytes := message.Bytes()
+	signature := issuer.PrivateKey.Sign(messageBytes[:len(messageBytes)-ed25519.SignatureSize])
+	signedMessage, _, err := tangle.MessageFromBytes(append(messageBytes[:len(messageBytes)-ed25519.SignatureSize:len(messageBytes)-ed25519.SignatureSize], signature.Bytes()...))
+	require.NoError(t, err)
+	require.True(t, signedMessage.VerifySignature())
+
+	return signedMessage
+}
+
+// testTransaction creates a Transaction that spends the genesis output.
+func testTransaction() *ledgerstate.Transaction {
+	keyPair := ed25519.GenerateKeyPair()
+	essence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(100, ledgerstate.NewED25519Address(keyPair.PublicKey))),
+	)
+
+	return ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
+		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
+	})
+}
@@ -0,0 +1,149 @@
+package inclusionproof
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/types"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var (
+	// ErrInvalidProof is returned if a Proof is malformed or does not prove what it is supposed to prove.
+	ErrInvalidProof = errors.New("invalid proof")
+	// ErrUntrustedAnchor is returned if the anchor of a Proof is neither a trusted Message nor issued by a trusted
+	// issuer.
+	ErrUntrustedAnchor = errors.New("untrusted anchor")
+)
+
+// region Verifier /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Verifier validates Proofs without access to a node. The anchor of a Proof is only accepted if it is one of the
+// trusted anchors or if it was issued (and signed) by one of the trusted issuers, e.g. the public keys of nodes with a
+// high consensus mana that the verifier relies on like on milestones.
+//
+// The trust in a Proof comes only from its anchor: the GradeOfFinality and the approval weight that a Proof reports for
+// its anchor are not authenticated and are therefore ignored by the Verifier. Consequently, the two kinds of trust
+// prove different things:
+//   - a trusted anchor proves that the Message is confirmed, if the anchor was obtained as confirmed (e.g. from several
+//     independent nodes),
+//   - a trusted issuer proves only the inclusion of the Message in the Tangle as seen by that issuer, as any Message it
+//     signed is accepted as the anchor, whether it is confirmed or not. It does not prove that a Transaction won its
+//     conflicts.
+type Verifier struct {
+	trustedAnchors map[tangle.MessageID]types.Empty
+	trustedIssuers map[ed25519.PublicKey]types.Empty
+}
+
+// NewVerifier creates a new Verifier with the given options. A Verifier needs at least one trusted anchor or issuer to
+// accept any Proof.
+func NewVerifier(options ...VerifierOption) (verifier *Verifier) {
+	verifier = &Verifier{
+		trustedAnchors: make(map[tangle.MessageID]types.Empty),
+		trustedIssuers: make(map[ed25519.PublicKey]types.Empty),
+	}
+	for _, option := range options {
+		option(verifier)
+	}
+
+	return verifier
+}
+
+// VerifyMessage checks if the Proof proves that the Message with the given ID is part of the Tangle.
+func (v *Verifier) VerifyMessage(proof *Proof, messageID tangle.MessageID) (err error) {
+	if err = v.verifyPath(proof); err != nil {
+		return err
+	}
+	if proof.Message().ID() != messageID {
+		return errors.Errorf("proof is for %s instead of %s: %w", proof.Message().ID(), messageID, ErrInvalidProof)
+	}
+
+	return nil
+}
+
+// VerifyTransaction checks if the Proof proves that the Transaction with the given ID is part of the Tangle.
+func (v *Verifier) VerifyTransaction(proof *Proof, transactionID ledgerstate.TransactionID) (err error) {
+	if err = v.verifyPath(proof); err != nil {
+		return err
+	}
+	provenTransactionID, exists := proof.TransactionID()
+	if !exists {
+		return errors.Errorf("proven %s does not attach a transaction: %w", proof.Message().ID(), ErrInvalidProof)
+	}
+	if provenTransactionID != transactionID {
+		return errors.Errorf("proof is for %s instead of %s: %w", provenTransactionID.Base58(), transactionID.Base58(), ErrInvalidProof)
+	}
+
+	return nil
+}
+
+// verifyPath checks if every Message of the path is signed by its issuer and strongly referenced by its successor and
+// if the anchor of the path is trusted. Like references count as strong references, just like the Prover walks them as
+// strong approvers.
+func (v *Verifier) verifyPath(proof *Proof) (err error) {
+	path := proof.Path()
+	if len(path) == 0 || len(path) > MaxPathLength {
+		return errors.Errorf("path length %d is not between 1 and %d: %w", len(path), MaxPathLength, ErrInvalidProof)
+	}
+
+	for i, message := range path {
+		if !message.VerifySignature() {
+			return errors.Errorf("signature of %s is invalid: %w", message.ID(), ErrInvalidProof)
+		}
+		if i > 0 && !referencesStrongly(message, path[i-1].ID()) {
+			return errors.Errorf("%s does not strongly reference %s: %w", message.ID(), path[i-1].ID(), ErrInvalidProof)
+		}
+	}
+
+	anchor := proof.Anchor()
+	_, trustedAnchor := v.trustedAnchors[anchor.ID()]
+	_, trustedIssuer := v.trustedIssuers[anchor.IssuerPublicKey()]
+	if !trustedAnchor && !trustedIssuer {
+		return errors.Errorf("anchor %s issued by %s is not trusted: %w", anchor.ID(), anchor.IssuerPublicKey(), ErrUntrustedAnchor)
+	}
+
+	return nil
+}
+
+// referencesStrongly returns true if the Message references the given parent with a strong or like reference, which
+// both include the past cone of the parent. The Storage books both kinds of references as StrongApprover, so this
+// matches the approvers that the Prover follows.
+func referencesStrongly(message *tangle.Message, parentMessageID tangle.MessageID) (references bool) {
+	message.ForEachParent(func(parent tangle.Parent) {
+		if parent.ID == parentMessageID && (parent.Type == tangle.StrongParentType || parent.Type == tangle.LikeParentType) {
+			references = true
+		}
+	})
+
+	return references
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region VerifierOption ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// VerifierOption is a function that configures a Verifier.
+type VerifierOption func(verifier *Verifier)
+
+// WithTrustedAnchors is a VerifierOption that adds Messages that are trusted to be confirmed, e.g. because several
+// independent nodes reported them as confirmed.
+func WithTrustedAnchors(messageIDs ...tangle.MessageID) VerifierOption {
+	return func(verifier *Verifier) {
+		for _, messageID := range messageIDs {
+			verifier.trustedAnchors[messageID] = types.Void
+		}
+	}
+}
+
+// WithTrustedIssuers is a VerifierOption that adds the public keys of issuers whose Messages are trusted as anchors.
+// A Proof anchored by one of them only proves the inclusion of the Message, not its confirmation (see Verifier).
+func WithTrustedIssuers(publicKeys ...ed25519.PublicKey) VerifierOption {
+	return func(verifier *Verifier) {
+		for _, publicKey := range publicKeys {
+			verifier.trustedIssuers[publicKey] = types.Void
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
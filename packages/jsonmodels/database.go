@@ -0,0 +1,7 @@
+package jsonmodels
+
+// DatabaseCheckpointResponse is the HTTP response of creating a checkpoint of the database.
+type DatabaseCheckpointResponse struct {
+	Directory string `json:"directory,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
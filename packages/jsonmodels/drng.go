@@ -1,6 +1,12 @@
 package jsonmodels
 
-import "time"
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/drng"
+)
 
 // CollectiveBeaconResponse is the HTTP response from broadcasting a collective beacon message.
 type CollectiveBeaconResponse struct {
@@ -13,6 +19,17 @@ type CollectiveBeaconRequest struct {
 	Payload []byte `json:"payload"`
 }
 
+// CommitteeRotationResponse is the HTTP response from broadcasting a committee rotation message.
+type CommitteeRotationResponse struct {
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// CommitteeRotationRequest is a request containing a committee rotation payload.
+type CommitteeRotationRequest struct {
+	Payload []byte `json:"payload"`
+}
+
 // CommitteeResponse is the HTTP message containing the DRNG committee.
 type CommitteeResponse struct {
 	Committees []Committee `json:"committees,omitempty"`
@@ -25,6 +42,7 @@ type Committee struct {
 	Threshold     uint8    `json:"threshold,omitempty"`
 	Identities    []string `json:"identities,omitempty"`
 	DistributedPK string   `json:"distributedPK,omitempty"`
+	BeaconType    uint8    `json:"beaconType,omitempty"`
 }
 
 // RandomnessResponse is the HTTP message containing the current DRNG randomness.
@@ -40,3 +58,61 @@ type Randomness struct {
 	Timestamp  time.Time `json:"timestamp,omitempty"`
 	Randomness []byte    `json:"randomness,omitempty"`
 }
+
+// RandomnessHistoryResponse is the HTTP message containing the past randomness values of a DRNG instance.
+type RandomnessHistoryResponse struct {
+	InstanceID uint32       `json:"instanceID,omitempty"`
+	Randomness []Randomness `json:"randomness,omitempty"`
+	Error      string       `json:"error,omitempty"`
+}
+
+// Beacon defines the randomness of a round together with the beacon payload proving it.
+type Beacon struct {
+	InstanceID uint32    `json:"instanceID"`
+	Round      uint64    `json:"round"`
+	Timestamp  time.Time `json:"timestamp"`
+	Randomness []byte    `json:"randomness"`
+	Issuer     string    `json:"issuer"`
+	Payload    []byte    `json:"payload"`
+}
+
+// NewBeacon returns the JSON model of the given drng.Beacon.
+func NewBeacon(beacon *drng.Beacon) Beacon {
+	return Beacon{
+		InstanceID: beacon.InstanceID,
+		Round:      beacon.Round,
+		Timestamp:  beacon.Timestamp,
+		Randomness: beacon.Randomness,
+		Issuer:     beacon.Issuer.String(),
+		Payload:    beacon.Payload,
+	}
+}
+
+// ToBeacon returns the drng.Beacon of the JSON model, which can be verified with drng.VerifyBeacon.
+func (b Beacon) ToBeacon() (*drng.Beacon, error) {
+	issuer, err := ed25519.PublicKeyFromString(b.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drng.Beacon{
+		InstanceID: b.InstanceID,
+		Round:      b.Round,
+		Timestamp:  b.Timestamp,
+		Randomness: b.Randomness,
+		Issuer:     issuer,
+		Payload:    b.Payload,
+	}, nil
+}
+
+// BeaconResponse is the HTTP message containing the beacon of a round.
+type BeaconResponse struct {
+	Beacon *Beacon `json:"beacon,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// BeaconsResponse is the HTTP message containing the beacons of a range of rounds.
+type BeaconsResponse struct {
+	Beacons []Beacon `json:"beacons,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
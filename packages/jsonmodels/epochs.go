@@ -0,0 +1,43 @@
+package jsonmodels
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+)
+
+// EpochCommitment represents the JSON model of the Commitment of an epoch.
+type EpochCommitment struct {
+	EpochID            uint64 `json:"epochID"`
+	StartTime          int64  `json:"startTime"`
+	EndTime            int64  `json:"endTime"`
+	Root               string `json:"root"`
+	PreviousRoot       string `json:"previousRoot"`
+	MessagesRoot       string `json:"messagesRoot"`
+	CreatedOutputsRoot string `json:"createdOutputsRoot"`
+	SpentOutputsRoot   string `json:"spentOutputsRoot"`
+	ManaRoot           string `json:"manaRoot"`
+	MessageCount       uint32 `json:"messageCount"`
+	CreatedOutputCount uint32 `json:"createdOutputCount"`
+	SpentOutputCount   uint32 `json:"spentOutputCount"`
+	ManaDiffCount      uint32 `json:"manaDiffCount"`
+}
+
+// NewEpochCommitment returns the JSON model of the given Commitment of the epoch that lasts from startTime to endTime.
+func NewEpochCommitment(commitment *epochs.Commitment, startTime, endTime time.Time) *EpochCommitment {
+	return &EpochCommitment{
+		EpochID:            uint64(commitment.EpochID()),
+		StartTime:          startTime.Unix(),
+		EndTime:            endTime.Unix(),
+		Root:               commitment.Root().Base58(),
+		PreviousRoot:       commitment.PreviousRoot().Base58(),
+		MessagesRoot:       commitment.MessagesRoot().Base58(),
+		CreatedOutputsRoot: commitment.CreatedOutputsRoot().Base58(),
+		SpentOutputsRoot:   commitment.SpentOutputsRoot().Base58(),
+		ManaRoot:           commitment.ManaRoot().Base58(),
+		MessageCount:       commitment.MessageCount(),
+		CreatedOutputCount: commitment.CreatedOutputCount(),
+		SpentOutputCount:   commitment.SpentOutputCount(),
+		ManaDiffCount:      commitment.ManaDiffCount(),
+	}
+}
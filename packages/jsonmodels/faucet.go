@@ -6,10 +6,46 @@ type FaucetResponse struct {
 	Error string `json:"error,omitempty"`
 }
 
-// FaucetRequest contains the address to request funds from faucet.
+// FaucetRequest contains the address to request funds from faucet. If a color or an amount is given, the request
+// asks for a custom amount of tokens of that color.
 type FaucetRequest struct {
 	Address               string `json:"address"`
 	AccessManaPledgeID    string `json:"accessManaPledgeID"`
 	ConsensusManaPledgeID string `json:"consensusManaPledgeID"`
+	Color                 string `json:"color,omitempty"`
+	Amount                uint64 `json:"amount,omitempty"`
 	Nonce                 uint64 `json:"nonce"`
 }
+
+// FaucetDifficultyResponse contains the PoW difficulty currently required by the faucet.
+type FaucetDifficultyResponse struct {
+	Difficulty int    `json:"difficulty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// FaucetColorsResponse contains the tokens dispensed by the faucet.
+type FaucetColorsResponse struct {
+	Colors []FaucetColor `json:"colors"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// FaucetColor contains the limit per request and the supply of the tokens of a color dispensed by the faucet.
+type FaucetColor struct {
+	Color         string `json:"color"`
+	MaxAmount     uint64 `json:"maxAmount"`
+	SupplyAddress string `json:"supplyAddress,omitempty"`
+	Balance       uint64 `json:"balance,omitempty"`
+}
+
+// FaucetThrottleResponse contains the throttling state of the faucet.
+type FaucetThrottleResponse struct {
+	Entries []FaucetThrottleEntry `json:"entries"`
+	Error   string                `json:"error,omitempty"`
+}
+
+// FaucetThrottleEntry contains the requests counted against the quota of an address or node.
+type FaucetThrottleEntry struct {
+	Kind     string  `json:"kind"`
+	Key      string  `json:"key"`
+	Requests []int64 `json:"requests"`
+}
@@ -2,6 +2,9 @@ package jsonmodels
 
 import (
 	"time"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/finality"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 )
 
 // InfoResponse holds the response of the GET request.
@@ -34,6 +37,8 @@ type InfoResponse struct {
 	ManaDelegationAddress string `json:"manaDelegationAddress,omitempty"`
 	// ManaDecay is the decay coefficient of bm2.
 	ManaDecay float64 `json:"mana_decay"`
+	// MinimumOutputDeposit is the amount of tokens that every output has to hold at least (0 if disabled).
+	MinimumOutputDeposit uint64 `json:"minimumOutputDeposit"`
 	// Scheduler is the scheduler.
 	Scheduler Scheduler `json:"scheduler"`
 	// error of the response
@@ -67,3 +72,30 @@ type RateSetter struct {
 	Rate float64 `json:"rate"`
 	Size int     `json:"size"`
 }
+
+// FinalityPolicyResponse holds the finality policies that are active on the node.
+type FinalityPolicyResponse struct {
+	Branch  FinalityPolicy `json:"branch"`
+	Message FinalityPolicy `json:"message"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// FinalityPolicy is the JSON model of a finality.Policy.
+type FinalityPolicy struct {
+	LowThreshold      float64 `json:"lowThreshold"`
+	MediumThreshold   float64 `json:"mediumThreshold"`
+	HighThreshold     float64 `json:"highThreshold"`
+	MinLowDuration    string  `json:"minLowDuration"`
+	MinMediumDuration string  `json:"minMediumDuration"`
+}
+
+// NewFinalityPolicy returns the JSON model of the given finality.Policy.
+func NewFinalityPolicy(policy *finality.Policy) FinalityPolicy {
+	return FinalityPolicy{
+		LowThreshold:      policy.Thresholds.Low,
+		MediumThreshold:   policy.Thresholds.Medium,
+		HighThreshold:     policy.Thresholds.High,
+		MinLowDuration:    policy.MinDurations[gof.Low].String(),
+		MinMediumDuration: policy.MinDurations[gof.Medium].String(),
+	}
+}
@@ -101,6 +101,26 @@ func (o *Output) ToLedgerstateOutput() (ledgerstate.Output, error) {
 			return nil, tErr
 		}
 		return res, nil
+	case ledgerstate.HashTimeLockedOutputType:
+		s, uErr := UnmarshalHashTimeLockedOutputFromBytes(o.Output)
+		if uErr != nil {
+			return nil, uErr
+		}
+		res, tErr := s.ToLedgerStateOutput(id)
+		if tErr != nil {
+			return nil, tErr
+		}
+		return res, nil
+	case ledgerstate.FoundryOutputType:
+		s, uErr := UnmarshalFoundryOutputFromBytes(o.Output)
+		if uErr != nil {
+			return nil, uErr
+		}
+		res, tErr := s.ToLedgerStateOutput(id)
+		if tErr != nil {
+			return nil, tErr
+		}
+		return res, nil
 	default:
 		return nil, errors.Errorf("not supported output type: %d", outputType)
 	}
@@ -134,6 +154,18 @@ func MarshalOutput(output ledgerstate.Output) []byte {
 		if err != nil {
 			return nil
 		}
+	case ledgerstate.HashTimeLockedOutputType:
+		var err error
+		res, err = HashTimeLockedOutputFromLedgerstate(output)
+		if err != nil {
+			return nil
+		}
+	case ledgerstate.FoundryOutputType:
+		var err error
+		res, err = FoundryOutputFromLedgerstate(output)
+		if err != nil {
+			return nil
+		}
 	default:
 		return nil
 	}
@@ -455,6 +487,141 @@ func UnmarshalExtendedLockedOutputFromBytes(data []byte) (*ExtendedLockedOutput,
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region HashTimeLockedOutput /////////////////////////////////////////////////////////////////////////////////////////
+
+// HashTimeLockedOutput is the JSON model of a ledgerstate.HashTimeLockedOutput.
+type HashTimeLockedOutput struct {
+	Balances        map[string]uint64 `json:"balances"`
+	Address         string            `json:"address"`
+	HashLock        string            `json:"hashLock"`
+	Deadline        int64             `json:"deadline"`
+	FallbackAddress string            `json:"fallbackAddress"`
+}
+
+// ToLedgerStateOutput builds a ledgerstate.Output from HashTimeLockedOutput with the given outputID.
+func (h *HashTimeLockedOutput) ToLedgerStateOutput(id ledgerstate.OutputID) (ledgerstate.Output, error) {
+	addy, err := ledgerstate.AddressFromBase58EncodedString(h.Address)
+	if err != nil {
+		return nil, errors.Errorf("wrong address in HashTimeLockedOutput: %w", err)
+	}
+	fallbackAddy, err := ledgerstate.AddressFromBase58EncodedString(h.FallbackAddress)
+	if err != nil {
+		return nil, errors.Errorf("wrong fallback address in HashTimeLockedOutput: %w", err)
+	}
+	hashLock, err := ledgerstate.HashLockFromBase58EncodedString(h.HashLock)
+	if err != nil {
+		return nil, errors.Errorf("wrong hash lock in HashTimeLockedOutput: %w", err)
+	}
+	balances, bErr := getColoredBalances(h.Balances)
+	if bErr != nil {
+		return nil, errors.Errorf("failed to parse colored balances: %w", bErr)
+	}
+
+	res := ledgerstate.NewHashTimeLockedOutput(balances.Map(), addy, hashLock, time.Unix(h.Deadline, 0), fallbackAddy)
+	res.SetID(id)
+	return res, nil
+}
+
+// HashTimeLockedOutputFromLedgerstate creates a JSON compatible representation of a ledgerstate output.
+func HashTimeLockedOutputFromLedgerstate(output ledgerstate.Output) (*HashTimeLockedOutput, error) {
+	if output.Type() != ledgerstate.HashTimeLockedOutputType {
+		return nil, errors.Errorf("wrong output type: %s", output.Type().String())
+	}
+	castedOutput := output.(*ledgerstate.HashTimeLockedOutput)
+	return &HashTimeLockedOutput{
+		Balances:        getStringBalances(output),
+		Address:         output.Address().Base58(),
+		HashLock:        castedOutput.HashLock().Base58(),
+		Deadline:        castedOutput.Deadline().Unix(),
+		FallbackAddress: castedOutput.FallbackAddress().Base58(),
+	}, nil
+}
+
+// UnmarshalHashTimeLockedOutputFromBytes uses the json unmarshaler to unmarshal data into a HashTimeLockedOutput.
+func UnmarshalHashTimeLockedOutputFromBytes(data []byte) (*HashTimeLockedOutput, error) {
+	marshalledOutput := &HashTimeLockedOutput{}
+	err := json.Unmarshal(data, marshalledOutput)
+	if err != nil {
+		return nil, errors.Errorf("failed to unmarshal HashTimeLockedOutput: %w", err)
+	}
+	return marshalledOutput, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region FoundryOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// FoundryOutput is the JSON model of a ledgerstate.FoundryOutput.
+type FoundryOutput struct {
+	Balances          map[string]uint64 `json:"balances"`
+	AliasAddress      string            `json:"aliasAddress"`
+	TokenColor        string            `json:"tokenColor"`
+	IsOrigin          bool              `json:"isOrigin"`
+	CirculatingSupply uint64            `json:"circulatingSupply"`
+	MaximumSupply     uint64            `json:"maximumSupply"`
+
+	// marshaled to base64
+	TokenMetadata []byte `json:"tokenMetadata,omitempty"`
+}
+
+// ToLedgerStateOutput builds a ledgerstate.Output from FoundryOutput with the given outputID.
+func (f *FoundryOutput) ToLedgerStateOutput(id ledgerstate.OutputID) (ledgerstate.Output, error) {
+	balances, err := getColoredBalances(f.Balances)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse colored balances: %w", err)
+	}
+	aliasAddy, err := ledgerstate.AliasAddressFromBase58EncodedString(f.AliasAddress)
+	if err != nil {
+		return nil, errors.Errorf("wrong alias address in FoundryOutput: %w", err)
+	}
+	tokenColor, err := ledgerstate.ColorFromBase58EncodedString(f.TokenColor)
+	if err != nil {
+		return nil, errors.Errorf("wrong token color in FoundryOutput: %w", err)
+	}
+
+	res, err := ledgerstate.NewFoundryOutput(balances.Map(), aliasAddy, f.MaximumSupply, f.TokenMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if !f.IsOrigin {
+		res.SetTokenColor(tokenColor)
+	}
+	if err = res.SetCirculatingSupply(f.CirculatingSupply); err != nil {
+		return nil, err
+	}
+	res.SetID(id)
+	return res, nil
+}
+
+// FoundryOutputFromLedgerstate creates a JSON compatible representation of a ledgerstate output.
+func FoundryOutputFromLedgerstate(output ledgerstate.Output) (*FoundryOutput, error) {
+	if output.Type() != ledgerstate.FoundryOutputType {
+		return nil, errors.Errorf("wrong output type: %s", output.Type().String())
+	}
+	castedOutput := output.(*ledgerstate.FoundryOutput)
+	return &FoundryOutput{
+		Balances:          getStringBalances(output),
+		AliasAddress:      castedOutput.AliasAddress().Base58(),
+		TokenColor:        castedOutput.TokenColor().Base58(),
+		IsOrigin:          castedOutput.IsOrigin(),
+		CirculatingSupply: castedOutput.CirculatingSupply(),
+		MaximumSupply:     castedOutput.MaximumSupply(),
+		TokenMetadata:     castedOutput.TokenMetadata(),
+	}, nil
+}
+
+// UnmarshalFoundryOutputFromBytes uses the json unmarshaler to unmarshal data into a FoundryOutput.
+func UnmarshalFoundryOutputFromBytes(data []byte) (*FoundryOutput, error) {
+	marshalledOutput := &FoundryOutput{}
+	err := json.Unmarshal(data, marshalledOutput)
+	if err != nil {
+		return nil, errors.Errorf("failed to unmarshal FoundryOutput: %w", err)
+	}
+	return marshalledOutput, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region OutputID /////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // OutputID represents the JSON model of a ledgerstate.OutputID.
@@ -533,6 +700,7 @@ type Branch struct {
 	ConflictIDs     []string            `json:"conflictIDs,omitempty"`
 	GradeOfFinality gof.GradeOfFinality `json:"gradeOfFinality"`
 	ApprovalWeight  float64             `json:"approvalWeight"`
+	Liked           bool                `json:"liked"`
 }
 
 // NewBranch returns a Branch from the given ledgerstate.Branch.
@@ -616,6 +784,7 @@ func NewConflict(conflictID ledgerstate.ConflictID, branchIDs []ledgerstate.Bran
 type Transaction struct {
 	Version           ledgerstate.TransactionEssenceVersion `json:"version"`
 	Timestamp         int64                                 `json:"timestamp"`
+	ValidUntil        int64                                 `json:"validUntil,omitempty"`
 	AccessPledgeID    string                                `json:"accessPledgeID"`
 	ConsensusPledgeID string                                `json:"consensusPledgeID"`
 	Inputs            []*Input                              `json:"inputs"`
@@ -646,9 +815,15 @@ func NewTransaction(transaction *ledgerstate.Transaction) *Transaction {
 		dataPayload = transaction.Essence().Payload().Bytes()
 	}
 
+	var validUntil int64
+	if transaction.Essence().Version() == ledgerstate.ExpiringTransactionEssenceVersion {
+		validUntil = transaction.Essence().ValidUntil().Unix()
+	}
+
 	return &Transaction{
 		Version:           transaction.Essence().Version(),
 		Timestamp:         transaction.Essence().Timestamp().Unix(),
+		ValidUntil:        validUntil,
 		AccessPledgeID:    base58.Encode(transaction.Essence().AccessPledgeID().Bytes()),
 		ConsensusPledgeID: base58.Encode(transaction.Essence().ConsensusPledgeID().Bytes()),
 		Inputs:            inputs,
@@ -703,6 +878,7 @@ type UnlockBlock struct {
 	SignatureType   ledgerstate.SignatureType `json:"signatureType,omitempty"`
 	PublicKey       string                    `json:"publicKey,omitempty"`
 	Signature       string                    `json:"signature,omitempty"`
+	Preimage        string                    `json:"preimage,omitempty"`
 }
 
 // NewUnlockBlock returns an UnlockBlock from the given ledgerstate.UnlockBlock.
@@ -714,25 +890,34 @@ func NewUnlockBlock(unlockBlock ledgerstate.UnlockBlock) *UnlockBlock {
 	switch unlockBlock.Type() {
 	case ledgerstate.SignatureUnlockBlockType:
 		signature, _, _ := ledgerstate.SignatureFromBytes(unlockBlock.Bytes())
-		result.SignatureType = signature.Type()
-		switch signature.Type() {
-		case ledgerstate.ED25519SignatureType:
-			signature, _, _ := ledgerstate.ED25519SignatureFromBytes(signature.Bytes())
-			result.PublicKey = signature.PublicKey.String()
-			result.Signature = signature.Signature.String()
-
-		case ledgerstate.BLSSignatureType:
-			signature, _, _ := ledgerstate.BLSSignatureFromBytes(signature.Bytes())
-			result.Signature = signature.Signature.String()
-		}
+		result.setSignature(signature)
 	case ledgerstate.ReferenceUnlockBlockType:
 		referenceUnlockBlock, _, _ := ledgerstate.ReferenceUnlockBlockFromBytes(unlockBlock.Bytes())
 		result.ReferencedIndex = referenceUnlockBlock.ReferencedIndex()
+	case ledgerstate.HashLockUnlockBlockType:
+		hashLockUnlockBlock, _, _ := ledgerstate.HashLockUnlockBlockFromBytes(unlockBlock.Bytes())
+		result.setSignature(hashLockUnlockBlock.Signature())
+		result.Preimage = hashLockUnlockBlock.Preimage().Base58()
 	}
 
 	return result
 }
 
+// setSignature fills the signature related fields of the UnlockBlock.
+func (u *UnlockBlock) setSignature(signature ledgerstate.Signature) {
+	u.SignatureType = signature.Type()
+	switch signature.Type() {
+	case ledgerstate.ED25519SignatureType:
+		signature, _, _ := ledgerstate.ED25519SignatureFromBytes(signature.Bytes())
+		u.PublicKey = signature.PublicKey.String()
+		u.Signature = signature.Signature.String()
+
+	case ledgerstate.BLSSignatureType:
+		signature, _, _ := ledgerstate.BLSSignatureFromBytes(signature.Bytes())
+		u.Signature = signature.Signature.String()
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region TransactionMetadata ///////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,7 @@
 package jsonmodels
 
 import (
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
@@ -174,6 +175,65 @@ func NewGetTransactionAttachmentsResponse(transactionID ledgerstate.TransactionI
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetTokenSupplyResponse ///////////////////////////////////////////////////////////////////////////////////////
+
+// GetTokenSupplyResponse represents the JSON model of a response from the GetTokenSupply endpoint.
+type GetTokenSupplyResponse struct {
+	Color             string  `json:"color"`
+	CirculatingSupply uint64  `json:"circulatingSupply"`
+	MaximumSupply     uint64  `json:"maximumSupply"`
+	TokenMetadata     []byte  `json:"tokenMetadata,omitempty"`
+	AliasAddress      string  `json:"aliasAddress"`
+	Foundry           *Output `json:"foundry"`
+}
+
+// NewGetTokenSupplyResponse returns a GetTokenSupplyResponse from the given FoundryOutput.
+func NewGetTokenSupplyResponse(foundry *ledgerstate.FoundryOutput) *GetTokenSupplyResponse {
+	return &GetTokenSupplyResponse{
+		Color:             foundry.TokenColor().Base58(),
+		CirculatingSupply: foundry.CirculatingSupply(),
+		MaximumSupply:     foundry.MaximumSupply(),
+		TokenMetadata:     foundry.TokenMetadata(),
+		AliasAddress:      foundry.AliasAddress().Base58(),
+		Foundry:           NewOutput(foundry),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetInclusionProofResponse ////////////////////////////////////////////////////////////////////////////////////
+
+// GetInclusionProofResponse represents the JSON model of a response from the GetTransactionInclusionProof and
+// GetMessageInclusionProof endpoints.
+type GetInclusionProofResponse struct {
+	Proof                 string  `json:"proof"`
+	MessageID             string  `json:"messageID"`
+	TransactionID         string  `json:"transactionID,omitempty"`
+	AnchorMessageID       string  `json:"anchorMessageID"`
+	AnchorGradeOfFinality uint8   `json:"anchorGradeOfFinality"`
+	AnchorApprovalWeight  float64 `json:"anchorApprovalWeight"`
+	PathLength            int     `json:"pathLength"`
+}
+
+// NewGetInclusionProofResponse returns a GetInclusionProofResponse from the given Proof.
+func NewGetInclusionProofResponse(proof *inclusionproof.Proof) *GetInclusionProofResponse {
+	response := &GetInclusionProofResponse{
+		Proof:                 proof.Base58(),
+		MessageID:             proof.Message().ID().Base58(),
+		AnchorMessageID:       proof.Anchor().ID().Base58(),
+		AnchorGradeOfFinality: uint8(proof.AnchorGradeOfFinality()),
+		AnchorApprovalWeight:  proof.AnchorApprovalWeight(),
+		PathLength:            len(proof.Path()),
+	}
+	if transactionID, exists := proof.TransactionID(); exists {
+		response.TransactionID = transactionID.Base58()
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostPayloadRequest ///////////////////////////////////////////////////////////////////////////////////////////
 
 // PostPayloadRequest represents the JSON model of a PostPayload request.
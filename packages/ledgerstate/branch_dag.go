@@ -375,6 +375,22 @@ func (b *BranchDAG) Prune() (err error) {
 	return
 }
 
+// ExportCachedObjects adds the objects that are kept in the caches of the BranchDAG to the given CachedObjects.
+func (b *BranchDAG) ExportCachedObjects(cachedObjects *database.CachedObjects) (err error) {
+	for prefix, storage := range map[byte]*objectstorage.ObjectStorage{
+		PrefixBranchStorage:         b.branchStorage,
+		PrefixChildBranchStorage:    b.childBranchStorage,
+		PrefixConflictStorage:       b.conflictStorage,
+		PrefixConflictMemberStorage: b.conflictMemberStorage,
+	} {
+		if err = database.ExportCachedObjects(cachedObjects, []byte{database.PrefixLedgerState, prefix}, storage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Shutdown shuts down the BranchDAG and persists its state.
 func (b *BranchDAG) Shutdown() {
 	b.shutdownOnce.Do(func() {
@@ -2,10 +2,13 @@ package ledgerstate
 
 import (
 	"testing"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/database"
 
+	"github.com/iotaledger/hive.go/byteutils"
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -346,6 +349,39 @@ func TestBranchDAG_normalizeBranches(t *testing.T) {
 	}
 }
 
+func TestBranchDAG_ExportCachedObjects(t *testing.T) {
+	store := mapdb.NewMapDB()
+	branchDAG := NewBranchDAG(store, database.NewCacheTimeProvider(time.Minute))
+
+	// a branch that is stored in the database but deleted in the cache
+	deletedBranchID := BranchID{42}
+	require.NoError(t, store.WithRealm([]byte{database.PrefixLedgerState, PrefixBranchStorage}).Set(deletedBranchID.Bytes(), []byte("stale")))
+	branchDAG.branchStorage.Delete(deletedBranchID.Bytes())
+
+	// the checkpoint is a copy of the database that is completed with the cached objects
+	checkpoint := mapdb.NewMapDB()
+	_, err := database.CopyStore(checkpoint, store, kvstore.EmptyPrefix)
+	require.NoError(t, err)
+	cachedObjects := database.NewCachedObjects(store)
+	require.NoError(t, branchDAG.ExportCachedObjects(cachedObjects))
+	require.NoError(t, cachedObjects.WriteTo(checkpoint))
+	branchDAG.Shutdown()
+
+	persistedEntries := make(map[string][]byte)
+	require.NoError(t, store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		persistedEntries[string(key)] = value
+		return true
+	}))
+	checkpointEntries := make(map[string][]byte)
+	require.NoError(t, checkpoint.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		checkpointEntries[string(key)] = value
+		return true
+	}))
+	assert.NotEmpty(t, checkpointEntries)
+	assert.Equal(t, persistedEntries, checkpointEntries)
+	assert.NotContains(t, checkpointEntries, string(byteutils.ConcatBytes([]byte{database.PrefixLedgerState, PrefixBranchStorage}, deletedBranchID.Bytes())))
+}
+
 func TestBranchDAG_ConflictMembers(t *testing.T) {
 	branchDAG := NewBranchDAG(mapdb.NewMapDB(), database.NewCacheTimeProvider(0))
 	err := branchDAG.Prune()
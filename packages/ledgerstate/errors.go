@@ -13,4 +13,14 @@ var (
 
 	// ErrInvalidStateTransition is returned if there is an invalid state transition in the ledger state.
 	ErrInvalidStateTransition = errors.New("invalid state transition")
+
+	// ErrTransactionExpired is returned if a Transaction is attached after the end of its validity window.
+	ErrTransactionExpired = errors.New("transaction expired")
+
+	// ErrDustOutput is returned if a Transaction creates an Output that holds less tokens than the minimum deposit.
+	ErrDustOutput = errors.New("dust output")
+
+	// ErrInconsistentLedger is returned if the entities of the ledger state that are stored in the database do not
+	// reference each other correctly.
+	ErrInconsistentLedger = errors.New("inconsistent ledger")
 )
@@ -38,6 +38,12 @@ const (
 
 	// PrefixAddressOutputMappingStorage defines the storage prefix for the AddressOutputMapping object storage.
 	PrefixAddressOutputMappingStorage
+
+	// PrefixTokenFoundryMappingStorage defines the storage prefix for the TokenFoundryMapping object storage.
+	PrefixTokenFoundryMappingStorage
+
+	// PrefixProtocolParameters defines the storage prefix for the ProtocolParameters of the ledger.
+	PrefixProtocolParameters
 )
 
 // block of default cache time.
@@ -80,6 +86,9 @@ type storageOptions struct {
 
 	// addressOutputMappingStorageOptions contains a list of default settings for the AddressOutputMapping object storage.
 	addressOutputMappingStorageOptions []objectstorage.Option
+
+	// tokenFoundryMappingStorageOptions contains a list of default settings for the TokenFoundryMapping object storage.
+	tokenFoundryMappingStorageOptions []objectstorage.Option
 }
 
 func buildObjectStorageOptions(cacheProvider *database.CacheTimeProvider) *storageOptions {
@@ -144,5 +153,12 @@ func buildObjectStorageOptions(cacheProvider *database.CacheTimeProvider) *stora
 		objectstorage.StoreOnCreation(true),
 	}
 
+	options.tokenFoundryMappingStorageOptions = []objectstorage.Option{
+		cacheProvider.CacheTime(addressCacheTime),
+		objectstorage.PartitionKey(ColorLength, OutputIDLength),
+		objectstorage.LeakDetectionEnabled(false),
+		objectstorage.StoreOnCreation(true),
+	}
+
 	return &options
 }
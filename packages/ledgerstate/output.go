@@ -2,6 +2,7 @@ package ledgerstate
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"sort"
@@ -61,6 +62,14 @@ const (
 
 	// ExtendedLockedOutputType represents an Output which extends SigLockedColoredOutput with alias locking and fallback.
 	ExtendedLockedOutputType
+
+	// HashTimeLockedOutputType represents an Output that can be claimed by revealing the preimage of a hash before a
+	// deadline and that falls back to its sender afterwards.
+	HashTimeLockedOutputType
+
+	// FoundryOutputType represents an Output that is controlled by an alias and that keeps track of the supply of a
+	// native token.
+	FoundryOutputType
 )
 
 // String returns a human readable representation of the OutputType.
@@ -70,6 +79,8 @@ func (o OutputType) String() string {
 		"SigLockedColoredOutputType",
 		"AliasOutputType",
 		"ExtendedLockedOutputType",
+		"HashTimeLockedOutputType",
+		"FoundryOutputType",
 	}[o]
 }
 
@@ -80,6 +91,8 @@ func OutputTypeFromString(ot string) (OutputType, error) {
 		"SigLockedColoredOutputType": SigLockedColoredOutputType,
 		"AliasOutputType":            AliasOutputType,
 		"ExtendedLockedOutputType":   ExtendedLockedOutputType,
+		"HashTimeLockedOutputType":   HashTimeLockedOutputType,
+		"FoundryOutputType":          FoundryOutputType,
 	}[ot]
 	if !ok {
 		return res, errors.New(fmt.Sprintf("unsupported output type: %s", ot))
@@ -281,6 +294,16 @@ func OutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output Output,
 			err = errors.Errorf("failed to parse ExtendedOutput: %w", err)
 			return
 		}
+	case HashTimeLockedOutputType:
+		if output, err = HashTimeLockedOutputFromMarshalUtil(marshalUtil); err != nil {
+			err = errors.Errorf("failed to parse HashTimeLockedOutput: %w", err)
+			return
+		}
+	case FoundryOutputType:
+		if output, err = FoundryOutputFromMarshalUtil(marshalUtil); err != nil {
+			err = errors.Errorf("failed to parse FoundryOutput: %w", err)
+			return
+		}
 
 	default:
 		err = errors.Errorf("unsupported OutputType (%X): %w", outputType, cerrors.ErrParseBytesFailed)
@@ -2218,6 +2241,802 @@ var _ Output = &ExtendedLockedOutput{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region HashTimeLockedOutput /////////////////////////////////////////////////////////////////////////////////////////
+
+// PreimageLength contains the amount of bytes of the secret that unlocks a HashTimeLockedOutput.
+const PreimageLength = 32
+
+// Preimage is the secret whose SHA-256 hash is committed to in a HashTimeLockedOutput.
+type Preimage [PreimageLength]byte
+
+// PreimageFromBytes unmarshals a Preimage from a sequence of bytes.
+func PreimageFromBytes(bytes []byte) (preimage Preimage, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if preimage, err = PreimageFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Preimage from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// PreimageFromBase58EncodedString creates a Preimage from a base58 encoded string.
+func PreimageFromBase58EncodedString(base58String string) (preimage Preimage, err error) {
+	decodedBytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = errors.Errorf("error while decoding base58 encoded Preimage (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+		return
+	}
+
+	if preimage, _, err = PreimageFromBytes(decodedBytes); err != nil {
+		err = errors.Errorf("failed to parse Preimage from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// PreimageFromMarshalUtil unmarshals a Preimage using a MarshalUtil (for easier unmarshaling).
+func PreimageFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (preimage Preimage, err error) {
+	preimageBytes, err := marshalUtil.ReadBytes(PreimageLength)
+	if err != nil {
+		err = errors.Errorf("failed to parse Preimage (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(preimage[:], preimageBytes)
+
+	return
+}
+
+// HashLock returns the HashLock that is unlocked by the Preimage.
+func (p Preimage) HashLock() HashLock {
+	return sha256.Sum256(p[:])
+}
+
+// Bytes returns a marshaled version of the Preimage.
+func (p Preimage) Bytes() []byte {
+	return p[:]
+}
+
+// Base58 returns a base58 encoded version of the Preimage.
+func (p Preimage) Base58() string {
+	return base58.Encode(p.Bytes())
+}
+
+// String returns a human readable version of the Preimage.
+func (p Preimage) String() string {
+	return "Preimage(" + p.Base58() + ")"
+}
+
+// HashLockLength contains the amount of bytes of a marshaled HashLock.
+const HashLockLength = sha256.Size
+
+// HashLock is the SHA-256 hash of a Preimage. SHA-256 is used so that the same HashLock can be used on other ledgers
+// that take part in an atomic swap.
+type HashLock [HashLockLength]byte
+
+// HashLockFromBytes unmarshals a HashLock from a sequence of bytes.
+func HashLockFromBytes(bytes []byte) (hashLock HashLock, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if hashLock, err = HashLockFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse HashLock from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// HashLockFromBase58EncodedString creates a HashLock from a base58 encoded string.
+func HashLockFromBase58EncodedString(base58String string) (hashLock HashLock, err error) {
+	decodedBytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = errors.Errorf("error while decoding base58 encoded HashLock (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+		return
+	}
+
+	if hashLock, _, err = HashLockFromBytes(decodedBytes); err != nil {
+		err = errors.Errorf("failed to parse HashLock from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// HashLockFromMarshalUtil unmarshals a HashLock using a MarshalUtil (for easier unmarshaling).
+func HashLockFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (hashLock HashLock, err error) {
+	hashLockBytes, err := marshalUtil.ReadBytes(HashLockLength)
+	if err != nil {
+		err = errors.Errorf("failed to parse HashLock (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(hashLock[:], hashLockBytes)
+
+	return
+}
+
+// UnlockedBy returns true if the given Preimage hashes to the HashLock.
+func (h HashLock) UnlockedBy(preimage Preimage) bool {
+	return preimage.HashLock() == h
+}
+
+// Bytes returns a marshaled version of the HashLock.
+func (h HashLock) Bytes() []byte {
+	return h[:]
+}
+
+// Base58 returns a base58 encoded version of the HashLock.
+func (h HashLock) Base58() string {
+	return base58.Encode(h.Bytes())
+}
+
+// String returns a human readable version of the HashLock.
+func (h HashLock) String() string {
+	return "HashLock(" + h.Base58() + ")"
+}
+
+// HashTimeLockedOutput is an Output that can be unlocked by its address with a HashLockUnlockBlock revealing the
+// Preimage of its HashLock until the deadline. After the deadline only the fallback address can unlock it (with a
+// regular SignatureUnlockBlock), which allows the sender to take back the funds if the swap did not happen.
+type HashTimeLockedOutput struct {
+	id              OutputID
+	idMutex         sync.RWMutex
+	balances        *ColoredBalances
+	address         Address
+	hashLock        HashLock
+	deadline        time.Time
+	fallbackAddress Address
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewHashTimeLockedOutput is the constructor for a HashTimeLockedOutput.
+func NewHashTimeLockedOutput(balances map[Color]uint64, address Address, hashLock HashLock, deadline time.Time, fallbackAddress Address) *HashTimeLockedOutput {
+	return &HashTimeLockedOutput{
+		balances:        NewColoredBalances(balances),
+		address:         address.Clone(),
+		hashLock:        hashLock,
+		deadline:        deadline,
+		fallbackAddress: fallbackAddress.Clone(),
+	}
+}
+
+// HashTimeLockedOutputFromBytes unmarshals a HashTimeLockedOutput from a sequence of bytes.
+func HashTimeLockedOutputFromBytes(data []byte) (output *HashTimeLockedOutput, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(data)
+	if output, err = HashTimeLockedOutputFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse HashTimeLockedOutput from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// HashTimeLockedOutputFromMarshalUtil unmarshals a HashTimeLockedOutput using a MarshalUtil (for easier unmarshaling).
+func HashTimeLockedOutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output *HashTimeLockedOutput, err error) {
+	outputType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = errors.Errorf("failed to parse OutputType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if OutputType(outputType) != HashTimeLockedOutputType {
+		err = errors.Errorf("invalid OutputType (%X): %w", outputType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	output = &HashTimeLockedOutput{}
+	if output.balances, err = ColoredBalancesFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse ColoredBalances: %w", err)
+		return
+	}
+	if output.address, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Address (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.hashLock, err = HashLockFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse HashLock: %w", err)
+		return
+	}
+	if output.deadline, err = marshalUtil.ReadTime(); err != nil {
+		err = errors.Errorf("failed to parse deadline (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.fallbackAddress, err = AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse fallbackAddress (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	return output, nil
+}
+
+// ID returns the identifier of the Output that is used to address the Output in the UTXODAG.
+func (o *HashTimeLockedOutput) ID() OutputID {
+	o.idMutex.RLock()
+	defer o.idMutex.RUnlock()
+
+	return o.id
+}
+
+// SetID allows to set the identifier of the Output. We offer a setter for the property since Outputs that are
+// created to become part of a transaction usually do not have an identifier, yet as their identifier depends on
+// the TransactionID that is only determinable after the Transaction has been fully constructed. The ID is therefore
+// only accessed when the Output is supposed to be persisted by the node.
+func (o *HashTimeLockedOutput) SetID(outputID OutputID) Output {
+	o.idMutex.Lock()
+	defer o.idMutex.Unlock()
+
+	o.id = outputID
+
+	return o
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (o *HashTimeLockedOutput) Type() OutputType {
+	return HashTimeLockedOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (o *HashTimeLockedOutput) Balances() *ColoredBalances {
+	return o.balances
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+func (o *HashTimeLockedOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inputs []Output) (unlockValid bool, err error) {
+	if o.Expired(tx.Essence().Timestamp()) {
+		// after the deadline only the fallback address can take back the funds
+		blk, isSignature := unlockBlock.(*SignatureUnlockBlock)
+		if !isSignature {
+			return false, errors.Errorf("hashTimeLockedOutput: %s can't be used after the deadline", unlockBlock.Type().String())
+		}
+
+		return blk.AddressSignatureValid(o.fallbackAddress, tx.Essence().Bytes()), nil
+	}
+
+	blk, isHashLock := unlockBlock.(*HashLockUnlockBlock)
+	if !isHashLock {
+		return false, errors.Errorf("hashTimeLockedOutput: %s can't be used before the deadline", unlockBlock.Type().String())
+	}
+
+	return o.hashLock.UnlockedBy(blk.Preimage()) && blk.AddressSignatureValid(o.address, tx.Essence().Bytes()), nil
+}
+
+// Address returns the Address that can claim the Output by revealing the Preimage.
+func (o *HashTimeLockedOutput) Address() Address {
+	return o.address
+}
+
+// HashLock returns the hash of the Preimage that needs to be revealed to claim the Output.
+func (o *HashTimeLockedOutput) HashLock() HashLock {
+	return o.hashLock
+}
+
+// Deadline returns the time until which the Output can be claimed by revealing the Preimage.
+func (o *HashTimeLockedOutput) Deadline() time.Time {
+	return o.deadline
+}
+
+// FallbackAddress returns the Address that can take back the funds after the deadline.
+func (o *HashTimeLockedOutput) FallbackAddress() Address {
+	return o.fallbackAddress
+}
+
+// Expired returns true if the deadline of the Output has passed at the given moment.
+func (o *HashTimeLockedOutput) Expired(nowis time.Time) bool {
+	return nowis.After(o.deadline)
+}
+
+// UnlockAddressNow return unlock address which is valid for the specific moment of time.
+func (o *HashTimeLockedOutput) UnlockAddressNow(nowis time.Time) Address {
+	if o.Expired(nowis) {
+		return o.fallbackAddress
+	}
+	return o.address
+}
+
+// Input returns an Input that references the Output.
+func (o *HashTimeLockedOutput) Input() Input {
+	if o.ID() == EmptyOutputID {
+		panic("HashTimeLockedOutput: Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(o.ID())
+}
+
+// Clone creates a copy of the Output.
+func (o *HashTimeLockedOutput) Clone() Output {
+	ret := &HashTimeLockedOutput{
+		balances:        o.balances.Clone(),
+		address:         o.address.Clone(),
+		hashLock:        o.hashLock,
+		deadline:        o.deadline,
+		fallbackAddress: o.fallbackAddress.Clone(),
+	}
+	copy(ret.id[:], o.id[:])
+
+	return ret
+}
+
+// UpdateMintingColor replaces the ColorMint in the balances of the Output with the hash of the OutputID. It returns a
+// copy of the original Output with the modified balances.
+func (o *HashTimeLockedOutput) UpdateMintingColor() Output {
+	coloredBalances := o.Balances().Map()
+	if mintedCoins, mintedCoinsExist := coloredBalances[ColorMint]; mintedCoinsExist {
+		delete(coloredBalances, ColorMint)
+		coloredBalances[Color(blake2b.Sum256(o.ID().Bytes()))] = mintedCoins
+	}
+	updatedOutput := NewHashTimeLockedOutput(coloredBalances, o.address, o.hashLock, o.deadline, o.fallbackAddress)
+	updatedOutput.SetID(o.ID())
+
+	return updatedOutput
+}
+
+// Bytes returns a marshaled version of the Output.
+func (o *HashTimeLockedOutput) Bytes() []byte {
+	return o.ObjectStorageValue()
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (o *HashTimeLockedOutput) Update(objectstorage.StorableObject) {
+	panic("HashTimeLockedOutput: updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (o *HashTimeLockedOutput) ObjectStorageKey() []byte {
+	return o.id.Bytes()
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (o *HashTimeLockedOutput) ObjectStorageValue() []byte {
+	return marshalutil.New().
+		WriteByte(byte(HashTimeLockedOutputType)).
+		WriteBytes(o.balances.Bytes()).
+		WriteBytes(o.address.Bytes()).
+		WriteBytes(o.hashLock.Bytes()).
+		WriteTime(o.deadline).
+		WriteBytes(o.fallbackAddress.Bytes()).
+		Bytes()
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (o *HashTimeLockedOutput) Compare(other Output) int {
+	return bytes.Compare(o.Bytes(), other.Bytes())
+}
+
+// String returns a human readable version of the Output.
+func (o *HashTimeLockedOutput) String() string {
+	return stringify.Struct("HashTimeLockedOutput",
+		stringify.StructField("id", o.ID()),
+		stringify.StructField("address", o.address),
+		stringify.StructField("balances", o.balances),
+		stringify.StructField("hashLock", o.hashLock),
+		stringify.StructField("deadline", o.deadline),
+		stringify.StructField("fallbackAddress", o.fallbackAddress),
+	)
+}
+
+// code contract (make sure the type implements all required methods).
+var _ Output = &HashTimeLockedOutput{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region FoundryOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MaxTokenMetadataSize defines the maximum size of the metadata that describes the token of a FoundryOutput.
+const MaxTokenMetadataSize = MaxOutputPayloadSize
+
+// FoundryOutput is an Output that is controlled by an AliasOutput and that keeps track of the supply of a native token.
+// The token gets a unique Color that is derived from the OutputID of the origin FoundryOutput. Every time the
+// controlling alias performs a state transition, the FoundryOutput can be transitioned as well to mint new tokens or
+// to provably burn existing ones. The ledger enforces that the tokens created or destroyed by a Transaction match the
+// change of the circulating supply and that the circulating supply never exceeds the maximum supply.
+type FoundryOutput struct {
+	id                OutputID
+	idMutex           sync.RWMutex
+	balances          *ColoredBalances
+	aliasAddress      *AliasAddress
+	tokenColor        Color
+	circulatingSupply uint64
+	maximumSupply     uint64
+	tokenMetadata     []byte
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewFoundryOutput is the constructor of an origin FoundryOutput that is controlled by the alias with the given
+// AliasAddress. The Color of the token is assigned once the FoundryOutput is booked.
+func NewFoundryOutput(balances map[Color]uint64, aliasAddress *AliasAddress, maximumSupply uint64, tokenMetadata []byte) (*FoundryOutput, error) {
+	output := &FoundryOutput{
+		balances:      NewColoredBalances(balances),
+		aliasAddress:  aliasAddress.Clone().(*AliasAddress),
+		maximumSupply: maximumSupply,
+		tokenMetadata: make([]byte, len(tokenMetadata)),
+	}
+	copy(output.tokenMetadata, tokenMetadata)
+	if err := output.checkBasicValidity(); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FoundryOutputFromBytes unmarshals a FoundryOutput from a sequence of bytes.
+func FoundryOutputFromBytes(data []byte) (output *FoundryOutput, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(data)
+	if output, err = FoundryOutputFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse FoundryOutput from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FoundryOutputFromMarshalUtil unmarshals a FoundryOutput using a MarshalUtil (for easier unmarshaling).
+func FoundryOutputFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (output *FoundryOutput, err error) {
+	outputType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = errors.Errorf("failed to parse OutputType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if OutputType(outputType) != FoundryOutputType {
+		err = errors.Errorf("invalid OutputType (%X): %w", outputType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	output = &FoundryOutput{}
+	if output.balances, err = ColoredBalancesFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse ColoredBalances: %w", err)
+		return
+	}
+	if output.aliasAddress, err = AliasAddressFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse AliasAddress (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.tokenColor, err = ColorFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse token Color: %w", err)
+		return
+	}
+	if output.circulatingSupply, err = marshalUtil.ReadUint64(); err != nil {
+		err = errors.Errorf("failed to parse circulating supply (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.maximumSupply, err = marshalUtil.ReadUint64(); err != nil {
+		err = errors.Errorf("failed to parse maximum supply (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	tokenMetadataSize, err := marshalUtil.ReadUint16()
+	if err != nil {
+		err = errors.Errorf("failed to parse token metadata size (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if output.tokenMetadata, err = marshalUtil.ReadBytes(int(tokenMetadataSize)); err != nil {
+		err = errors.Errorf("failed to parse token metadata (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if err = output.checkBasicValidity(); err != nil {
+		return nil, errors.Errorf("%v: %w", err, cerrors.ErrParseBytesFailed)
+	}
+
+	return output, nil
+}
+
+// NewFoundryOutputNext creates the chained FoundryOutput that continues the given one in a Transaction.
+func (f *FoundryOutput) NewFoundryOutputNext() *FoundryOutput {
+	next := f.clone()
+	next.tokenColor = f.TokenColor()
+	next.SetID(EmptyOutputID)
+
+	return next
+}
+
+// ID returns the identifier of the Output that is used to address the Output in the UTXODAG.
+func (f *FoundryOutput) ID() OutputID {
+	f.idMutex.RLock()
+	defer f.idMutex.RUnlock()
+
+	return f.id
+}
+
+// SetID allows to set the identifier of the Output. We offer a setter for the property since Outputs that are
+// created to become part of a transaction usually do not have an identifier, yet as their identifier depends on
+// the TransactionID that is only determinable after the Transaction has been fully constructed. The ID is therefore
+// only accessed when the Output is supposed to be persisted by the node.
+func (f *FoundryOutput) SetID(outputID OutputID) Output {
+	f.idMutex.Lock()
+	defer f.idMutex.Unlock()
+
+	f.id = outputID
+
+	return f
+}
+
+// Type returns the type of the Output which allows us to generically handle Outputs of different types.
+func (f *FoundryOutput) Type() OutputType {
+	return FoundryOutputType
+}
+
+// Balances returns the funds that are associated with the Output.
+func (f *FoundryOutput) Balances() *ColoredBalances {
+	return f.balances
+}
+
+// SetBalances sets the IOTA deposit of the Output.
+func (f *FoundryOutput) SetBalances(balances map[Color]uint64) error {
+	if !onlyIOTABalances(balances) || !IsAboveDustThreshold(balances) {
+		return errors.Errorf("foundryOutput: balances have to consist of at least %d IOTA and no other colors", DustThresholdAliasOutputIOTA)
+	}
+	f.balances = NewColoredBalances(balances)
+
+	return nil
+}
+
+// Address returns the AliasAddress of the alias that controls the Output.
+func (f *FoundryOutput) Address() Address {
+	return f.aliasAddress
+}
+
+// AliasAddress returns the AliasAddress of the alias that controls the Output.
+func (f *FoundryOutput) AliasAddress() *AliasAddress {
+	return f.aliasAddress
+}
+
+// IsOrigin returns true if the Output creates a new token whose Color has not been assigned, yet.
+func (f *FoundryOutput) IsOrigin() bool {
+	return f.tokenColor == ColorIOTA
+}
+
+// SetTokenColor sets the Color of the token whose supply is controlled by the Output.
+func (f *FoundryOutput) SetTokenColor(tokenColor Color) {
+	f.tokenColor = tokenColor
+}
+
+// TokenColor returns the Color of the token whose supply is controlled by the Output. The Color of an origin
+// FoundryOutput is derived from its OutputID.
+func (f *FoundryOutput) TokenColor() Color {
+	if f.IsOrigin() {
+		return blake2b.Sum256(f.ID().Bytes())
+	}
+
+	return f.tokenColor
+}
+
+// CirculatingSupply returns the amount of tokens that have been minted and not been burned, yet.
+func (f *FoundryOutput) CirculatingSupply() uint64 {
+	return f.circulatingSupply
+}
+
+// SetCirculatingSupply sets the circulating supply of the token. The difference to the circulating supply of the
+// consumed FoundryOutput has to be minted or burned by the same Transaction.
+func (f *FoundryOutput) SetCirculatingSupply(circulatingSupply uint64) error {
+	if circulatingSupply > f.maximumSupply {
+		return errors.Errorf("foundryOutput: circulating supply (%d) exceeds the maximum supply (%d)", circulatingSupply, f.maximumSupply)
+	}
+	f.circulatingSupply = circulatingSupply
+
+	return nil
+}
+
+// MaximumSupply returns the amount of tokens that can be in circulation at most.
+func (f *FoundryOutput) MaximumSupply() uint64 {
+	return f.maximumSupply
+}
+
+// TokenMetadata returns the immutable metadata that describes the token.
+func (f *FoundryOutput) TokenMetadata() []byte {
+	return f.tokenMetadata
+}
+
+// UnlockValid determines if the given Transaction and the corresponding UnlockBlock are allowed to spend the Output.
+// A FoundryOutput can only be unlocked by an AliasUnlockBlock that references its controlling alias, which has to be
+// unlocked for a state transition.
+func (f *FoundryOutput) UnlockValid(tx *Transaction, unlockBlock UnlockBlock, inputs []Output) (unlockValid bool, err error) {
+	blk, isAliasUnlockBlock := unlockBlock.(*AliasUnlockBlock)
+	if !isAliasUnlockBlock {
+		return false, errors.Errorf("foundryOutput: %s can't be used, the Output has to be unlocked by its alias", unlockBlock.Type().String())
+	}
+	if int(blk.AliasInputIndex()) >= len(inputs) {
+		return false, errors.New("foundryOutput: wrong alias reference index")
+	}
+	refAlias, isAlias := inputs[blk.AliasInputIndex()].(*AliasOutput)
+	if !isAlias {
+		return false, errors.New("foundryOutput: the referenced output is not of AliasOutput type")
+	}
+	if !refAlias.GetAliasAddress().Equals(f.aliasAddress) {
+		return false, errors.New("foundryOutput: wrong alias reference address")
+	}
+	if refAlias.hasToBeUnlockedForGovernanceUpdate(tx) {
+		return false, errors.New("foundryOutput: the referenced alias is not unlocked for a state transition")
+	}
+
+	chained, err := f.findChainedOutputAndCheckFork(tx)
+	if err != nil {
+		return false, err
+	}
+	if chained == nil {
+		// the foundry is destroyed, which is only possible once all of its tokens have been burned
+		if f.circulatingSupply != 0 {
+			return false, errors.Errorf("foundryOutput: can't destroy foundry with a circulating supply of %d", f.circulatingSupply)
+		}
+
+		return true, nil
+	}
+	if err = f.validateTransition(chained); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Input returns an Input that references the Output.
+func (f *FoundryOutput) Input() Input {
+	if f.ID() == EmptyOutputID {
+		panic("FoundryOutput: Outputs that haven't been assigned an ID, yet cannot be converted to an Input")
+	}
+
+	return NewUTXOInput(f.ID())
+}
+
+// Clone creates a copy of the Output.
+func (f *FoundryOutput) Clone() Output {
+	return f.clone()
+}
+
+// clone creates a typed copy of the Output.
+func (f *FoundryOutput) clone() *FoundryOutput {
+	ret := &FoundryOutput{
+		balances:          f.balances.Clone(),
+		aliasAddress:      f.aliasAddress.Clone().(*AliasAddress),
+		tokenColor:        f.tokenColor,
+		circulatingSupply: f.circulatingSupply,
+		maximumSupply:     f.maximumSupply,
+		tokenMetadata:     make([]byte, len(f.tokenMetadata)),
+	}
+	copy(ret.tokenMetadata, f.tokenMetadata)
+	ret.SetID(f.ID())
+
+	return ret
+}
+
+// UpdateMintingColor assigns the Color of the token of an origin FoundryOutput. It returns a copy of the original
+// Output.
+func (f *FoundryOutput) UpdateMintingColor() Output {
+	updatedOutput := f.clone()
+	updatedOutput.tokenColor = f.TokenColor()
+
+	return updatedOutput
+}
+
+// Bytes returns a marshaled version of the Output.
+func (f *FoundryOutput) Bytes() []byte {
+	return f.ObjectStorageValue()
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (f *FoundryOutput) Update(objectstorage.StorableObject) {
+	panic("FoundryOutput: updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (f *FoundryOutput) ObjectStorageKey() []byte {
+	return f.ID().Bytes()
+}
+
+// ObjectStorageValue marshals the Output into a sequence of bytes. The ID is not serialized here as it is only used as
+// a key in the ObjectStorage.
+func (f *FoundryOutput) ObjectStorageValue() []byte {
+	return marshalutil.New().
+		WriteByte(byte(FoundryOutputType)).
+		WriteBytes(f.balances.Bytes()).
+		WriteBytes(f.aliasAddress.Bytes()).
+		WriteBytes(f.tokenColor.Bytes()).
+		WriteUint64(f.circulatingSupply).
+		WriteUint64(f.maximumSupply).
+		WriteUint16(uint16(len(f.tokenMetadata))).
+		WriteBytes(f.tokenMetadata).
+		Bytes()
+}
+
+// Compare offers a comparator for Outputs which returns -1 if the other Output is bigger, 1 if it is smaller and 0 if
+// they are the same.
+func (f *FoundryOutput) Compare(other Output) int {
+	return bytes.Compare(f.Bytes(), other.Bytes())
+}
+
+// String returns a human readable version of the Output.
+func (f *FoundryOutput) String() string {
+	return stringify.Struct("FoundryOutput",
+		stringify.StructField("id", f.ID()),
+		stringify.StructField("balances", f.balances),
+		stringify.StructField("aliasAddress", f.aliasAddress),
+		stringify.StructField("tokenColor", f.TokenColor()),
+		stringify.StructField("circulatingSupply", f.circulatingSupply),
+		stringify.StructField("maximumSupply", f.maximumSupply),
+		stringify.StructField("tokenMetadata", f.tokenMetadata),
+	)
+}
+
+// checkBasicValidity checks the syntactical validity of the Output.
+func (f *FoundryOutput) checkBasicValidity() error {
+	if !onlyIOTABalances(f.balances.Map()) || !IsAboveDustThreshold(f.balances.Map()) {
+		return errors.Errorf("foundryOutput: balances have to consist of at least %d IOTA and no other colors", DustThresholdAliasOutputIOTA)
+	}
+	if f.aliasAddress == nil || f.aliasAddress.IsNil() {
+		return errors.New("foundryOutput: alias address must not be empty")
+	}
+	if f.tokenColor == ColorMint {
+		return errors.New("foundryOutput: token color must not be the minting color")
+	}
+	if f.maximumSupply == 0 || f.maximumSupply > MaxOutputBalance {
+		return errors.Errorf("foundryOutput: maximum supply has to be between 1 and %d", uint64(MaxOutputBalance))
+	}
+	if f.circulatingSupply > f.maximumSupply {
+		return errors.Errorf("foundryOutput: circulating supply (%d) exceeds the maximum supply (%d)", f.circulatingSupply, f.maximumSupply)
+	}
+	if len(f.tokenMetadata) > MaxTokenMetadataSize {
+		return errors.Errorf("foundryOutput: size of the token metadata (%d) exceeds maximum allowed (%d)", len(f.tokenMetadata), MaxTokenMetadataSize)
+	}
+
+	return nil
+}
+
+// findChainedOutputAndCheckFork finds the FoundryOutput that continues the Output in the given Transaction. It
+// returns an error if there is more than one and nil if the Output is destroyed.
+func (f *FoundryOutput) findChainedOutputAndCheckFork(tx *Transaction) (chained *FoundryOutput, err error) {
+	tokenColor := f.TokenColor()
+	for _, output := range tx.Essence().Outputs() {
+		foundry, isFoundry := output.(*FoundryOutput)
+		if !isFoundry || foundry.IsOrigin() || foundry.tokenColor != tokenColor {
+			continue
+		}
+		if chained != nil {
+			return nil, errors.Errorf("foundryOutput: duplicated foundry output for token %s", tokenColor)
+		}
+		chained = foundry
+	}
+
+	return chained, nil
+}
+
+// validateTransition enforces the immutability of the token definition between the consumed and the chained
+// FoundryOutput. Changes of the circulating supply are checked against the balances of the Transaction.
+func (f *FoundryOutput) validateTransition(chained *FoundryOutput) error {
+	if !f.aliasAddress.Equals(chained.aliasAddress) {
+		return errors.New("foundryOutput: can't modify alias address")
+	}
+	if f.maximumSupply != chained.maximumSupply {
+		return errors.New("foundryOutput: can't modify maximum supply")
+	}
+	if chained.circulatingSupply > chained.maximumSupply {
+		return errors.Errorf("foundryOutput: circulating supply (%d) exceeds the maximum supply (%d)", chained.circulatingSupply, chained.maximumSupply)
+	}
+	if !bytes.Equal(f.tokenMetadata, chained.tokenMetadata) {
+		return errors.New("foundryOutput: can't modify token metadata")
+	}
+
+	return nil
+}
+
+// onlyIOTABalances is an internal utility function that checks if the balances do not contain any colored tokens.
+func onlyIOTABalances(balances map[Color]uint64) bool {
+	for color := range balances {
+		if color != ColorIOTA {
+			return false
+		}
+	}
+
+	return true
+}
+
+// code contract (make sure the type implements all required methods).
+var _ Output = &FoundryOutput{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region CachedOutput /////////////////////////////////////////////////////////////////////////////////////////////////
 
 // CachedOutput is a wrapper for the generic CachedObject returned by the object storage that overrides the accessor
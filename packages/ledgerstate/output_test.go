@@ -2339,6 +2339,227 @@ func TestExtendedLockedOutput_Clone(t *testing.T) {
 
 // endregion
 
+// region HashTimeLockedOutput Tests
+
+func TestHashTimeLockedOutput_Bytes(t *testing.T) {
+	o := dummyHashTimeLockedOutput()
+	restored, consumed, err := OutputFromBytes(o.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(o.Bytes()), consumed)
+	castedRestored, ok := restored.(*HashTimeLockedOutput)
+	require.True(t, ok)
+	assert.True(t, o.address.Equals(castedRestored.address))
+	assert.True(t, o.fallbackAddress.Equals(castedRestored.fallbackAddress))
+	assert.Equal(t, o.hashLock, castedRestored.hashLock)
+	assert.True(t, o.deadline.Equal(castedRestored.deadline))
+	assert.Equal(t, o.balances.Bytes(), castedRestored.balances.Bytes())
+}
+
+func TestHashTimeLockedOutput_Clone(t *testing.T) {
+	out := dummyHashTimeLockedOutput()
+	outBack := out.Clone()
+	outBackT, ok := outBack.(*HashTimeLockedOutput)
+	assert.True(t, ok)
+	assert.True(t, out != outBackT)
+	assert.True(t, out.address != outBackT.address)
+	assert.True(t, out.fallbackAddress != outBackT.fallbackAddress)
+	assert.EqualValues(t, out.Bytes(), outBack.Bytes())
+	assert.Equal(t, out.ID(), outBack.ID())
+}
+
+func TestHashTimeLockedOutput_UpdateMintingColor(t *testing.T) {
+	out := dummyHashTimeLockedOutput()
+	out.balances = NewColoredBalances(map[Color]uint64{ColorIOTA: 1, ColorMint: 10})
+
+	updated := out.UpdateMintingColor().(*HashTimeLockedOutput)
+	expectedColor := Color(blake2b.Sum256(out.ID().Bytes()))
+	balance, ok := updated.Balances().Get(expectedColor)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), balance)
+	_, ok = updated.Balances().Get(ColorMint)
+	assert.False(t, ok)
+	assert.Equal(t, out.HashLock(), updated.HashLock())
+	assert.True(t, out.Deadline().Equal(updated.Deadline()))
+	assert.True(t, out.FallbackAddress().Equals(updated.FallbackAddress()))
+}
+
+func TestHashTimeLockedOutput_UnlockAddressNow(t *testing.T) {
+	out := dummyHashTimeLockedOutput()
+	assert.True(t, out.address.Equals(out.UnlockAddressNow(out.deadline)))
+	assert.True(t, out.fallbackAddress.Equals(out.UnlockAddressNow(out.deadline.Add(time.Second))))
+}
+
+func TestHashTimeLockedOutput_UnlockValid(t *testing.T) {
+	receiver := genRandomWallet()
+	sender := genRandomWallet()
+	nowis := time.Now()
+	var preimage Preimage
+	_, _ = rand.Read(preimage[:])
+
+	input := NewHashTimeLockedOutput(map[Color]uint64{ColorIOTA: 1}, receiver.address, preimage.HashLock(), nowis.Add(time.Hour), sender.address)
+	input.SetID(randOutputID())
+	output := NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 1}), randEd25119Address())
+
+	t.Run("CASE: Happy path, claimed with preimage", func(t *testing.T) {
+		essence := NewTransactionEssence(0, nowis, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock := NewHashLockUnlockBlock(receiver.sign(essence), preimage)
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("CASE: Wrong preimage", func(t *testing.T) {
+		var wrongPreimage Preimage
+		_, _ = rand.Read(wrongPreimage[:])
+		essence := NewTransactionEssence(0, nowis, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock := NewHashLockUnlockBlock(receiver.sign(essence), wrongPreimage)
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Preimage revealed by someone else than the receiver", func(t *testing.T) {
+		essence := NewTransactionEssence(0, nowis, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock := NewHashLockUnlockBlock(sender.sign(essence), preimage)
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Refund before the deadline", func(t *testing.T) {
+		essence := NewTransactionEssence(0, nowis.Add(time.Hour), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock := NewSignatureUnlockBlock(sender.sign(essence))
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Claim after the deadline", func(t *testing.T) {
+		essence := NewTransactionEssence(0, nowis.Add(time.Hour).Add(time.Nanosecond), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock := NewHashLockUnlockBlock(receiver.sign(essence), preimage)
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.Error(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("CASE: Refund after the deadline", func(t *testing.T) {
+		essence := NewTransactionEssence(0, nowis.Add(time.Hour).Add(time.Nanosecond), identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		unlockBlock := NewSignatureUnlockBlock(sender.sign(essence))
+		tx := NewTransaction(essence, UnlockBlocks{unlockBlock})
+
+		valid, err := input.UnlockValid(tx, unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.True(t, valid)
+
+		// the receiver can not sign for the refund
+		unlockBlock = NewSignatureUnlockBlock(receiver.sign(essence))
+		valid, err = input.UnlockValid(NewTransaction(essence, UnlockBlocks{unlockBlock}), unlockBlock, Outputs{input})
+		assert.NoError(t, err)
+		assert.False(t, valid)
+	})
+}
+
+func TestHashTimeLockedOutput_UnlockBlocksValid(t *testing.T) {
+	receiver := genRandomWallet()
+	nowis := time.Now()
+	var preimage Preimage
+	_, _ = rand.Read(preimage[:])
+
+	// two swaps with the same hash lock can be claimed with a single HashLockUnlockBlock
+	inputs := make(Outputs, 2)
+	for i := range inputs {
+		var transactionID TransactionID
+		_, _ = rand.Read(transactionID[:])
+		inputs[i] = NewHashTimeLockedOutput(map[Color]uint64{ColorIOTA: 1}, receiver.address, preimage.HashLock(), nowis.Add(time.Hour), randEd25119Address()).SetID(NewOutputID(transactionID, 0))
+	}
+	output := NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 2}), randEd25119Address())
+	essence := NewTransactionEssence(0, nowis, identity.ID{}, identity.ID{}, NewInputs(inputs[0].Input(), inputs[1].Input()), NewOutputs(output))
+	orderedInputs := make(Outputs, len(essence.Inputs()))
+	for i, input := range essence.Inputs() {
+		for _, candidate := range inputs {
+			if candidate.ID() == input.(*UTXOInput).ReferencedOutputID() {
+				orderedInputs[i] = candidate
+			}
+		}
+	}
+
+	tx := NewTransaction(essence, UnlockBlocks{NewHashLockUnlockBlock(receiver.sign(essence), preimage), NewReferenceUnlockBlock(0)})
+	valid, err := UnlockBlocksValidWithError(orderedInputs, tx)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	restoredTx, _, err := TransactionFromBytes(tx.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, tx.UnlockBlocks().Bytes(), restoredTx.UnlockBlocks().Bytes())
+}
+
+// endregion
+
+// region FoundryOutput Tests
+
+func TestFoundryOutput_Bytes(t *testing.T) {
+	o := dummyFoundryOutput()
+	restored, consumed, err := OutputFromBytes(o.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, len(o.Bytes()), consumed)
+	castedRestored, ok := restored.(*FoundryOutput)
+	require.True(t, ok)
+	assert.True(t, o.aliasAddress.Equals(castedRestored.aliasAddress))
+	assert.Equal(t, o.tokenColor, castedRestored.tokenColor)
+	assert.Equal(t, o.circulatingSupply, castedRestored.circulatingSupply)
+	assert.Equal(t, o.maximumSupply, castedRestored.maximumSupply)
+	assert.Equal(t, o.tokenMetadata, castedRestored.tokenMetadata)
+	assert.Equal(t, o.balances.Bytes(), castedRestored.balances.Bytes())
+
+	// outputs violating the supply rules can't be parsed
+	o.circulatingSupply = o.maximumSupply + 1
+	_, _, err = OutputFromBytes(o.Bytes())
+	assert.Error(t, err)
+}
+
+func TestNewFoundryOutput(t *testing.T) {
+	_, err := NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}, randAliasAddress(), 1000, []byte("token"))
+	assert.NoError(t, err)
+	_, err = NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA - 1}, randAliasAddress(), 1000, nil)
+	assert.Error(t, err)
+	_, err = NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA, {1}: 1}, randAliasAddress(), 1000, nil)
+	assert.Error(t, err)
+	_, err = NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}, randAliasAddress(), 0, nil)
+	assert.Error(t, err)
+	_, err = NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}, randAliasAddress(), 1000, make([]byte, MaxTokenMetadataSize+1))
+	assert.Error(t, err)
+}
+
+func TestFoundryOutput_UpdateMintingColor(t *testing.T) {
+	out, err := NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}, randAliasAddress(), 1000, []byte("token"))
+	require.NoError(t, err)
+	out.SetID(randOutputID())
+	assert.True(t, out.IsOrigin())
+
+	updated := out.UpdateMintingColor().(*FoundryOutput)
+	assert.False(t, updated.IsOrigin())
+	assert.Equal(t, Color(blake2b.Sum256(out.ID().Bytes())), updated.TokenColor())
+	assert.Equal(t, out.TokenColor(), updated.TokenColor())
+	assert.Equal(t, out.ID(), updated.ID())
+
+	next := updated.NewFoundryOutputNext()
+	assert.Equal(t, updated.TokenColor(), next.TokenColor())
+	assert.Equal(t, EmptyOutputID, next.ID())
+	assert.Error(t, next.SetCirculatingSupply(1001))
+}
+
+// endregion
+
 // region test utils
 
 func genRandomWallet() wallet {
@@ -2394,6 +2615,35 @@ func dummyExtendedLockedOutput() *ExtendedLockedOutput {
 	}
 }
 
+func dummyHashTimeLockedOutput() *HashTimeLockedOutput {
+	var preimage Preimage
+	_, _ = rand.Read(preimage[:])
+	return &HashTimeLockedOutput{
+		id:                  randOutputID(),
+		idMutex:             sync.RWMutex{},
+		balances:            NewColoredBalances(map[Color]uint64{ColorIOTA: 1}),
+		address:             randEd25119Address(),
+		hashLock:            preimage.HashLock(),
+		deadline:            time.Unix(1001, 0),
+		fallbackAddress:     randEd25119Address(),
+		StorableObjectFlags: objectstorage.StorableObjectFlags{},
+	}
+}
+
+func dummyFoundryOutput() *FoundryOutput {
+	return &FoundryOutput{
+		id:                  randOutputID(),
+		idMutex:             sync.RWMutex{},
+		balances:            NewColoredBalances(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}),
+		aliasAddress:        randAliasAddress(),
+		tokenColor:          Color{1},
+		circulatingSupply:   100,
+		maximumSupply:       1000,
+		tokenMetadata:       []byte("token"),
+		StorableObjectFlags: objectstorage.StorableObjectFlags{},
+	}
+}
+
 func randEd25119Address() *ED25519Address {
 	keyPair := ed25519.GenerateKeyPair()
 	return NewED25519Address(keyPair.PublicKey)
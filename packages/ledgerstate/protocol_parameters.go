@@ -0,0 +1,82 @@
+package ledgerstate
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+)
+
+// protocolParametersKey is the key the ProtocolParameters are stored with in the ledger state.
+var protocolParametersKey = []byte("protocolParameters")
+
+// region ProtocolParameters ///////////////////////////////////////////////////////////////////////////////////////////
+
+// ProtocolParameters contains the rules of the ledger that all nodes of a network have to agree on. They are
+// distributed with the genesis Snapshot of the network.
+type ProtocolParameters struct {
+	// MinimumOutputDeposit is the amount of tokens that every Output created by a Transaction has to hold at least once
+	// the dust protection is active. A deposit of 0 disables the dust protection.
+	MinimumOutputDeposit uint64
+
+	// DustProtectionActivationTime is the time from which on the dust protection applies. Transactions are checked
+	// against the timestamp of their essence, so all nodes of the network protect the same Transactions.
+	DustProtectionActivationTime time.Time
+}
+
+// ProtocolParametersFromBytes unmarshals the ProtocolParameters from a sequence of bytes.
+func ProtocolParametersFromBytes(bytes []byte) (protocolParameters *ProtocolParameters, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if protocolParameters, err = ProtocolParametersFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse ProtocolParameters from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// ProtocolParametersFromMarshalUtil unmarshals the ProtocolParameters using a MarshalUtil (for easier unmarshaling).
+func ProtocolParametersFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (protocolParameters *ProtocolParameters, err error) {
+	protocolParameters = &ProtocolParameters{}
+	if protocolParameters.MinimumOutputDeposit, err = marshalUtil.ReadUint64(); err != nil {
+		err = errors.Errorf("failed to parse minimum output deposit (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if protocolParameters.DustProtectionActivationTime, err = marshalUtil.ReadTime(); err != nil {
+		err = errors.Errorf("failed to parse dust protection activation time (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return
+}
+
+// MinimumOutputDepositAt returns the minimum deposit that applies to a Transaction with the given timestamp. It is 0
+// before the activation of the dust protection or if no ProtocolParameters are set.
+func (p *ProtocolParameters) MinimumOutputDepositAt(timestamp time.Time) uint64 {
+	if p == nil || timestamp.Before(p.DustProtectionActivationTime) {
+		return 0
+	}
+
+	return p.MinimumOutputDeposit
+}
+
+// Bytes returns a marshaled version of the ProtocolParameters.
+func (p *ProtocolParameters) Bytes() []byte {
+	return marshalutil.New(marshalutil.Uint64Size + marshalutil.TimeSize).
+		WriteUint64(p.MinimumOutputDeposit).
+		WriteTime(p.DustProtectionActivationTime).
+		Bytes()
+}
+
+// String returns a human readable version of the ProtocolParameters.
+func (p *ProtocolParameters) String() string {
+	return stringify.Struct("ProtocolParameters",
+		stringify.StructField("minimumOutputDeposit", p.MinimumOutputDeposit),
+		stringify.StructField("dustProtectionActivationTime", p.DustProtectionActivationTime),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -6,6 +6,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/marshalutil"
 )
@@ -14,6 +15,8 @@ import (
 type Snapshot struct {
 	Transactions     map[TransactionID]Record
 	AccessManaByNode map[identity.ID]AccessMana
+	// ProtocolParameters are the rules of the ledger of the network (optional).
+	ProtocolParameters *ProtocolParameters
 }
 
 // AccessMana defines the info for the aMana snapshot.
@@ -96,6 +99,19 @@ func (s *Snapshot) WriteTo(writer io.Writer) (int64, error) {
 		bytesWritten += 8
 	}
 
+	// the protocol parameters are appended, so snapshots without them can still be read
+	if s.ProtocolParameters != nil {
+		protocolParametersBytes := s.ProtocolParameters.Bytes()
+		if err := binary.Write(writer, binary.LittleEndian, uint32(len(protocolParametersBytes))); err != nil {
+			return 0, fmt.Errorf("unable to write length of protocol parameters: %w", err)
+		}
+		bytesWritten += 4
+		if err := binary.Write(writer, binary.LittleEndian, protocolParametersBytes); err != nil {
+			return 0, fmt.Errorf("unable to write protocol parameters: %w", err)
+		}
+		bytesWritten += int64(len(protocolParametersBytes))
+	}
+
 	return bytesWritten, nil
 }
 
@@ -112,7 +128,38 @@ func (s *Snapshot) ReadFrom(reader io.Reader) (int64, error) {
 		return bytesAccessMana, err
 	}
 
-	return bytesTransactions + bytesAccessMana, nil
+	bytesProtocolParameters, err := s.readProtocolParameters(reader)
+	if err != nil {
+		return bytesProtocolParameters, err
+	}
+
+	return bytesTransactions + bytesAccessMana + bytesProtocolParameters, nil
+}
+
+// readProtocolParameters reads the optional protocol parameters from the end of the snapshot.
+func (s *Snapshot) readProtocolParameters(reader io.Reader) (int64, error) {
+	s.ProtocolParameters = nil
+
+	var protocolParametersLength uint32
+	if err := binary.Read(reader, binary.LittleEndian, &protocolParametersLength); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("unable to read length of protocol parameters: %w", err)
+	}
+
+	protocolParametersBytes := make([]byte, protocolParametersLength)
+	if err := binary.Read(reader, binary.LittleEndian, &protocolParametersBytes); err != nil {
+		return 0, fmt.Errorf("unable to read protocol parameters: %w", err)
+	}
+
+	protocolParameters, _, err := ProtocolParametersFromBytes(protocolParametersBytes)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse protocol parameters: %w", err)
+	}
+	s.ProtocolParameters = protocolParameters
+
+	return 4 + int64(protocolParametersLength), nil
 }
 
 // readTransactions reads the transactions from the snapshot.
@@ -257,7 +257,7 @@ func TransactionFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (transacti
 	maxReferencedUnlockIndex := len(transaction.essence.Inputs()) - 1
 	for i, unlockBlock := range transaction.unlockBlocks {
 		switch unlockBlock.Type() {
-		case SignatureUnlockBlockType:
+		case SignatureUnlockBlockType, HashLockUnlockBlockType:
 			continue
 		case ReferenceUnlockBlockType:
 			if unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex() > uint16(maxReferencedUnlockIndex) {
@@ -465,6 +465,8 @@ type TransactionEssence struct {
 	version TransactionEssenceVersion
 	// timestamp is the timestamp of the transaction.
 	timestamp time.Time
+	// validUntil is the time after which the transaction can no longer be attached (ExpiringTransactionEssenceVersion).
+	validUntil time.Time
 	// accessPledgeID is the nodeID to which access mana of the transaction is pledged.
 	accessPledgeID identity.ID
 	// consensusPledgeID is the nodeID to which consensus mana of the transaction is pledged.
@@ -517,6 +519,17 @@ func TransactionEssenceFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (tr
 		err = errors.Errorf("failed to parse Transaction timestamp from MarshalUtil: %w", err)
 		return
 	}
+	// unmarshal the end of the validity window
+	if transactionEssence.version == ExpiringTransactionEssenceVersion {
+		if transactionEssence.validUntil, err = marshalUtil.ReadTime(); err != nil {
+			err = errors.Errorf("failed to parse Transaction validUntil from MarshalUtil: %w", err)
+			return
+		}
+		if !transactionEssence.validUntil.After(transactionEssence.timestamp) {
+			err = errors.Errorf("validity window of Transaction ends before its timestamp: %w", cerrors.ErrParseBytesFailed)
+			return
+		}
+	}
 	// unmarshal accessPledgeID
 	var accessPledgeIDBytes []byte
 	if accessPledgeIDBytes, err = marshalUtil.ReadBytes(len(identity.ID{})); err != nil {
@@ -549,6 +562,15 @@ func TransactionEssenceFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (tr
 	return
 }
 
+// WithValidUntil limits the time the Transaction can be attached in a Message to the period until the given time and
+// upgrades the TransactionEssence to the ExpiringTransactionEssenceVersion.
+func (t *TransactionEssence) WithValidUntil(validUntil time.Time) *TransactionEssence {
+	t.version = ExpiringTransactionEssenceVersion
+	t.validUntil = validUntil
+
+	return t
+}
+
 // SetPayload set the optional Payload of the TransactionEssence.
 func (t *TransactionEssence) SetPayload(p payload.Payload) {
 	t.payload = p
@@ -564,6 +586,17 @@ func (t *TransactionEssence) Timestamp() time.Time {
 	return t.timestamp
 }
 
+// ValidUntil returns the time after which the Transaction can no longer be attached. It returns the zero time if the
+// TransactionEssence has no validity window.
+func (t *TransactionEssence) ValidUntil() time.Time {
+	return t.validUntil
+}
+
+// Expired returns true if a Message issued at the given time can no longer attach the Transaction.
+func (t *TransactionEssence) Expired(issuingTime time.Time) bool {
+	return t.version == ExpiringTransactionEssenceVersion && issuingTime.After(t.validUntil)
+}
+
 // AccessPledgeID returns the access mana pledge nodeID of the TransactionEssence.
 func (t *TransactionEssence) AccessPledgeID() identity.ID {
 	return t.accessPledgeID
@@ -593,7 +626,11 @@ func (t *TransactionEssence) Payload() payload.Payload {
 func (t *TransactionEssence) Bytes() []byte {
 	marshalUtil := marshalutil.New().
 		Write(t.version).
-		WriteTime(t.timestamp).
+		WriteTime(t.timestamp)
+	if t.version == ExpiringTransactionEssenceVersion {
+		marshalUtil.WriteTime(t.validUntil)
+	}
+	marshalUtil.
 		Write(t.accessPledgeID).
 		Write(t.consensusPledgeID).
 		Write(t.inputs).
@@ -613,6 +650,7 @@ func (t *TransactionEssence) String() string {
 	return stringify.Struct("TransactionEssence",
 		stringify.StructField("version", t.version),
 		stringify.StructField("timestamp", t.timestamp),
+		stringify.StructField("validUntil", t.validUntil),
 		stringify.StructField("accessPledgeID", t.accessPledgeID),
 		stringify.StructField("consensusPledgeID", t.consensusPledgeID),
 		stringify.StructField("inputs", t.inputs),
@@ -629,6 +667,15 @@ func (t *TransactionEssence) String() string {
 // compatibility if the structure ever needs to get changed.
 type TransactionEssenceVersion uint8
 
+const (
+	// DefaultTransactionEssenceVersion is the version of TransactionEssences that can be attached at any time.
+	DefaultTransactionEssenceVersion TransactionEssenceVersion = iota
+
+	// ExpiringTransactionEssenceVersion is the version of TransactionEssences that contain the end of a validity
+	// window, after which the Transaction can no longer be attached.
+	ExpiringTransactionEssenceVersion
+)
+
 // TransactionEssenceVersionFromBytes unmarshals a TransactionEssenceVersion from a sequence of bytes.
 func TransactionEssenceVersionFromBytes(bytes []byte) (version TransactionEssenceVersion, consumedBytes int, err error) {
 	marshalUtil := marshalutil.New(bytes)
@@ -649,7 +696,7 @@ func TransactionEssenceVersionFromMarshalUtil(marshalUtil *marshalutil.MarshalUt
 		err = errors.Errorf("failed to parse TransactionEssenceVersion (%v): %w", err, cerrors.ErrParseBytesFailed)
 		return
 	}
-	if readByte != 0 {
+	if TransactionEssenceVersion(readByte) > ExpiringTransactionEssenceVersion {
 		err = errors.Errorf("invalid TransactionVersion (%d): %w", readByte, cerrors.ErrParseBytesFailed)
 		return
 	}
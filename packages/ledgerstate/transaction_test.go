@@ -25,6 +25,48 @@ func TestTransaction_Bytes(t *testing.T) {
 	assert.Equal(t, tx.ID(), _tx.ID())
 }
 
+func TestTransactionEssence_ValidUntil(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	timestamp := time.Now()
+	newEssence := func() *TransactionEssence {
+		input := NewUTXOInput(NewOutputID(GenesisTransactionID, 0))
+		output := NewSigLockedSingleOutput(100, NewED25519Address(keyPair.PublicKey))
+		return NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, NewInputs(input), NewOutputs(output))
+	}
+
+	t.Run("CASE: Default version", func(t *testing.T) {
+		essence := newEssence()
+		assert.True(t, essence.ValidUntil().IsZero())
+		assert.False(t, essence.Expired(timestamp.Add(time.Hour)))
+	})
+
+	t.Run("CASE: Expiring version", func(t *testing.T) {
+		validUntil := timestamp.Add(time.Minute)
+		essence := newEssence().WithValidUntil(validUntil)
+		assert.Equal(t, ExpiringTransactionEssenceVersion, essence.Version())
+		assert.False(t, essence.Expired(validUntil))
+		assert.True(t, essence.Expired(validUntil.Add(time.Nanosecond)))
+
+		restored, _, err := TransactionEssenceFromBytes(essence.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, essence.Bytes(), restored.Bytes())
+		assert.True(t, validUntil.Equal(restored.ValidUntil()))
+		assert.NotEqual(t, newEssence().Bytes(), essence.Bytes())
+	})
+
+	t.Run("CASE: Validity window ends before timestamp", func(t *testing.T) {
+		_, _, err := TransactionEssenceFromBytes(newEssence().WithValidUntil(timestamp).Bytes())
+		assert.Error(t, err)
+	})
+
+	t.Run("CASE: Unknown version", func(t *testing.T) {
+		essenceBytes := newEssence().Bytes()
+		essenceBytes[0] = byte(ExpiringTransactionEssenceVersion + 1)
+		_, _, err := TransactionEssenceFromBytes(essenceBytes)
+		assert.Error(t, err)
+	})
+}
+
 func TestTransaction_Complex(t *testing.T) {
 	// setup variables representing keys and outputs for the two parties that wants to trade tokens
 	party1KeyChain, party1SrcAddress, party1DestAddress, party1RemainderAddress := setupKeyChainAndAddresses(t)
@@ -22,6 +22,9 @@ const (
 
 	// AliasUnlockBlockType represents the type of a AliasUnlockBlock.
 	AliasUnlockBlockType
+
+	// HashLockUnlockBlockType represents the type of a HashLockUnlockBlock.
+	HashLockUnlockBlockType
 )
 
 // UnlockBlockType represents the type of the UnlockBlock. Different types of UnlockBlocks can unlock different types of
@@ -34,6 +37,7 @@ func (a UnlockBlockType) String() string {
 		"SignatureUnlockBlockType",
 		"ReferenceUnlockBlockType",
 		"AliasUnlockBlockType",
+		"HashLockUnlockBlockType",
 	}[a]
 }
 
@@ -91,6 +95,11 @@ func UnlockBlockFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (unlockBlo
 			err = errors.Errorf("failed to parse AliasUnlockBlock from MarshalUtil: %w", err)
 			return
 		}
+	case HashLockUnlockBlockType:
+		if unlockBlock, err = HashLockUnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+			err = errors.Errorf("failed to parse HashLockUnlockBlock from MarshalUtil: %w", err)
+			return
+		}
 
 	default:
 		err = errors.Errorf("unsupported UnlockBlockType (%X): %w", unlockBlockType, cerrors.ErrParseBytesFailed)
@@ -409,3 +418,94 @@ func (r *AliasUnlockBlock) String() string {
 var _ UnlockBlock = &AliasUnlockBlock{}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region HashLockUnlockBlock //////////////////////////////////////////////////////////////////////////////////////////
+
+// HashLockUnlockBlock is an UnlockBlock that unlocks a HashTimeLockedOutput before its deadline. It contains the
+// signature of the receiving address and reveals the Preimage of the HashLock.
+type HashLockUnlockBlock struct {
+	signature Signature
+	preimage  Preimage
+}
+
+// NewHashLockUnlockBlock is the constructor for HashLockUnlockBlock objects.
+func NewHashLockUnlockBlock(signature Signature, preimage Preimage) *HashLockUnlockBlock {
+	return &HashLockUnlockBlock{
+		signature: signature,
+		preimage:  preimage,
+	}
+}
+
+// HashLockUnlockBlockFromBytes unmarshals a HashLockUnlockBlock from a sequence of bytes.
+func HashLockUnlockBlockFromBytes(bytes []byte) (unlockBlock *HashLockUnlockBlock, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if unlockBlock, err = HashLockUnlockBlockFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse HashLockUnlockBlock from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// HashLockUnlockBlockFromMarshalUtil unmarshals a HashLockUnlockBlock using a MarshalUtil (for easier unmarshaling).
+func HashLockUnlockBlockFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (unlockBlock *HashLockUnlockBlock, err error) {
+	unlockBlockType, err := marshalUtil.ReadByte()
+	if err != nil {
+		err = errors.Errorf("failed to parse UnlockBlockType (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if UnlockBlockType(unlockBlockType) != HashLockUnlockBlockType {
+		err = errors.Errorf("invalid UnlockBlockType (%X): %w", unlockBlockType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	unlockBlock = &HashLockUnlockBlock{}
+	if unlockBlock.signature, err = SignatureFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Signature from MarshalUtil: %w", err)
+		return
+	}
+	if unlockBlock.preimage, err = PreimageFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Preimage from MarshalUtil: %w", err)
+		return
+	}
+	return
+}
+
+// AddressSignatureValid returns true if the UnlockBlock correctly signs the given Address.
+func (h *HashLockUnlockBlock) AddressSignatureValid(address Address, signedData []byte) bool {
+	return h.signature.AddressSignatureValid(address, signedData)
+}
+
+// Signature returns the signature of the receiving address.
+func (h *HashLockUnlockBlock) Signature() Signature {
+	return h.signature
+}
+
+// Preimage returns the revealed secret.
+func (h *HashLockUnlockBlock) Preimage() Preimage {
+	return h.preimage
+}
+
+// Type returns the UnlockBlockType of the UnlockBlock.
+func (h *HashLockUnlockBlock) Type() UnlockBlockType {
+	return HashLockUnlockBlockType
+}
+
+// Bytes returns a marshaled version of the UnlockBlock.
+func (h *HashLockUnlockBlock) Bytes() []byte {
+	return byteutils.ConcatBytes([]byte{byte(HashLockUnlockBlockType)}, h.signature.Bytes(), h.preimage.Bytes())
+}
+
+// String returns a human readable version of the UnlockBlock.
+func (h *HashLockUnlockBlock) String() string {
+	return stringify.Struct("HashLockUnlockBlock",
+		stringify.StructField("signature", h.signature),
+		stringify.StructField("preimage", h.preimage),
+	)
+}
+
+// code contract (make sure the type implements all required methods).
+var _ UnlockBlock = &HashLockUnlockBlock{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -34,3 +34,17 @@ func TestUnlockBlockFromMarshalUtil(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestHashLockUnlockBlock(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	preimage := Preimage{1, 2, 3}
+
+	unlockBlock := NewHashLockUnlockBlock(NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign([]byte("testdata"))), preimage)
+	parsedUnlockBlock, consumedBytes, err := UnlockBlockFromBytes(unlockBlock.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, len(unlockBlock.Bytes()), consumedBytes)
+	assert.Equal(t, unlockBlock, parsedUnlockBlock)
+	assert.Equal(t, preimage, parsedUnlockBlock.(*HashLockUnlockBlock).Preimage())
+	assert.True(t, parsedUnlockBlock.(*HashLockUnlockBlock).AddressSignatureValid(NewED25519Address(keyPair.PublicKey), []byte("testdata")))
+	assert.True(t, preimage.HashLock().UnlockedBy(preimage))
+}
@@ -8,7 +8,14 @@ import (
 )
 
 // TransactionBalancesValid is an internal utility function that checks if the sum of the balance changes equals to 0.
+// Tokens that are minted by a FoundryOutput are created by recoloring IOTA, while tokens that are burned by a
+// FoundryOutput have to be turned back into IOTA.
 func TransactionBalancesValid(inputs Outputs, outputs Outputs) (valid bool) {
+	supplyChanges, valid := foundrySupplyChanges(inputs, outputs)
+	if !valid {
+		return
+	}
+
 	consumedCoins := make(map[Color]uint64)
 	for _, input := range inputs {
 		input.Balances().ForEach(func(color Color, balance uint64) bool {
@@ -22,6 +29,16 @@ func TransactionBalancesValid(inputs Outputs, outputs Outputs) (valid bool) {
 		}
 	}
 
+	mintedCoins := uint64(0)
+	for color, supplyChange := range supplyChanges {
+		if consumedCoins[color], valid = SafeAddUint64(consumedCoins[color], supplyChange.minted); !valid {
+			return
+		}
+		if mintedCoins, valid = SafeAddUint64(mintedCoins, supplyChange.minted); !valid {
+			return
+		}
+	}
+
 	recoloredCoins := uint64(0)
 	for _, output := range outputs {
 		output.Balances().ForEach(func(color Color, balance uint64) bool {
@@ -40,6 +57,13 @@ func TransactionBalancesValid(inputs Outputs, outputs Outputs) (valid bool) {
 		}
 	}
 
+	// the tokens of a foundry that are not spent again have to be burned by the foundry
+	for color, supplyChange := range supplyChanges {
+		if consumedCoins[color] != supplyChange.burned {
+			return false
+		}
+	}
+
 	unspentCoins := uint64(0)
 	for _, remainingBalance := range consumedCoins {
 		if unspentCoins, valid = SafeAddUint64(unspentCoins, remainingBalance); !valid {
@@ -47,9 +71,98 @@ func TransactionBalancesValid(inputs Outputs, outputs Outputs) (valid bool) {
 		}
 	}
 
+	if recoloredCoins, valid = SafeAddUint64(recoloredCoins, mintedCoins); !valid {
+		return
+	}
+
 	return unspentCoins == recoloredCoins
 }
 
+// foundrySupplyChange contains the amount of tokens that are minted or burned by a FoundryOutput.
+type foundrySupplyChange struct {
+	minted uint64
+	burned uint64
+}
+
+// foundrySupplyChanges is an internal utility function that determines the changes of the circulating supply of all
+// tokens whose FoundryOutputs are consumed by a Transaction.
+func foundrySupplyChanges(inputs Outputs, outputs Outputs) (supplyChanges map[Color]*foundrySupplyChange, valid bool) {
+	supplyChanges = make(map[Color]*foundrySupplyChange)
+	circulatingSupplies := make(map[Color]uint64)
+	for _, input := range inputs {
+		foundry, isFoundry := input.(*FoundryOutput)
+		if !isFoundry {
+			continue
+		}
+		if _, exists := circulatingSupplies[foundry.TokenColor()]; exists {
+			return nil, false
+		}
+		circulatingSupplies[foundry.TokenColor()] = foundry.CirculatingSupply()
+		supplyChanges[foundry.TokenColor()] = &foundrySupplyChange{}
+	}
+
+	for _, output := range outputs {
+		foundry, isFoundry := output.(*FoundryOutput)
+		if !isFoundry || foundry.IsOrigin() {
+			continue
+		}
+		supplyChange, exists := supplyChanges[foundry.TokenColor()]
+		if !exists {
+			// a foundry that is not consumed by the Transaction can not change the supply
+			return nil, false
+		}
+
+		previousSupply := circulatingSupplies[foundry.TokenColor()]
+		if foundry.CirculatingSupply() >= previousSupply {
+			supplyChange.minted += foundry.CirculatingSupply() - previousSupply
+		} else {
+			supplyChange.burned += previousSupply - foundry.CirculatingSupply()
+		}
+	}
+
+	return supplyChanges, true
+}
+
+// RecoloredFoundryTokens is an internal utility function that returns the Colors of the tokens that are recolored to
+// IOTA by a Transaction without consuming the FoundryOutput of the token. The ledger has to reject the Transaction if
+// any of these tokens has been minted by a FoundryOutput, as the circulating supply would otherwise be wrong.
+func RecoloredFoundryTokens(inputs Outputs, outputs Outputs) (colors []Color) {
+	consumedFoundries := make(map[Color]types.Empty)
+	for _, input := range inputs {
+		if foundry, isFoundry := input.(*FoundryOutput); isFoundry {
+			consumedFoundries[foundry.TokenColor()] = types.Void
+		}
+	}
+
+	remainingCoins := make(map[Color]uint64)
+	for _, input := range inputs {
+		input.Balances().ForEach(func(color Color, balance uint64) bool {
+			if _, exists := consumedFoundries[color]; !exists && color != ColorIOTA {
+				remainingCoins[color] += balance
+			}
+
+			return true
+		})
+	}
+	for _, output := range outputs {
+		output.Balances().ForEach(func(color Color, balance uint64) bool {
+			if remainingBalance, exists := remainingCoins[color]; exists && remainingBalance <= balance {
+				delete(remainingCoins, color)
+			} else if exists {
+				remainingCoins[color] -= balance
+			}
+
+			return true
+		})
+	}
+
+	for color := range remainingCoins {
+		colors = append(colors, color)
+	}
+
+	return colors
+}
+
 // UnlockBlocksValid is an internal utility function that checks if the UnlockBlocks are matching the referenced Inputs.
 func UnlockBlocksValid(inputs Outputs, transaction *Transaction) (valid bool) {
 	unlockValid, unlockErr := UnlockBlocksValidWithError(inputs, transaction)
@@ -83,6 +196,32 @@ func UnlockBlocksValidWithError(inputs Outputs, transaction *Transaction) (bool,
 	return true, nil
 }
 
+// DustProtectionValid is an internal utility function that checks if all Outputs hold at least the minimum deposit. The
+// balances of all colors count towards the deposit, as every colored token is backed by an IOTA. AliasOutputs and
+// FoundryOutputs are exempt, as they are subject to their own dust threshold. A minimum deposit of 0 disables the check.
+func DustProtectionValid(outputs Outputs, minimumDeposit uint64) (err error) {
+	if minimumDeposit == 0 {
+		return nil
+	}
+
+	for i, output := range outputs {
+		if output.Type() == AliasOutputType || output.Type() == FoundryOutputType {
+			continue
+		}
+
+		deposit := uint64(0)
+		output.Balances().ForEach(func(color Color, balance uint64) bool {
+			deposit += balance
+			return true
+		})
+		if deposit < minimumDeposit {
+			return errors.Errorf("output %d holds %d tokens, which is less than the minimum deposit of %d: %w", i, deposit, minimumDeposit, ErrDustOutput)
+		}
+	}
+
+	return nil
+}
+
 // AliasInitialStateValid is an internal utility function that checks if aliases are created by the transaction with
 // valid initial states.
 // Initial state of an alias is valid, if and only if:
@@ -149,6 +288,75 @@ func AliasInitialStateValid(inputs Outputs, transaction *Transaction) bool {
 	return true
 }
 
+// FoundryInitialStateValid is an internal utility function that checks if the FoundryOutputs created by the
+// transaction are valid.
+// A FoundryOutput on the output side is valid, if and only if:
+//  - it continues a FoundryOutput of the same token that is consumed by the transaction, or
+//  - it is an origin FoundryOutput with a circulating supply of 0 and its controlling alias is consumed by the
+//    transaction for a state transition.
+func FoundryInitialStateValid(inputs Outputs, transaction *Transaction) bool {
+	inputFoundries := make(map[Color]types.Empty)
+	inputAliases := make(map[AliasAddress]*AliasOutput)
+	for _, input := range inputs {
+		switch typedInput := input.(type) {
+		case *FoundryOutput:
+			inputFoundries[typedInput.TokenColor()] = types.Void
+		case *AliasOutput:
+			inputAliases[*typedInput.GetAliasAddress()] = typedInput
+		}
+	}
+
+	for _, output := range transaction.Essence().Outputs() {
+		foundry, isFoundry := output.(*FoundryOutput)
+		if !isFoundry {
+			continue
+		}
+		if !foundry.IsOrigin() {
+			// a foundry can't be created for a token that already exists, transition rules are enforced by the input
+			if _, exists := inputFoundries[foundry.TokenColor()]; !exists {
+				return false
+			}
+			continue
+		}
+		if foundry.CirculatingSupply() != 0 {
+			return false
+		}
+		alias, exists := inputAliases[*foundry.AliasAddress()]
+		if !exists || alias.hasToBeUnlockedForGovernanceUpdate(transaction) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HashTimeLockedOutputsValid is an internal utility function that checks if the HashTimeLockedOutputs created by the
+// transaction are valid.
+// A HashTimeLockedOutput on the output side is valid, if and only if:
+//  - its deadline is after the timestamp of the transaction, and
+//  - its address and its fallback address can be unlocked by a signature (ED25519 or BLS addresses).
+func HashTimeLockedOutputsValid(transaction *Transaction) bool {
+	for _, output := range transaction.Essence().Outputs() {
+		htlc, isHTLC := output.(*HashTimeLockedOutput)
+		if !isHTLC {
+			continue
+		}
+		if !htlc.Deadline().After(transaction.Essence().Timestamp()) {
+			return false
+		}
+		if !signatureAddress(htlc.Address()) || !signatureAddress(htlc.FallbackAddress()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// signatureAddress returns true if the given Address is unlocked by a signature.
+func signatureAddress(address Address) bool {
+	return address.Type() == ED25519AddressType || address.Type() == BLSAddressType
+}
+
 // SafeAddUint64 adds two uint64 values. It returns the result and a valid flag that indicates whether the addition is
 // valid without causing an overflow.
 func SafeAddUint64(a uint64, b uint64) (result uint64, valid bool) {
@@ -199,8 +407,8 @@ func NewUnlockGraph(blocks UnlockBlocks) (*UnlockGraph, error) {
 	for i, block := range blocks {
 		g.Vertices[i] = uint16(i)
 		switch block.Type() {
-		case SignatureUnlockBlockType:
-			// no adjacent vertex as a SignatureUnlockBlockType can't reference an other one
+		case SignatureUnlockBlockType, HashLockUnlockBlockType:
+			// no adjacent vertex as a SignatureUnlockBlockType or HashLockUnlockBlockType can't reference an other one
 		case ReferenceUnlockBlockType:
 			// a reference unlock block can not point to another reference unlock block
 			refIndex := block.(*ReferenceUnlockBlock).ReferencedIndex()
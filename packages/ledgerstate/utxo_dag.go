@@ -32,6 +32,8 @@ type IUTXODAG interface {
 	Events() *UTXODAGEvents
 	// Shutdown shuts down the UTXODAG and persists its state.
 	Shutdown()
+	// ExportCachedObjects adds the objects that are kept in the caches of the UTXODAG to the given CachedObjects.
+	ExportCachedObjects(cachedObjects *database.CachedObjects) (err error)
 	// CheckTransaction contains fast checks that have to be performed before booking a Transaction.
 	CheckTransaction(transaction *Transaction) (err error)
 	// BookTransaction books a Transaction into the ledger state.
@@ -42,6 +44,8 @@ type IUTXODAG interface {
 	Transaction(transactionID TransactionID) (transaction *Transaction)
 	// Transactions returns all the transactions, consumed.
 	Transactions() (transactions map[TransactionID]*Transaction)
+	// CheckConsistency checks if the stored entities of the ledger state reference each other correctly.
+	CheckConsistency() (err error)
 	// CachedTransactionMetadata retrieves the TransactionMetadata with the given TransactionID from the object storage.
 	CachedTransactionMetadata(transactionID TransactionID) (cachedTransactionMetadata *CachedTransactionMetadata)
 	// CachedOutput retrieves the Output with the given OutputID from the object storage.
@@ -50,8 +54,11 @@ type IUTXODAG interface {
 	CachedOutputMetadata(outputID OutputID) (cachedOutput *CachedOutputMetadata)
 	// CachedConsumers retrieves the Consumers of the given OutputID from the object storage.
 	CachedConsumers(outputID OutputID) (cachedConsumers CachedConsumers)
-	// LoadSnapshot creates a set of outputs in the UTXO-DAG, that are forming the genesis for future transactions.
-	LoadSnapshot(snapshot *Snapshot)
+	// LoadSnapshot creates a set of outputs in the UTXO-DAG, that are forming the genesis for future transactions, and
+	// stores the ProtocolParameters of the Snapshot.
+	LoadSnapshot(snapshot *Snapshot) (err error)
+	// ProtocolParameters returns the ProtocolParameters of the network or nil if the Snapshot did not define any.
+	ProtocolParameters() (protocolParameters *ProtocolParameters)
 	// CachedAddressOutputMapping retrieves the outputs for the given address.
 	CachedAddressOutputMapping(address Address) (cachedAddressOutputMappings CachedAddressOutputMappings)
 	// ConsumedOutputs returns the consumed (cached)Outputs of the given Transaction.
@@ -60,6 +67,8 @@ type IUTXODAG interface {
 	ManageStoreAddressOutputMapping(output Output)
 	// StoreAddressOutputMapping stores the address-output mapping.
 	StoreAddressOutputMapping(address Address, outputID OutputID)
+	// CachedTokenFoundryMapping retrieves the FoundryOutputs that control the supply of the token with the given Color.
+	CachedTokenFoundryMapping(color Color) (cachedTokenFoundryMappings CachedTokenFoundryMappings)
 	// TransactionGradeOfFinality returns the GradeOfFinality of the Transaction with the given TransactionID.
 	TransactionGradeOfFinality(transactionID TransactionID) (gradeOfFinality gof.GradeOfFinality, err error)
 	// BranchGradeOfFinality returns the GradeOfFinality of the Branch with the given BranchID.
@@ -79,6 +88,10 @@ type UTXODAG struct {
 	outputMetadataStorage       *objectstorage.ObjectStorage
 	consumerStorage             *objectstorage.ObjectStorage
 	addressOutputMappingStorage *objectstorage.ObjectStorage
+	tokenFoundryMappingStorage  *objectstorage.ObjectStorage
+	protocolParametersStore     kvstore.KVStore
+	protocolParameters          *ProtocolParameters
+	protocolParametersMutex     sync.RWMutex
 	branchDAG                   *BranchDAG
 	shutdownOnce                sync.Once
 }
@@ -97,8 +110,16 @@ func NewUTXODAG(store kvstore.KVStore, cacheProvider *database.CacheTimeProvider
 		outputMetadataStorage:       osFactory.New(PrefixOutputMetadataStorage, OutputMetadataFromObjectStorage, options.outputMetadataStorageOptions...),
 		consumerStorage:             osFactory.New(PrefixConsumerStorage, ConsumerFromObjectStorage, options.consumerStorageOptions...),
 		addressOutputMappingStorage: osFactory.New(PrefixAddressOutputMappingStorage, AddressOutputMappingFromObjectStorage, options.addressOutputMappingStorageOptions...),
+		tokenFoundryMappingStorage:  osFactory.New(PrefixTokenFoundryMappingStorage, TokenFoundryMappingFromObjectStorage, options.tokenFoundryMappingStorageOptions...),
+		protocolParametersStore:     store.WithRealm([]byte{database.PrefixLedgerState, PrefixProtocolParameters}),
 		branchDAG:                   branchDAG,
 	}
+
+	if protocolParametersBytes, err := utxoDAG.protocolParametersStore.Get(protocolParametersKey); err == nil {
+		if utxoDAG.protocolParameters, _, err = ProtocolParametersFromBytes(protocolParametersBytes); err != nil {
+			panic(fmt.Errorf("failed to load ProtocolParameters: %w", err))
+		}
+	}
 	return
 }
 
@@ -116,9 +137,29 @@ func (u *UTXODAG) Shutdown() {
 		u.outputMetadataStorage.Shutdown()
 		u.consumerStorage.Shutdown()
 		u.addressOutputMappingStorage.Shutdown()
+		u.tokenFoundryMappingStorage.Shutdown()
 	})
 }
 
+// ExportCachedObjects adds the objects that are kept in the caches of the UTXODAG to the given CachedObjects.
+func (u *UTXODAG) ExportCachedObjects(cachedObjects *database.CachedObjects) (err error) {
+	for prefix, storage := range map[byte]*objectstorage.ObjectStorage{
+		PrefixTransactionStorage:          u.transactionStorage,
+		PrefixTransactionMetadataStorage:  u.transactionMetadataStorage,
+		PrefixOutputStorage:               u.outputStorage,
+		PrefixOutputMetadataStorage:       u.outputMetadataStorage,
+		PrefixConsumerStorage:             u.consumerStorage,
+		PrefixAddressOutputMappingStorage: u.addressOutputMappingStorage,
+		PrefixTokenFoundryMappingStorage:  u.tokenFoundryMappingStorage,
+	} {
+		if err = database.ExportCachedObjects(cachedObjects, []byte{database.PrefixLedgerState, prefix}, storage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CheckTransaction contains fast checks that have to be performed before booking a Transaction.
 func (u *UTXODAG) CheckTransaction(transaction *Transaction) (err error) {
 	cachedConsumedOutputs := u.ConsumedOutputs(transaction)
@@ -138,6 +179,20 @@ func (u *UTXODAG) CheckTransaction(transaction *Transaction) (err error) {
 	if !AliasInitialStateValid(consumedOutputs, transaction) {
 		return errors.Errorf("initial state of created alias output is invalid: %w", ErrTransactionInvalid)
 	}
+	if !FoundryInitialStateValid(consumedOutputs, transaction) {
+		return errors.Errorf("initial state of created foundry output is invalid: %w", ErrTransactionInvalid)
+	}
+	if !HashTimeLockedOutputsValid(transaction) {
+		return errors.Errorf("created hash time locked output is invalid: %w", ErrTransactionInvalid)
+	}
+	for _, color := range RecoloredFoundryTokens(consumedOutputs, transaction.Essence().Outputs()) {
+		if u.tokenFoundryExists(color) {
+			return errors.Errorf("tokens with %s can only be burned by their foundry: %w", color, ErrTransactionInvalid)
+		}
+	}
+	if err = DustProtectionValid(transaction.Essence().Outputs(), u.ProtocolParameters().MinimumOutputDepositAt(transaction.Essence().Timestamp())); err != nil {
+		return errors.Errorf("transaction violates the dust protection: %w", err)
+	}
 
 	return nil
 }
@@ -321,8 +376,57 @@ func (u *UTXODAG) CachedConsumers(outputID OutputID) (cachedConsumers CachedCons
 	return
 }
 
+// CheckConsistency checks if the stored entities of the ledger state reference each other correctly: every Transaction
+// has TransactionMetadata whose Branch exists and every Output has OutputMetadata and a creating Transaction.
+func (u *UTXODAG) CheckConsistency() (err error) {
+	u.transactionStorage.ForEachKeyOnly(func(key []byte) bool {
+		transactionID, _, parseErr := TransactionIDFromBytes(key)
+		if parseErr != nil {
+			err = errors.Errorf("failed to parse TransactionID of stored Transaction: %w", parseErr)
+			return false
+		}
+
+		if !u.CachedTransactionMetadata(transactionID).Consume(func(transactionMetadata *TransactionMetadata) {
+			if !u.branchDAG.Branch(transactionMetadata.BranchID()).Consume(func(Branch) {}) {
+				err = errors.Errorf("Branch %s of %s is missing: %w", transactionMetadata.BranchID(), transactionID, ErrInconsistentLedger)
+			}
+		}) {
+			err = errors.Errorf("TransactionMetadata of %s is missing: %w", transactionID, ErrInconsistentLedger)
+		}
+
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	u.outputStorage.ForEachKeyOnly(func(key []byte) bool {
+		outputID, _, parseErr := OutputIDFromBytes(key)
+		if parseErr != nil {
+			err = errors.Errorf("failed to parse OutputID of stored Output: %w", parseErr)
+			return false
+		}
+
+		if !u.CachedOutputMetadata(outputID).Consume(func(*OutputMetadata) {}) {
+			err = errors.Errorf("OutputMetadata of %s is missing: %w", outputID, ErrInconsistentLedger)
+		} else if !u.CachedTransaction(outputID.TransactionID()).Consume(func(*Transaction) {}) {
+			err = errors.Errorf("Transaction that created %s is missing: %w", outputID, ErrInconsistentLedger)
+		}
+
+		return err == nil
+	})
+
+	return err
+}
+
 // LoadSnapshot creates a set of outputs in the UTXO-DAG, that are forming the genesis for future transactions.
-func (u *UTXODAG) LoadSnapshot(snapshot *Snapshot) {
+func (u *UTXODAG) LoadSnapshot(snapshot *Snapshot) (err error) {
+	if snapshot.ProtocolParameters != nil {
+		if err = u.setProtocolParameters(snapshot.ProtocolParameters); err != nil {
+			return err
+		}
+	}
+
 	for txID, record := range snapshot.Transactions {
 		transaction := NewTransaction(record.Essence, record.UnlockBlocks)
 		cached, storedTx := u.transactionStorage.StoreIfAbsent(transaction)
@@ -342,6 +446,9 @@ func (u *UTXODAG) LoadSnapshot(snapshot *Snapshot) {
 
 			// store addressOutputMapping
 			u.ManageStoreAddressOutputMapping(output)
+			if foundry, isFoundry := output.(*FoundryOutput); isFoundry {
+				u.StoreTokenFoundryMapping(foundry.TokenColor(), foundry.ID())
+			}
 
 			// store OutputMetadata
 			metadata := NewOutputMetadata(output.ID())
@@ -366,6 +473,29 @@ func (u *UTXODAG) LoadSnapshot(snapshot *Snapshot) {
 			return txMetadata
 		})}).Release()
 	}
+
+	return nil
+}
+
+// ProtocolParameters returns the ProtocolParameters of the network or nil if the Snapshot did not define any.
+func (u *UTXODAG) ProtocolParameters() (protocolParameters *ProtocolParameters) {
+	u.protocolParametersMutex.RLock()
+	defer u.protocolParametersMutex.RUnlock()
+
+	return u.protocolParameters
+}
+
+// setProtocolParameters persists the given ProtocolParameters and applies them to the following Transactions.
+func (u *UTXODAG) setProtocolParameters(protocolParameters *ProtocolParameters) (err error) {
+	u.protocolParametersMutex.Lock()
+	defer u.protocolParametersMutex.Unlock()
+
+	if err = u.protocolParametersStore.Set(protocolParametersKey, protocolParameters.Bytes()); err != nil {
+		return errors.Errorf("failed to store %s: %w", protocolParameters, err)
+	}
+	u.protocolParameters = protocolParameters
+
+	return nil
 }
 
 // CachedAddressOutputMapping retrieves the outputs for the given address.
@@ -377,6 +507,24 @@ func (u *UTXODAG) CachedAddressOutputMapping(address Address) (cachedAddressOutp
 	return
 }
 
+// CachedTokenFoundryMapping retrieves the FoundryOutputs that control the supply of the token with the given Color.
+func (u *UTXODAG) CachedTokenFoundryMapping(color Color) (cachedTokenFoundryMappings CachedTokenFoundryMappings) {
+	u.tokenFoundryMappingStorage.ForEach(func(key []byte, cachedObject objectstorage.CachedObject) bool {
+		cachedTokenFoundryMappings = append(cachedTokenFoundryMappings, &CachedTokenFoundryMapping{cachedObject})
+		return true
+	}, objectstorage.WithIteratorPrefix(color.Bytes()))
+	return
+}
+
+// tokenFoundryExists returns true if the token with the given Color has been created by a FoundryOutput.
+func (u *UTXODAG) tokenFoundryExists(color Color) (exists bool) {
+	u.tokenFoundryMappingStorage.ForEachKeyOnly(func(key []byte) bool {
+		exists = true
+		return false
+	}, objectstorage.WithIteratorPrefix(color.Bytes()))
+	return
+}
+
 // region booking functions ////////////////////////////////////////////////////////////////////////////////////////////
 
 // bookInvalidTransaction is an internal utility function that books the given Transaction into the Branch identified by
@@ -576,6 +724,11 @@ func (u *UTXODAG) bookOutputs(transaction *Transaction, targetBranch BranchID) {
 		metadata.SetBranchID(targetBranch)
 		metadata.SetSolid(true)
 		u.outputMetadataStorage.Store(metadata).Release()
+
+		// register the token of a FoundryOutput, independently of the branch, as its Color is unique
+		if foundry, isFoundry := updatedOutput.(*FoundryOutput); isFoundry {
+			u.StoreTokenFoundryMapping(foundry.TokenColor(), foundry.ID())
+		}
 	}
 }
 
@@ -811,6 +964,10 @@ func (u *UTXODAG) ManageStoreAddressOutputMapping(output Output) {
 			u.StoreAddressOutputMapping(castedOutput.FallbackAddress(), output.ID())
 		}
 		u.StoreAddressOutputMapping(output.Address(), output.ID())
+	case HashTimeLockedOutputType:
+		castedOutput := output.(*HashTimeLockedOutput)
+		u.StoreAddressOutputMapping(castedOutput.FallbackAddress(), output.ID())
+		u.StoreAddressOutputMapping(output.Address(), output.ID())
 	default:
 		u.StoreAddressOutputMapping(output.Address(), output.ID())
 	}
@@ -824,6 +981,14 @@ func (u *UTXODAG) StoreAddressOutputMapping(address Address, outputID OutputID)
 	}
 }
 
+// StoreTokenFoundryMapping stores the mapping between the Color of a token and its FoundryOutput.
+func (u *UTXODAG) StoreTokenFoundryMapping(color Color, outputID OutputID) {
+	result, stored := u.tokenFoundryMappingStorage.StoreIfAbsent(NewTokenFoundryMapping(color, outputID))
+	if stored {
+		result.Release()
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1304,3 +1469,206 @@ func (c CachedConsumers) String() string {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TokenFoundryMapping //////////////////////////////////////////////////////////////////////////////////////////
+
+// TokenFoundryMapping represents a mapping between the Color of a token and the FoundryOutputs that control its supply.
+// It allows to look up the circulating supply of a token and to detect tokens that are controlled by a foundry.
+type TokenFoundryMapping struct {
+	color    Color
+	outputID OutputID
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewTokenFoundryMapping returns a new TokenFoundryMapping.
+func NewTokenFoundryMapping(color Color, outputID OutputID) *TokenFoundryMapping {
+	return &TokenFoundryMapping{
+		color:    color,
+		outputID: outputID,
+	}
+}
+
+// TokenFoundryMappingFromBytes unmarshals a TokenFoundryMapping from a sequence of bytes.
+func TokenFoundryMappingFromBytes(bytes []byte) (tokenFoundryMapping *TokenFoundryMapping, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if tokenFoundryMapping, err = TokenFoundryMappingFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse TokenFoundryMapping from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// TokenFoundryMappingFromMarshalUtil unmarshals an TokenFoundryMapping using a MarshalUtil (for easier unmarshaling).
+func TokenFoundryMappingFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (tokenFoundryMapping *TokenFoundryMapping, err error) {
+	tokenFoundryMapping = &TokenFoundryMapping{}
+	if tokenFoundryMapping.color, err = ColorFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse Color from MarshalUtil: %w", err)
+		return
+	}
+	if tokenFoundryMapping.outputID, err = OutputIDFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse OutputID from MarshalUtil: %w", err)
+		return
+	}
+
+	return
+}
+
+// TokenFoundryMappingFromObjectStorage is a factory method that creates a new TokenFoundryMapping instance from a
+// storage key of the object storage. It is used by the object storage, to create new instances of this entity.
+func TokenFoundryMappingFromObjectStorage(key []byte, _ []byte) (result objectstorage.StorableObject, err error) {
+	if result, _, err = TokenFoundryMappingFromBytes(key); err != nil {
+		err = errors.Errorf("failed to parse TokenFoundryMapping from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// Color returns the Color of the token of the TokenFoundryMapping.
+func (t *TokenFoundryMapping) Color() Color {
+	return t.color
+}
+
+// OutputID returns the OutputID of the TokenFoundryMapping.
+func (t *TokenFoundryMapping) OutputID() OutputID {
+	return t.outputID
+}
+
+// Bytes marshals the TokenFoundryMapping into a sequence of bytes.
+func (t *TokenFoundryMapping) Bytes() []byte {
+	return t.ObjectStorageKey()
+}
+
+// String returns a human readable version of the TokenFoundryMapping.
+func (t *TokenFoundryMapping) String() (humanReadableTokenFoundryMapping string) {
+	return stringify.Struct("TokenFoundryMapping",
+		stringify.StructField("color", t.color),
+		stringify.StructField("outputID", t.outputID),
+	)
+}
+
+// Update is disabled and panics if it ever gets called - it is required to match the StorableObject interface.
+func (t *TokenFoundryMapping) Update(objectstorage.StorableObject) {
+	panic("updates disabled")
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// StorableObject interface.
+func (t *TokenFoundryMapping) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(t.color.Bytes(), t.outputID.Bytes())
+}
+
+// ObjectStorageValue marshals the TokenFoundryMapping into a sequence of bytes that are used as the value part in the object
+// storage.
+func (t *TokenFoundryMapping) ObjectStorageValue() (value []byte) {
+	return
+}
+
+// code contract (make sure the struct implements all required methods)
+var _ objectstorage.StorableObject = &TokenFoundryMapping{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedTokenFoundryMapping ////////////////////////////////////////////////////////////////////////////////////
+
+// CachedTokenFoundryMapping is a wrapper for the generic CachedObject returned by the object storage that overrides
+// the accessor methods with a type-casted one.
+type CachedTokenFoundryMapping struct {
+	objectstorage.CachedObject
+}
+
+// Retain marks the CachedObject to still be in use by the program.
+func (c *CachedTokenFoundryMapping) Retain() *CachedTokenFoundryMapping {
+	return &CachedTokenFoundryMapping{c.CachedObject.Retain()}
+}
+
+// Unwrap is the type-casted equivalent of Get. It returns nil if the object does not exist.
+func (c *CachedTokenFoundryMapping) Unwrap() *TokenFoundryMapping {
+	untypedObject := c.Get()
+	if untypedObject == nil {
+		return nil
+	}
+
+	typedObject := untypedObject.(*TokenFoundryMapping)
+	if typedObject == nil || typedObject.IsDeleted() {
+		return nil
+	}
+
+	return typedObject
+}
+
+// Consume unwraps the CachedObject and passes a type-casted version to the consumer (if the object is not empty - it
+// exists). It automatically releases the object when the consumer finishes.
+func (c *CachedTokenFoundryMapping) Consume(consumer func(tokenFoundryMapping *TokenFoundryMapping), forceRelease ...bool) (consumed bool) {
+	return c.CachedObject.Consume(func(object objectstorage.StorableObject) {
+		consumer(object.(*TokenFoundryMapping))
+	}, forceRelease...)
+}
+
+// String returns a human-readable version of the CachedTokenFoundryMapping.
+func (c *CachedTokenFoundryMapping) String() string {
+	return stringify.Struct("CachedTokenFoundryMapping",
+		stringify.StructField("CachedObject", c.Unwrap()),
+	)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CachedTokenFoundryMappings ///////////////////////////////////////////////////////////////////////////////////
+
+// CachedTokenFoundryMappings represents a collection of CachedTokenFoundryMapping objects.
+type CachedTokenFoundryMappings []*CachedTokenFoundryMapping
+
+// Unwrap is the type-casted equivalent of Get. It returns a slice of unwrapped objects with the object being nil if it
+// does not exist.
+func (c CachedTokenFoundryMappings) Unwrap() (unwrappedOutputs []*TokenFoundryMapping) {
+	unwrappedOutputs = make([]*TokenFoundryMapping, len(c))
+	for i, cachedTokenFoundryMapping := range c {
+		untypedObject := cachedTokenFoundryMapping.Get()
+		if untypedObject == nil {
+			continue
+		}
+
+		typedObject := untypedObject.(*TokenFoundryMapping)
+		if typedObject == nil || typedObject.IsDeleted() {
+			continue
+		}
+
+		unwrappedOutputs[i] = typedObject
+	}
+
+	return
+}
+
+// Consume iterates over the CachedObjects, unwraps them and passes a type-casted version to the consumer (if the object
+// is not empty - it exists). It automatically releases the object when the consumer finishes. It returns true, if at
+// least one object was consumed.
+func (c CachedTokenFoundryMappings) Consume(consumer func(tokenFoundryMapping *TokenFoundryMapping), forceRelease ...bool) (consumed bool) {
+	for _, cachedTokenFoundryMapping := range c {
+		consumed = cachedTokenFoundryMapping.Consume(consumer, forceRelease...) || consumed
+	}
+
+	return
+}
+
+// Release is a utility function that allows us to release all CachedObjects in the collection.
+func (c CachedTokenFoundryMappings) Release(force ...bool) {
+	for _, cachedTokenFoundryMapping := range c {
+		cachedTokenFoundryMapping.Release(force...)
+	}
+}
+
+// String returns a human readable version of the CachedTokenFoundryMappings.
+func (c CachedTokenFoundryMappings) String() string {
+	structBuilder := stringify.StructBuilder("CachedTokenFoundryMappings")
+	for i, cachedTokenFoundryMapping := range c {
+		structBuilder.AddField(stringify.StructField(strconv.Itoa(i), cachedTokenFoundryMapping))
+	}
+
+	return structBuilder.String()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
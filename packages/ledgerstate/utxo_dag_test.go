@@ -1,6 +1,7 @@
 package ledgerstate
 
 import (
+	"bytes"
 	"math"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/iotaledger/hive.go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
 )
 
 var (
@@ -280,6 +282,28 @@ func TestBookTransaction(t *testing.T) {
 	assert.Equal(t, MasterBranchID, targetBranch)
 }
 
+func TestBookTransactionFoundry(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	wallets := createWallets(1)
+	input := generateOutput(utxoDAG, wallets[0].address, 0)
+
+	foundry, err := NewFoundryOutput(map[Color]uint64{ColorIOTA: 100}, randAliasAddress(), 1000, []byte("token"))
+	require.NoError(t, err)
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(NewUTXOInput(input.ID())), NewOutputs(foundry))
+	tx := NewTransaction(essence, wallets[0].unlockBlocks(essence))
+
+	tokenColor := Color(blake2b.Sum256(NewOutputID(tx.ID(), 0).Bytes()))
+	assert.False(t, utxoDAG.tokenFoundryExists(tokenColor))
+
+	// booking the transaction registers the token of the foundry without relying on the tangle
+	_, err = utxoDAG.BookTransaction(tx)
+	require.NoError(t, err)
+	assert.True(t, utxoDAG.tokenFoundryExists(tokenColor))
+}
+
 func TestBookInvalidTransaction(t *testing.T) {
 	branchDAG, utxoDAG := setupDependencies(t)
 	defer branchDAG.Shutdown()
@@ -744,6 +768,286 @@ func TestUTXODAG_CheckTransaction(t *testing.T) {
 	})
 }
 
+func TestUTXODAG_CheckTransactionDustProtection(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	w := genRandomWallet()
+	input := NewSigLockedSingleOutput(150, w.address)
+	input.SetID(randOutputID())
+	utxoDAG.outputStorage.Store(input).Release()
+	metadata := NewOutputMetadata(input.ID())
+	metadata.SetBranchID(MasterBranchID)
+	metadata.SetSolid(true)
+	utxoDAG.outputMetadataStorage.Store(metadata).Release()
+
+	activationTime := time.Now().Add(-time.Hour)
+	checkTransaction := func(timestamp time.Time, outputs ...Output) error {
+		essence := NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(outputs...))
+		return utxoDAG.CheckTransaction(NewTransaction(essence, UnlockBlocks{NewSignatureUnlockBlock(w.sign(essence))}))
+	}
+
+	t.Run("CASE: No protocol parameters", func(t *testing.T) {
+		assert.NoError(t, checkTransaction(activationTime, NewSigLockedSingleOutput(149, randEd25119Address()), NewSigLockedSingleOutput(1, randEd25119Address())))
+	})
+
+	require.NoError(t, utxoDAG.LoadSnapshot(&Snapshot{ProtocolParameters: &ProtocolParameters{
+		MinimumOutputDeposit:         100,
+		DustProtectionActivationTime: activationTime,
+	}}))
+
+	t.Run("CASE: Outputs hold the minimum deposit", func(t *testing.T) {
+		assert.NoError(t, checkTransaction(activationTime, NewSigLockedSingleOutput(150, randEd25119Address())))
+		assert.NoError(t, checkTransaction(activationTime, NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{ColorIOTA: 50, ColorMint: 100}), randEd25119Address())))
+	})
+
+	t.Run("CASE: Output below the minimum deposit", func(t *testing.T) {
+		assert.ErrorIs(t, checkTransaction(activationTime, NewSigLockedSingleOutput(149, randEd25119Address()), NewSigLockedSingleOutput(1, randEd25119Address())), ErrDustOutput)
+	})
+
+	t.Run("CASE: Output below the minimum deposit before the activation", func(t *testing.T) {
+		assert.NoError(t, checkTransaction(activationTime.Add(-time.Second), NewSigLockedSingleOutput(149, randEd25119Address()), NewSigLockedSingleOutput(1, randEd25119Address())))
+	})
+}
+
+func TestUTXODAG_ProtocolParameters(t *testing.T) {
+	protocolParameters := &ProtocolParameters{
+		MinimumOutputDeposit:         100,
+		DustProtectionActivationTime: time.Unix(1798761600, 0),
+	}
+
+	// the protocol parameters are distributed with the snapshot, while older snapshots without them can still be read
+	readSnapshot := func(snapshot *Snapshot) *Snapshot {
+		var buffer bytes.Buffer
+		_, err := snapshot.WriteTo(&buffer)
+		require.NoError(t, err)
+
+		readSnapshot := &Snapshot{}
+		_, err = readSnapshot.ReadFrom(&buffer)
+		require.NoError(t, err)
+		return readSnapshot
+	}
+	assert.Nil(t, readSnapshot(&Snapshot{}).ProtocolParameters)
+	assert.Equal(t, protocolParameters.Bytes(), readSnapshot(&Snapshot{ProtocolParameters: protocolParameters}).ProtocolParameters.Bytes())
+
+	// the protocol parameters survive a restart
+	store := mapdb.NewMapDB()
+	cacheTimeProvider := database.NewCacheTimeProvider(0)
+	branchDAG := NewBranchDAG(store, cacheTimeProvider)
+	defer branchDAG.Shutdown()
+	utxoDAG := NewUTXODAG(store, cacheTimeProvider, branchDAG)
+	assert.Nil(t, utxoDAG.ProtocolParameters())
+	require.NoError(t, utxoDAG.LoadSnapshot(&Snapshot{ProtocolParameters: protocolParameters}))
+	utxoDAG.Shutdown()
+
+	utxoDAG = NewUTXODAG(store, cacheTimeProvider, branchDAG)
+	defer utxoDAG.Shutdown()
+	assert.Equal(t, protocolParameters.Bytes(), utxoDAG.ProtocolParameters().Bytes())
+	assert.Equal(t, uint64(0), utxoDAG.ProtocolParameters().MinimumOutputDepositAt(time.Unix(1798761599, 0)))
+	assert.Equal(t, uint64(100), utxoDAG.ProtocolParameters().MinimumOutputDepositAt(time.Unix(1798761600, 0)))
+}
+
+func TestDustProtectionValid(t *testing.T) {
+	alias := &AliasOutput{
+		balances:         NewColoredBalances(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}),
+		aliasAddress:     *randAliasAddress(),
+		stateAddress:     randEd25119Address(),
+		governingAddress: randEd25119Address(),
+	}
+	outputs := NewOutputs(alias, NewExtendedLockedOutput(map[Color]uint64{ColorIOTA: 500, color1: 500}, randEd25119Address()))
+
+	assert.NoError(t, DustProtectionValid(outputs, 0))
+	assert.NoError(t, DustProtectionValid(outputs, 1000))
+	assert.ErrorIs(t, DustProtectionValid(outputs, 1001), ErrDustOutput)
+	assert.ErrorIs(t, DustProtectionValid(NewOutputs(NewSigLockedSingleOutput(1, randEd25119Address())), 2), ErrDustOutput)
+}
+
+func TestUTXODAG_CheckTransactionHashTimeLocked(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	w := genRandomWallet()
+	input := NewSigLockedSingleOutput(100, w.address)
+	input.SetID(randOutputID())
+	utxoDAG.outputStorage.Store(input).Release()
+	metadata := NewOutputMetadata(input.ID())
+	metadata.SetBranchID(MasterBranchID)
+	metadata.SetSolid(true)
+	utxoDAG.outputMetadataStorage.Store(metadata).Release()
+
+	now := time.Now()
+	checkTransaction := func(address Address, deadline time.Time, fallbackAddress Address) error {
+		output := NewHashTimeLockedOutput(map[Color]uint64{ColorIOTA: 100}, address, HashLock{}, deadline, fallbackAddress)
+		essence := NewTransactionEssence(0, now, identity.ID{}, identity.ID{}, NewInputs(input.Input()), NewOutputs(output))
+		return utxoDAG.CheckTransaction(NewTransaction(essence, UnlockBlocks{NewSignatureUnlockBlock(w.sign(essence))}))
+	}
+
+	t.Run("CASE: Valid hash time locked output", func(t *testing.T) {
+		assert.NoError(t, checkTransaction(randEd25119Address(), now.Add(time.Hour), randEd25119Address()))
+	})
+
+	t.Run("CASE: Deadline not after the transaction timestamp", func(t *testing.T) {
+		assert.ErrorIs(t, checkTransaction(randEd25119Address(), now, randEd25119Address()), ErrTransactionInvalid)
+		assert.ErrorIs(t, checkTransaction(randEd25119Address(), now.Add(-time.Hour), randEd25119Address()), ErrTransactionInvalid)
+	})
+
+	t.Run("CASE: Unsupported address types", func(t *testing.T) {
+		assert.ErrorIs(t, checkTransaction(randAliasAddress(), now.Add(time.Hour), randEd25119Address()), ErrTransactionInvalid)
+		assert.ErrorIs(t, checkTransaction(randEd25119Address(), now.Add(time.Hour), randAliasAddress()), ErrTransactionInvalid)
+	})
+}
+
+func TestUTXODAG_CheckTransactionFoundry(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+	defer utxoDAG.Shutdown()
+
+	w := genRandomWallet()
+	storeOutput := func(output Output) Output {
+		output.SetID(randOutputID())
+		utxoDAG.outputStorage.Store(output).Release()
+		metadata := NewOutputMetadata(output.ID())
+		metadata.SetBranchID(MasterBranchID)
+		metadata.SetSolid(true)
+		utxoDAG.outputMetadataStorage.Store(metadata).Release()
+		utxoDAG.ManageStoreAddressOutputMapping(output)
+		if foundry, isFoundry := output.(*FoundryOutput); isFoundry {
+			utxoDAG.StoreTokenFoundryMapping(foundry.TokenColor(), foundry.ID())
+		}
+
+		return output
+	}
+	checkTransaction := func(inputs Outputs, outputs ...Output) error {
+		inputsByID := inputs.ByID()
+		essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, inputs.Inputs(), NewOutputs(outputs...))
+		unlockBlocks := make(UnlockBlocks, len(essence.Inputs()))
+		aliasIndex := -1
+		for i, input := range essence.Inputs() {
+			if _, isAlias := inputsByID[input.(*UTXOInput).ReferencedOutputID()].(*AliasOutput); isAlias {
+				aliasIndex = i
+				unlockBlocks[i] = NewSignatureUnlockBlock(w.sign(essence))
+			}
+		}
+		for i, input := range essence.Inputs() {
+			switch inputsByID[input.(*UTXOInput).ReferencedOutputID()].(type) {
+			case *AliasOutput:
+			case *FoundryOutput:
+				unlockBlocks[i] = NewAliasUnlockBlock(uint16(aliasIndex))
+			default:
+				if aliasIndex == -1 {
+					aliasIndex = i
+					unlockBlocks[i] = NewSignatureUnlockBlock(w.sign(essence))
+					continue
+				}
+				unlockBlocks[i] = NewReferenceUnlockBlock(uint16(aliasIndex))
+			}
+		}
+
+		return utxoDAG.CheckTransaction(NewTransaction(essence, unlockBlocks))
+	}
+
+	alias := storeOutput(&AliasOutput{
+		balances:         NewColoredBalances(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}),
+		aliasAddress:     *randAliasAddress(),
+		stateAddress:     w.address,
+		governingAddress: randEd25119Address(),
+	}).(*AliasOutput)
+	funds := storeOutput(NewSigLockedSingleOutput(1000, w.address))
+
+	t.Run("CASE: Create foundry", func(t *testing.T) {
+		foundry, err := NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}, alias.GetAliasAddress(), 1000, []byte("token"))
+		require.NoError(t, err)
+		remainder := NewSigLockedSingleOutput(900, w.address)
+		assert.NoError(t, checkTransaction(Outputs{alias, funds}, alias.NewAliasOutputNext(), foundry, remainder))
+
+		// the alias has to perform a state transition
+		assert.Error(t, checkTransaction(Outputs{alias, funds}, alias.NewAliasOutputNext(true), foundry, remainder))
+		assert.Error(t, checkTransaction(Outputs{funds}, foundry, remainder))
+
+		// a new foundry can't start with a circulating supply
+		foundry.circulatingSupply = 100
+		assert.Error(t, checkTransaction(Outputs{alias, funds}, alias.NewAliasOutputNext(), foundry, remainder))
+	})
+
+	foundry, err := NewFoundryOutput(map[Color]uint64{ColorIOTA: DustThresholdAliasOutputIOTA}, alias.GetAliasAddress(), 1000, []byte("token"))
+	require.NoError(t, err)
+	foundry = storeOutput(foundry.SetID(randOutputID()).(*FoundryOutput).UpdateMintingColor()).(*FoundryOutput)
+	tokenColor := foundry.TokenColor()
+
+	t.Run("CASE: Mint tokens", func(t *testing.T) {
+		nextFoundry := foundry.NewFoundryOutputNext()
+		require.NoError(t, nextFoundry.SetCirculatingSupply(300))
+		tokens := NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{tokenColor: 300}), randEd25119Address())
+		remainder := NewSigLockedSingleOutput(700, w.address)
+		assert.NoError(t, checkTransaction(Outputs{alias, foundry, funds}, alias.NewAliasOutputNext(), nextFoundry, tokens, remainder))
+
+		// the minted tokens have to match the change of the circulating supply
+		assert.Error(t, checkTransaction(Outputs{alias, foundry, funds}, alias.NewAliasOutputNext(), nextFoundry, NewSigLockedSingleOutput(1000, w.address)))
+		assert.Error(t, checkTransaction(Outputs{alias, foundry, funds}, alias.NewAliasOutputNext(), foundry.NewFoundryOutputNext(), tokens, remainder))
+
+		// the circulating supply can't exceed the maximum supply
+		nextFoundry.circulatingSupply = 1001
+		tokens = NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{tokenColor: 1001}), randEd25119Address())
+		assert.Error(t, checkTransaction(Outputs{alias, foundry, funds, storeOutput(NewSigLockedSingleOutput(1, w.address))}, alias.NewAliasOutputNext(), nextFoundry, tokens))
+	})
+
+	circulatingFoundry := foundry.NewFoundryOutputNext()
+	require.NoError(t, circulatingFoundry.SetCirculatingSupply(300))
+	circulatingFoundry = storeOutput(circulatingFoundry).(*FoundryOutput)
+	tokens := storeOutput(NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{tokenColor: 300}), w.address))
+
+	t.Run("CASE: Burn tokens", func(t *testing.T) {
+		nextFoundry := circulatingFoundry.NewFoundryOutputNext()
+		require.NoError(t, nextFoundry.SetCirculatingSupply(200))
+		remainder := NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{tokenColor: 200, ColorIOTA: 100}), w.address)
+		assert.NoError(t, checkTransaction(Outputs{alias, circulatingFoundry, tokens}, alias.NewAliasOutputNext(), nextFoundry, remainder))
+
+		// the burned tokens have to match the change of the circulating supply
+		assert.Error(t, checkTransaction(Outputs{alias, circulatingFoundry, tokens}, alias.NewAliasOutputNext(), circulatingFoundry.NewFoundryOutputNext(), remainder))
+
+		// tokens of a foundry can only be burned by the foundry
+		assert.ErrorIs(t, checkTransaction(Outputs{tokens}, NewSigLockedSingleOutput(300, w.address)), ErrTransactionInvalid)
+		assert.NoError(t, checkTransaction(Outputs{tokens}, NewSigLockedColoredOutput(NewColoredBalances(map[Color]uint64{tokenColor: 300}), randEd25119Address())))
+	})
+
+	t.Run("CASE: Destroy foundry", func(t *testing.T) {
+		assert.NoError(t, checkTransaction(Outputs{alias, foundry}, alias.NewAliasOutputNext(), NewSigLockedSingleOutput(DustThresholdAliasOutputIOTA, w.address)))
+		assert.Error(t, checkTransaction(Outputs{alias, circulatingFoundry}, alias.NewAliasOutputNext(), NewSigLockedSingleOutput(DustThresholdAliasOutputIOTA, w.address)))
+	})
+}
+
+func TestUTXODAG_CheckConsistency(t *testing.T) {
+	branchDAG, utxoDAG := setupDependencies(t)
+	defer branchDAG.Shutdown()
+
+	wallets := createWallets(1)
+	essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		NewInputs(NewUTXOInput(NewOutputID(GenesisTransactionID, 0))),
+		NewOutputs(NewSigLockedSingleOutput(100, wallets[0].address)),
+	)
+	genesisTransaction := NewTransaction(essence, wallets[0].unlockBlocks(essence))
+	require.NoError(t, utxoDAG.LoadSnapshot(&Snapshot{Transactions: map[TransactionID]Record{
+		genesisTransaction.ID(): {Essence: essence, UnlockBlocks: genesisTransaction.UnlockBlocks(), UnspentOutputs: []bool{true}},
+	}}))
+	assert.NoError(t, utxoDAG.CheckConsistency())
+
+	tx := buildTransaction(utxoDAG, wallets[0], wallets[0], []*SigLockedSingleOutput{genesisTransaction.Essence().Outputs()[0].(*SigLockedSingleOutput)})
+	_, err := utxoDAG.BookTransaction(tx)
+	require.NoError(t, err)
+	assert.NoError(t, utxoDAG.CheckConsistency())
+
+	utxoDAG.transactionMetadataStorage.Delete(tx.ID().Bytes())
+	assert.ErrorIs(t, utxoDAG.CheckConsistency(), ErrInconsistentLedger)
+
+	// Outputs without a creating Transaction are inconsistent
+	otherBranchDAG, otherUTXODAG := setupDependencies(t)
+	defer otherBranchDAG.Shutdown()
+	generateOutput(otherUTXODAG, wallets[0].address, 0)
+	assert.ErrorIs(t, otherUTXODAG.CheckConsistency(), ErrInconsistentLedger)
+}
+
 func setupDependencies(t *testing.T) (*BranchDAG, *UTXODAG) {
 	store := mapdb.NewMapDB()
 	cacheTimeProvider := database.NewCacheTimeProvider(0)
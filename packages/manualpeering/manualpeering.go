@@ -16,12 +16,17 @@ import (
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/logger"
 
+	"github.com/iotaledger/goshimmer/packages/database"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 )
 
-const defaultReconnectInterval = 5 * time.Second
+const (
+	defaultReconnectInterval = 5 * time.Second
+	defaultResolveInterval   = time.Minute
+)
 
 // ConnectionDirection is an enum for the type of connection between local peer and the other peer in the gossip layer.
 type ConnectionDirection string
@@ -44,17 +49,26 @@ const (
 )
 
 // KnownPeerToAdd defines a type that is used in .AddPeer() method.
+// The address can either contain a literal IP or a DNS name, which is periodically re-resolved.
 type KnownPeerToAdd struct {
 	PublicKey ed25519.PublicKey `json:"publicKey"`
 	Address   string            `json:"address"`
+	Alias     string            `json:"alias,omitempty"`
 }
 
 // KnownPeer defines a peer record in the manual peering layer.
 type KnownPeer struct {
 	PublicKey     ed25519.PublicKey   `json:"publicKey"`
 	Address       string              `json:"address"`
+	Alias         string              `json:"alias,omitempty"`
 	ConnDirection ConnectionDirection `json:"connectionDirection"`
 	ConnStatus    ConnectionStatus    `json:"connectionStatus"`
+	// AddedAt is the time when the peer was first added to the list of known peers.
+	AddedAt time.Time `json:"addedAt"`
+	// LastConnected is the time when the last connection with the peer was established, nil if it never connected.
+	LastConnected *time.Time `json:"lastConnected,omitempty"`
+	// FailureCount is the number of consecutive failed connection attempts.
+	FailureCount uint32 `json:"failureCount"`
 }
 
 // Manager is the core entity in the manual peering package.
@@ -75,6 +89,8 @@ type Manager struct {
 	stopMutex         sync.RWMutex
 	isStopped         bool
 	reconnectInterval time.Duration
+	resolveInterval   time.Duration
+	store             kvstore.KVStore
 	knownPeersMutex   sync.RWMutex
 	knownPeers        map[identity.ID]*knownPeer
 
@@ -82,15 +98,38 @@ type Manager struct {
 	onGossipNeighborAddedClosure   *events.Closure
 }
 
+// ManagerOption defines a single option for the Manager.
+type ManagerOption func(m *Manager)
+
+// WithStore returns a ManagerOption that persists the list of known peers in the given store. The persisted peers are
+// loaded again when the manager is started.
+func WithStore(store kvstore.KVStore) ManagerOption {
+	return func(m *Manager) {
+		m.store = store.WithRealm([]byte{database.PrefixManualPeering})
+	}
+}
+
+// WithResolveInterval returns a ManagerOption that sets the interval in which the DNS names of known peers are
+// resolved again.
+func WithResolveInterval(interval time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.resolveInterval = interval
+	}
+}
+
 // NewManager initializes a new Manager instance.
-func NewManager(gm *gossip.Manager, local *peer.Local, log *logger.Logger) *Manager {
+func NewManager(gm *gossip.Manager, local *peer.Local, log *logger.Logger, opts ...ManagerOption) *Manager {
 	m := &Manager{
 		gm:                gm,
 		local:             local,
 		log:               log,
 		reconnectInterval: defaultReconnectInterval,
+		resolveInterval:   defaultResolveInterval,
 		knownPeers:        map[identity.ID]*knownPeer{},
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
 	m.onGossipNeighborRemovedClosure = events.NewClosure(m.onGossipNeighborRemoved)
 	m.onGossipNeighborAddedClosure = events.NewClosure(m.onGossipNeighborAdded)
 	return m
@@ -100,7 +139,7 @@ func NewManager(gm *gossip.Manager, local *peer.Local, log *logger.Logger) *Mana
 func (m *Manager) AddPeer(peers ...*KnownPeerToAdd) error {
 	var resultErr error
 	for _, p := range peers {
-		if err := m.addPeer(p); err != nil {
+		if err := m.addPeer(p, nil); err != nil {
 			resultErr = errors.CombineErrors(resultErr, err)
 		}
 	}
@@ -160,27 +199,50 @@ func (m *Manager) GetPeers(opts ...GetPeersOption) []*KnownPeer {
 	for _, kp := range m.knownPeers {
 		connStatus := kp.getConnStatus()
 		if !conf.OnlyConnected || connStatus == ConnStatusConnected {
-			peers = append(peers, &KnownPeer{
-				PublicKey:     kp.peer.PublicKey(),
-				Address:       kp.peerAddress,
+			record := kp.record()
+			knownPeer := &KnownPeer{
+				PublicKey:     record.publicKey,
+				Address:       record.address,
+				Alias:         record.alias,
 				ConnDirection: kp.connDirection,
 				ConnStatus:    connStatus,
-			})
+				AddedAt:       record.addedAt,
+				FailureCount:  record.failureCount,
+			}
+			if !record.lastConnected.IsZero() {
+				knownPeer.LastConnected = &record.lastConnected
+			}
+			peers = append(peers, knownPeer)
 		}
 	}
 	return peers
 }
 
-// Start subscribes to the gossip layer events and starts internal background workers.
-// Calling multiple times has no effect.
+// Start subscribes to the gossip layer events, starts internal background workers and adds the peers that were
+// persisted in the store. Calling multiple times has no effect.
 func (m *Manager) Start() {
 	m.startOnce.Do(func() {
 		m.gm.NeighborsEvents(gossip.NeighborsGroupManual).NeighborRemoved.Attach(m.onGossipNeighborRemovedClosure)
 		m.gm.NeighborsEvents(gossip.NeighborsGroupManual).NeighborAdded.Attach(m.onGossipNeighborAddedClosure)
 		m.isStarted.Set()
+		m.addStoredPeers()
 	})
 }
 
+func (m *Manager) addStoredPeers() {
+	records, err := m.loadPeers()
+	if err != nil {
+		m.log.Errorw("Failed to load the persisted known peers", "err", err)
+		return
+	}
+	for _, record := range records {
+		p := &KnownPeerToAdd{PublicKey: record.publicKey, Address: record.address, Alias: record.alias}
+		if err := m.addPeer(p, record); err != nil {
+			m.log.Errorw("Failed to add persisted known peer", "peer", p, "err", err)
+		}
+	}
+}
+
 // Stop terminates internal background workers. Calling multiple times has no effect.
 func (m *Manager) Stop() (err error) {
 	if !m.isStarted.IsSet() {
@@ -198,27 +260,34 @@ func (m *Manager) Stop() (err error) {
 }
 
 type knownPeer struct {
-	peer          *peer.Peer
+	publicKey     ed25519.PublicKey
+	id            identity.ID
 	peerAddress   string
+	alias         string
+	addedAt       time.Time
 	connDirection ConnectionDirection
 	connStatus    *atomic.Value
 	removeCh      chan struct{}
 	doneCh        chan struct{}
+
+	// the following fields change during the lifetime of the known peer and are guarded by mutex.
+	mutex         sync.RWMutex
+	peer          *peer.Peer
+	resolvedAt    time.Time
+	lastConnected time.Time
+	failureCount  uint32
 }
 
 func newKnownPeer(p *KnownPeerToAdd, connDirection ConnectionDirection) (*knownPeer, error) {
-	tcpAddress, err := net.ResolveTCPAddr("tcp", p.Address)
-	if err != nil {
+	if _, _, err := net.SplitHostPort(p.Address); err != nil {
 		return nil, errors.Wrap(err, "failed to parse peer address")
 	}
-	services := service.New()
-	// Peering key is required in order to initialize a peer,
-	// but it's not used in both manual peering and gossip layers so we just specify the default one.
-	services.Update(service.PeeringKey, "tcp", 14626)
-	services.Update(service.GossipKey, tcpAddress.Network(), tcpAddress.Port)
 	kp := &knownPeer{
-		peer:          peer.NewPeer(identity.New(p.PublicKey), tcpAddress.IP, services),
+		publicKey:     p.PublicKey,
+		id:            identity.NewID(p.PublicKey),
 		peerAddress:   p.Address,
+		alias:         p.Alias,
+		addedAt:       time.Now(),
 		connDirection: connDirection,
 		connStatus:    &atomic.Value{},
 		removeCh:      make(chan struct{}),
@@ -228,6 +297,86 @@ func newKnownPeer(p *KnownPeerToAdd, connDirection ConnectionDirection) (*knownP
 	return kp, nil
 }
 
+// hasDNSName returns true if the address of the peer contains a DNS name instead of a literal IP.
+func (kp *knownPeer) hasDNSName() bool {
+	host, _, _ := net.SplitHostPort(kp.peerAddress)
+	return net.ParseIP(host) == nil
+}
+
+// resolve resolves the address of the known peer and updates the underlying peer if its IP or port changed.
+func (kp *knownPeer) resolve() (changed bool, err error) {
+	tcpAddress, err := net.ResolveTCPAddr("tcp", kp.peerAddress)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to resolve peer address")
+	}
+
+	kp.mutex.Lock()
+	defer kp.mutex.Unlock()
+	kp.resolvedAt = time.Now()
+	if kp.peer != nil && kp.peer.IP().Equal(tcpAddress.IP) &&
+		kp.peer.Services().Get(service.GossipKey).Port() == tcpAddress.Port {
+		return false, nil
+	}
+	services := service.New()
+	// Peering key is required in order to initialize a peer,
+	// but it's not used in both manual peering and gossip layers so we just specify the default one.
+	services.Update(service.PeeringKey, "tcp", 14626)
+	services.Update(service.GossipKey, tcpAddress.Network(), tcpAddress.Port)
+	kp.peer = peer.NewPeer(identity.New(kp.publicKey), tcpAddress.IP, services)
+	return true, nil
+}
+
+// resolveDue returns true if the address of the known peer needs to be resolved (again).
+func (kp *knownPeer) resolveDue(resolveInterval time.Duration) bool {
+	kp.mutex.RLock()
+	defer kp.mutex.RUnlock()
+	if kp.peer == nil {
+		return true
+	}
+	return kp.hasDNSName() && time.Since(kp.resolvedAt) >= resolveInterval
+}
+
+func (kp *knownPeer) getPeer() *peer.Peer {
+	kp.mutex.RLock()
+	defer kp.mutex.RUnlock()
+	return kp.peer
+}
+
+// applyRecord restores the metadata of the known peer from a persisted record.
+func (kp *knownPeer) applyRecord(record *peerRecord) {
+	kp.mutex.Lock()
+	defer kp.mutex.Unlock()
+	kp.addedAt = record.addedAt
+	kp.lastConnected = record.lastConnected
+	kp.failureCount = record.failureCount
+}
+
+func (kp *knownPeer) record() *peerRecord {
+	kp.mutex.RLock()
+	defer kp.mutex.RUnlock()
+	return &peerRecord{
+		publicKey:     kp.publicKey,
+		address:       kp.peerAddress,
+		alias:         kp.alias,
+		addedAt:       kp.addedAt,
+		lastConnected: kp.lastConnected,
+		failureCount:  kp.failureCount,
+	}
+}
+
+func (kp *knownPeer) markConnected() {
+	kp.mutex.Lock()
+	defer kp.mutex.Unlock()
+	kp.lastConnected = time.Now()
+	kp.failureCount = 0
+}
+
+func (kp *knownPeer) markFailure() {
+	kp.mutex.Lock()
+	defer kp.mutex.Unlock()
+	kp.failureCount++
+}
+
 func (kp *knownPeer) getConnStatus() ConnectionStatus {
 	return kp.connStatus.Load().(ConnectionStatus)
 }
@@ -236,7 +385,7 @@ func (kp *knownPeer) setConnStatus(cs ConnectionStatus) {
 	kp.connStatus.Store(cs)
 }
 
-func (m *Manager) addPeer(p *KnownPeerToAdd) error {
+func (m *Manager) addPeer(p *KnownPeerToAdd, record *peerRecord) error {
 	if !m.isStarted.IsSet() {
 		return errors.New("manual peering manager hasn't been started yet")
 	}
@@ -245,8 +394,6 @@ func (m *Manager) addPeer(p *KnownPeerToAdd) error {
 	if m.isStopped {
 		return errors.New("manual peering manager was stopped")
 	}
-	m.knownPeersMutex.Lock()
-	defer m.knownPeersMutex.Unlock()
 	connDirection, err := m.connectionDirection(p.PublicKey)
 	if err != nil {
 		return errors.WithStack(err)
@@ -255,11 +402,36 @@ func (m *Manager) addPeer(p *KnownPeerToAdd) error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	if _, exists := m.knownPeers[kp.peer.ID()]; exists {
+	if !m.replacesKnownPeer(kp, record) {
 		return nil
 	}
-	m.log.Infow("Adding new peer to the list of known peers in manual peering", "peer", p)
-	m.knownPeers[kp.peer.ID()] = kp
+	if record != nil {
+		kp.applyRecord(record)
+	}
+	// resolve before taking the lock, so that slow DNS lookups don't block the other known peers
+	if _, err := kp.resolve(); err != nil {
+		if !kp.hasDNSName() {
+			return errors.WithStack(err)
+		}
+		m.log.Warnw("Failed to resolve the DNS name of the peer, retrying later", "peer", p, "err", err)
+	}
+
+	m.knownPeersMutex.Lock()
+	defer m.knownPeersMutex.Unlock()
+	if existing, exists := m.knownPeers[kp.id]; exists {
+		if !replacesPeer(existing, kp, record) {
+			return nil
+		}
+		m.log.Infow("Updating peer in the list of known peers in manual peering", "peer", p)
+		kp.addedAt = existing.record().addedAt
+		if err := m.removePeerByID(kp.id); err != nil {
+			return errors.WithStack(err)
+		}
+	} else {
+		m.log.Infow("Adding new peer to the list of known peers in manual peering", "peer", p)
+	}
+	m.knownPeers[kp.id] = kp
+	m.storePeer(kp)
 	go func() {
 		defer close(kp.doneCh)
 		m.keepPeerConnected(kp)
@@ -267,14 +439,30 @@ func (m *Manager) addPeer(p *KnownPeerToAdd) error {
 	return nil
 }
 
+// replacesKnownPeer returns true if the given peer is not known yet or replaces the known peer with the same identity.
+func (m *Manager) replacesKnownPeer(kp *knownPeer, record *peerRecord) bool {
+	m.knownPeersMutex.RLock()
+	defer m.knownPeersMutex.RUnlock()
+	existing, exists := m.knownPeers[kp.id]
+	return !exists || replacesPeer(existing, kp, record)
+}
+
+// replacesPeer returns true if the peer replaces the existing known peer. Persisted records never replace a known
+// peer, while explicitly added peers (e.g. from the config) replace it if their address or alias changed.
+func replacesPeer(existing, kp *knownPeer, record *peerRecord) bool {
+	return record == nil && (existing.peerAddress != kp.peerAddress || existing.alias != kp.alias)
+}
+
 func (m *Manager) removePeer(key ed25519.PublicKey) error {
 	m.knownPeersMutex.Lock()
 	defer m.knownPeersMutex.Unlock()
 	m.log.Infow("Removing peer from from the list of known peers in manual peering",
 		"publicKey", key)
 	peerID := identity.NewID(key)
-	err := m.removePeerByID(peerID)
-	return errors.WithStack(err)
+	if err := m.removePeerByID(peerID); err != nil {
+		return errors.WithStack(err)
+	}
+	return m.deleteStoredPeer(key)
 }
 
 func (m *Manager) removeAllKnownPeers() error {
@@ -314,24 +502,33 @@ func (m *Manager) keepPeerConnected(kp *knownPeer) {
 	ticker := time.NewTicker(m.reconnectInterval)
 	defer ticker.Stop()
 
-	peerID := kp.peer.ID()
+	peerID := kp.id
 	for {
-		if kp.getConnStatus() == ConnStatusDisconnected {
+		if kp.resolveDue(m.resolveInterval) {
+			if changed, err := kp.resolve(); err != nil {
+				m.log.Warnw("Failed to resolve the DNS name of the peer", "peerID", peerID, "address", kp.peerAddress, "err", err)
+			} else if changed {
+				m.log.Infow("Resolved the address of the peer", "peerID", peerID, "address", kp.peerAddress, "ip", kp.getPeer().IP())
+			}
+		}
+		if p := kp.getPeer(); p != nil && kp.getConnStatus() == ConnStatusDisconnected {
 			m.log.Infow(
 				"Peer is disconnected, calling gossip layer to establish the connection",
-				"peer", kp.peer, "connectionDirection", kp.connDirection,
+				"peer", p, "connectionDirection", kp.connDirection,
 			)
 			var err error
 			if kp.connDirection == ConnDirectionOutbound {
-				err = m.gm.AddOutbound(ctx, kp.peer, gossip.NeighborsGroupManual)
+				err = m.gm.AddOutbound(ctx, p, gossip.NeighborsGroupManual)
 			} else if kp.connDirection == ConnDirectionInbound {
-				err = m.gm.AddInbound(ctx, kp.peer, gossip.NeighborsGroupManual, gossip.WithNoDefaultTimeout())
+				err = m.gm.AddInbound(ctx, p, gossip.NeighborsGroupManual, gossip.WithNoDefaultTimeout())
 			}
 			if err != nil && !errors.Is(err, gossip.ErrDuplicateNeighbor) && !errors.Is(err, context.Canceled) {
 				m.log.Errorw(
 					"Failed to connect a neighbor in the gossip layer",
 					"peerID", peerID, "connectionDirection", kp.connDirection, "err", err,
 				)
+				kp.markFailure()
+				m.storePeer(kp)
 			}
 		}
 		select {
@@ -363,6 +560,10 @@ func (m *Manager) changeNeighborStatus(neighbor *gossip.Neighbor, connStatus Con
 		return
 	}
 	kp.setConnStatus(connStatus)
+	if connStatus == ConnStatusConnected {
+		kp.markConnected()
+		m.storePeer(kp)
+	}
 }
 
 func (m *Manager) connectionDirection(peerPK ed25519.PublicKey) (ConnectionDirection, error) {
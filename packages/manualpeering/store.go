@@ -0,0 +1,111 @@
+package manualpeering
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+)
+
+// peerRecord is the persisted representation of a known peer.
+type peerRecord struct {
+	publicKey     ed25519.PublicKey
+	address       string
+	alias         string
+	addedAt       time.Time
+	lastConnected time.Time
+	failureCount  uint32
+}
+
+// bytes returns a marshaled version of the peerRecord.
+func (r *peerRecord) bytes() []byte {
+	return marshalutil.New().
+		WriteBytes(r.publicKey.Bytes()).
+		WriteUint16(uint16(len(r.address))).
+		WriteBytes([]byte(r.address)).
+		WriteUint16(uint16(len(r.alias))).
+		WriteBytes([]byte(r.alias)).
+		WriteTime(r.addedAt).
+		WriteTime(r.lastConnected).
+		WriteUint32(r.failureCount).
+		Bytes()
+}
+
+// peerRecordFromBytes unmarshals a peerRecord from a sequence of bytes.
+func peerRecordFromBytes(data []byte) (record *peerRecord, err error) {
+	marshalUtil := marshalutil.New(data)
+	record = &peerRecord{}
+	if record.publicKey, err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+	if record.address, err = readString(marshalUtil); err != nil {
+		return nil, errors.Wrap(err, "failed to parse address")
+	}
+	if record.alias, err = readString(marshalUtil); err != nil {
+		return nil, errors.Wrap(err, "failed to parse alias")
+	}
+	if record.addedAt, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Wrap(err, "failed to parse added at time")
+	}
+	if record.lastConnected, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Wrap(err, "failed to parse last connected time")
+	}
+	if record.failureCount, err = marshalUtil.ReadUint32(); err != nil {
+		return nil, errors.Wrap(err, "failed to parse failure count")
+	}
+	return record, nil
+}
+
+func readString(marshalUtil *marshalutil.MarshalUtil) (string, error) {
+	length, err := marshalUtil.ReadUint16()
+	if err != nil {
+		return "", err
+	}
+	data, err := marshalUtil.ReadBytes(int(length))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// storePeer persists the current state of the given known peer. It is a no-op if the manager has no store.
+func (m *Manager) storePeer(kp *knownPeer) {
+	if m.store == nil {
+		return
+	}
+	if err := m.store.Set(kp.publicKey.Bytes(), kp.record().bytes()); err != nil {
+		m.log.Errorw("Failed to persist known peer", "publicKey", kp.publicKey, "err", err)
+	}
+}
+
+// deleteStoredPeer removes the peer with the given public key from the store.
+func (m *Manager) deleteStoredPeer(key ed25519.PublicKey) error {
+	if m.store == nil {
+		return nil
+	}
+	if err := m.store.Delete(key.Bytes()); err != nil && !errors.Is(err, kvstore.ErrKeyNotFound) {
+		return errors.Wrapf(err, "failed to delete known peer %s from the store", key)
+	}
+	return nil
+}
+
+// loadPeers returns all the peer records that were persisted in the store.
+func (m *Manager) loadPeers() (records []*peerRecord, err error) {
+	if m.store == nil {
+		return nil, nil
+	}
+	if err = m.store.Iterate(kvstore.EmptyPrefix, func(_ kvstore.Key, value kvstore.Value) bool {
+		record, parseErr := peerRecordFromBytes(value)
+		if parseErr != nil {
+			m.log.Warnw("Failed to parse persisted known peer, skipping it", "err", parseErr)
+			return true
+		}
+		records = append(records, record)
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate over the persisted known peers")
+	}
+	return records, nil
+}
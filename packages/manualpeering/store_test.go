@@ -0,0 +1,111 @@
+package manualpeering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerRecordBytes(t *testing.T) {
+	record := &peerRecord{
+		publicKey:     ed25519.GenerateKeyPair().PublicKey,
+		address:       "node.example.com:14666",
+		alias:         "node",
+		addedAt:       time.Unix(1000, 0),
+		lastConnected: time.Unix(2000, 0),
+		failureCount:  3,
+	}
+
+	restored, err := peerRecordFromBytes(record.bytes())
+	require.NoError(t, err)
+	assert.Equal(t, record.publicKey, restored.publicKey)
+	assert.Equal(t, record.address, restored.address)
+	assert.Equal(t, record.alias, restored.alias)
+	assert.True(t, record.addedAt.Equal(restored.addedAt))
+	assert.True(t, record.lastConnected.Equal(restored.lastConnected))
+	assert.Equal(t, record.failureCount, restored.failureCount)
+
+	_, err = peerRecordFromBytes(record.bytes()[:40])
+	assert.Error(t, err)
+}
+
+func TestManagerStore(t *testing.T) {
+	m := NewManager(nil, nil, logger.NewExampleLogger("manualpeering"), WithStore(mapdb.NewMapDB()))
+
+	kp, err := newKnownPeer(&KnownPeerToAdd{
+		PublicKey: ed25519.GenerateKeyPair().PublicKey,
+		Address:   "127.0.0.1:14666",
+		Alias:     "local",
+	}, ConnDirectionOutbound)
+	require.NoError(t, err)
+	kp.markFailure()
+	kp.markFailure()
+	m.storePeer(kp)
+
+	records, err := m.loadPeers()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "local", records[0].alias)
+	assert.EqualValues(t, 2, records[0].failureCount)
+	assert.True(t, records[0].lastConnected.IsZero())
+
+	kp.markConnected()
+	m.storePeer(kp)
+	records, err = m.loadPeers()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Zero(t, records[0].failureCount)
+	assert.False(t, records[0].lastConnected.IsZero())
+
+	require.NoError(t, m.deleteStoredPeer(kp.publicKey))
+	records, err = m.loadPeers()
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestKnownPeerResolve(t *testing.T) {
+	_, err := newKnownPeer(&KnownPeerToAdd{Address: "127.0.0.1"}, ConnDirectionOutbound)
+	assert.Error(t, err)
+
+	kp, err := newKnownPeer(&KnownPeerToAdd{PublicKey: ed25519.GenerateKeyPair().PublicKey, Address: "127.0.0.1:14666"}, ConnDirectionOutbound)
+	require.NoError(t, err)
+	assert.False(t, kp.hasDNSName())
+	assert.True(t, kp.resolveDue(time.Minute))
+
+	changed, err := kp.resolve()
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "127.0.0.1", kp.getPeer().IP().String())
+	assert.False(t, kp.resolveDue(0))
+
+	changed, err = kp.resolve()
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	kp, err = newKnownPeer(&KnownPeerToAdd{PublicKey: ed25519.GenerateKeyPair().PublicKey, Address: "localhost:14666"}, ConnDirectionOutbound)
+	require.NoError(t, err)
+	assert.True(t, kp.hasDNSName())
+}
+
+func TestReplacesPeer(t *testing.T) {
+	publicKey := ed25519.GenerateKeyPair().PublicKey
+	newPeer := func(address, alias string) *knownPeer {
+		kp, err := newKnownPeer(&KnownPeerToAdd{PublicKey: publicKey, Address: address, Alias: alias}, ConnDirectionOutbound)
+		require.NoError(t, err)
+		return kp
+	}
+	existing := newPeer("127.0.0.1:14666", "node")
+
+	// persisted records never replace a known peer
+	assert.False(t, replacesPeer(existing, newPeer("127.0.0.2:14666", "node"), existing.record()))
+	// unchanged peers are not replaced
+	assert.False(t, replacesPeer(existing, newPeer("127.0.0.1:14666", "node"), nil))
+	// changed config entries replace the known peer
+	assert.True(t, replacesPeer(existing, newPeer("127.0.0.2:14666", "node"), nil))
+	assert.True(t, replacesPeer(existing, newPeer("127.0.0.1:14666", "other"), nil))
+}
@@ -300,6 +300,23 @@ func (m *Manager) UnregisterSequenceAliasMapping(sequenceAlias SequenceAlias, se
 	return
 }
 
+// ExportCachedObjects adds the objects that are kept in the caches of the Manager and its SequenceID counter to the
+// given CachedObjects.
+func (m *Manager) ExportCachedObjects(cachedObjects *database.CachedObjects) (err error) {
+	m.sequenceIDCounterMutex.Lock()
+	sequenceIDCounter := m.sequenceIDCounter
+	m.sequenceIDCounterMutex.Unlock()
+	if err = cachedObjects.Set(kvstore.Key("sequenceIDCounter"), sequenceIDCounter.Bytes()); err != nil {
+		return errors.Errorf("failed to export sequenceIDCounter: %w", err)
+	}
+
+	if err = database.ExportCachedObjects(cachedObjects, []byte{database.PrefixMarkers, PrefixSequence}, m.sequenceStore); err != nil {
+		return err
+	}
+
+	return database.ExportCachedObjects(cachedObjects, []byte{database.PrefixMarkers, PrefixSequenceAliasMapping}, m.sequenceAliasMappingStore)
+}
+
 // Shutdown shuts down the Manager and persists its state.
 func (m *Manager) Shutdown() {
 	m.shutdownOnce.Do(func() {
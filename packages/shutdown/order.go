@@ -9,6 +9,11 @@ const (
 	PriorityMana
 	// PriorityTangle defines the shutdown priority for the tangle.
 	PriorityTangle
+	// PriorityEpochs defines the shutdown priority for the epochs plugin.
+	PriorityEpochs
+	// PriorityDatabaseCheckpoints defines the shutdown priority for the periodic database checkpoints. They are stopped
+	// before the components whose cached objects are added to the checkpoints.
+	PriorityDatabaseCheckpoints
 	// PriorityDRNG defines the shutdown priority for dRNG.
 	PriorityDRNG
 	// PriorityFaucet defines the shutdown priority for the faucet.
@@ -19,6 +24,8 @@ const (
 	PriorityAnalysis
 	// PriorityPrometheus defines the shutdown priority for prometheus.
 	PriorityPrometheus
+	// PriorityTracing defines the shutdown priority for the tracing plugin.
+	PriorityTracing
 	// PriorityMetrics defines the shutdown priority for metrics server.
 	PriorityMetrics
 	// PriorityGossip defines the shutdown priority for gossip.
@@ -372,7 +372,7 @@ func (b *Booker) bookPayload(message *Message) (branchID ledgerstate.BranchID, e
 
 	transaction := payload.(*ledgerstate.Transaction)
 
-	if transactionErr := b.tangle.LedgerState.TransactionValid(transaction, message.ID()); transactionErr != nil {
+	if transactionErr := b.tangle.LedgerState.TransactionValid(transaction, message); transactionErr != nil {
 		return ledgerstate.UndefinedBranchID, errors.Errorf("invalid transaction in message with %s: %w", message.ID(), transactionErr)
 	}
 
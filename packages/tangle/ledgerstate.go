@@ -65,16 +65,17 @@ func (l *LedgerState) InheritBranch(referencedBranchIDs ledgerstate.BranchIDs) (
 	return
 }
 
-// TransactionValid performs some fast checks of the Transaction and triggers a MessageInvalid event if the checks do
-// not pass.
-func (l *LedgerState) TransactionValid(transaction *ledgerstate.Transaction, messageID MessageID) (err error) {
-	if err = l.UTXODAG.CheckTransaction(transaction); err != nil {
-		l.tangle.Storage.MessageMetadata(messageID).Consume(func(messagemetadata *MessageMetadata) {
+// TransactionValid performs some fast checks of the Transaction attached by the given Message and triggers a
+// MessageInvalid event if the checks do not pass. An attachment issued after the end of the validity window of the
+// Transaction is invalid, even if the Transaction was already booked through an earlier attachment.
+func (l *LedgerState) TransactionValid(transaction *ledgerstate.Transaction, message *Message) (err error) {
+	if err = l.CheckTransactionAt(transaction, message.IssuingTime()); err != nil {
+		l.tangle.Storage.MessageMetadata(message.ID()).Consume(func(messagemetadata *MessageMetadata) {
 			messagemetadata.SetInvalid(true)
 		})
-		l.tangle.Events.MessageInvalid.Trigger(&MessageInvalidEvent{MessageID: messageID, Error: err})
+		l.tangle.Events.MessageInvalid.Trigger(&MessageInvalidEvent{MessageID: message.ID(), Error: err})
 
-		return errors.Errorf("invalid transaction in message with %s: %w", messageID, err)
+		return errors.Errorf("invalid transaction in message with %s: %w", message.ID(), err)
 	}
 
 	return nil
@@ -141,7 +142,9 @@ func (l *LedgerState) BranchID(transactionID ledgerstate.TransactionID) (branchI
 
 // LoadSnapshot creates a set of outputs in the UTXO-DAG, that are forming the genesis for future transactions.
 func (l *LedgerState) LoadSnapshot(snapshot *ledgerstate.Snapshot) (err error) {
-	l.UTXODAG.LoadSnapshot(snapshot)
+	if err = l.UTXODAG.LoadSnapshot(snapshot); err != nil {
+		return err
+	}
 	// add attachment link between txs from snapshot and the genesis message (EmptyMessageID).
 	for txID, record := range snapshot.Transactions {
 		attachment, _ := l.tangle.Storage.StoreAttachment(txID, EmptyMessageID)
@@ -171,7 +174,8 @@ func (l *LedgerState) SnapshotUTXO() (snapshot *ledgerstate.Snapshot) {
 	// We can snapshot this far in the past, since global snapshots dont occur frequent and it is ok to ignore the last few minutes.
 	minAge := 120 * time.Second
 	snapshot = &ledgerstate.Snapshot{
-		Transactions: make(map[ledgerstate.TransactionID]ledgerstate.Record),
+		Transactions:       make(map[ledgerstate.TransactionID]ledgerstate.Record),
+		ProtocolParameters: l.UTXODAG.ProtocolParameters(),
 	}
 
 	startSnapshot := time.Now()
@@ -258,11 +262,29 @@ func (l *LedgerState) CachedOutputsOnAddress(address ledgerstate.Address) (cache
 	return
 }
 
+// CachedFoundriesOfToken retrieves all the FoundryOutputs that control the supply of the token with the given Color.
+func (l *LedgerState) CachedFoundriesOfToken(color ledgerstate.Color) (cachedOutputs ledgerstate.CachedOutputs) {
+	l.UTXODAG.CachedTokenFoundryMapping(color).Consume(func(tokenFoundryMapping *ledgerstate.TokenFoundryMapping) {
+		cachedOutputs = append(cachedOutputs, l.CachedOutput(tokenFoundryMapping.OutputID()))
+	})
+	return
+}
+
 // CheckTransaction contains fast checks that have to be performed before booking a Transaction.
 func (l *LedgerState) CheckTransaction(transaction *ledgerstate.Transaction) (err error) {
 	return l.UTXODAG.CheckTransaction(transaction)
 }
 
+// CheckTransactionAt contains the checks of CheckTransaction and additionally checks that a Message issued at the given
+// time can still attach the Transaction.
+func (l *LedgerState) CheckTransactionAt(transaction *ledgerstate.Transaction, issuingTime time.Time) (err error) {
+	if transaction.Essence().Expired(issuingTime) {
+		return errors.Errorf("transaction with %s is only valid until %s: %w", transaction.ID(), transaction.Essence().ValidUntil(), ledgerstate.ErrTransactionExpired)
+	}
+
+	return l.UTXODAG.CheckTransaction(transaction)
+}
+
 // ConsumedOutputs returns the consumed (cached)Outputs of the given Transaction.
 func (l *LedgerState) ConsumedOutputs(transaction *ledgerstate.Transaction) (cachedInputs ledgerstate.CachedOutputs) {
 	return l.UTXODAG.ConsumedOutputs(transaction)
@@ -481,6 +481,7 @@ type TransactionFilter struct {
 }
 
 // Filter compares the timestamps between the message and it's transaction payload and calls the corresponding callback.
+// Messages issued after the end of the validity window of their transaction are rejected.
 func (f *TransactionFilter) Filter(msg *Message, peer *peer.Peer) {
 	if payload := msg.Payload(); payload.Type() == ledgerstate.TransactionType {
 		transaction, _, err := ledgerstate.TransactionFromBytes(payload.Bytes())
@@ -492,6 +493,10 @@ func (f *TransactionFilter) Filter(msg *Message, peer *peer.Peer) {
 			f.getRejectCallback()(msg, ErrInvalidMessageAndTransactionTimestamp, peer)
 			return
 		}
+		if transaction.Essence().Expired(msg.IssuingTime()) {
+			f.getRejectCallback()(msg, errors.Errorf("transaction with %s is only valid until %s: %w", transaction.ID(), transaction.Essence().ValidUntil(), ledgerstate.ErrTransactionExpired), peer)
+			return
+		}
 	}
 	f.getAcceptCallback()(msg, peer)
 }
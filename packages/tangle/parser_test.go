@@ -8,6 +8,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/marshalutil"
@@ -86,6 +87,22 @@ func TestTransactionFilter_Filter(t *testing.T) {
 		m.On("Reject", msg, mock.MatchedBy(func(err error) bool { return err != nil }), testPeer)
 		filter.Filter(msg, testPeer)
 	})
+
+	t.Run("accept transaction within its validity window", func(t *testing.T) {
+		tx := newExpiringTransaction(time.Now(), time.Now().Add(time.Minute))
+		msg := &Message{payload: tx, issuingTime: tx.Essence().ValidUntil()}
+		m.On("Accept", msg, testPeer)
+		filter.Filter(msg, testPeer)
+	})
+
+	t.Run("reject expired transaction", func(t *testing.T) {
+		tx := newExpiringTransaction(time.Now(), time.Now().Add(time.Minute))
+		msg := &Message{payload: tx, issuingTime: tx.Essence().ValidUntil().Add(time.Millisecond)}
+		m.On("Reject", msg, mock.MatchedBy(func(err error) bool { return errors.Is(err, ledgerstate.ErrTransactionExpired) }), testPeer)
+		filter.Filter(msg, testPeer)
+	})
+
+	m.AssertExpectations(t)
 }
 
 func Test_isMessageAndTransactionTimestampsValid(t *testing.T) {
@@ -215,3 +232,13 @@ func newTransaction(t time.Time) *ledgerstate.Transaction {
 	var unlockBlocks ledgerstate.UnlockBlocks
 	return ledgerstate.NewTransaction(essence, unlockBlocks)
 }
+
+func newExpiringTransaction(timestamp, validUntil time.Time) *ledgerstate.Transaction {
+	keyPair := ed25519.GenerateKeyPair()
+	input := ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))
+	output := ledgerstate.NewSigLockedSingleOutput(100, ledgerstate.NewED25519Address(keyPair.PublicKey))
+	essence := ledgerstate.NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, ledgerstate.NewInputs(input), ledgerstate.NewOutputs(output)).WithValidUntil(validUntil)
+	signature := ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))
+
+	return ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(signature)})
+}
@@ -419,6 +419,30 @@ func (s *Storage) deleteWeakApprover(approvedMessageID MessageID, approvingMessa
 	s.approverStorage.Delete(byteutils.ConcatBytes(approvedMessageID.Bytes(), WeakApprover.Bytes(), approvingMessage.Bytes()))
 }
 
+// ExportCachedObjects adds the objects that are kept in the caches of the Storage to the given CachedObjects.
+func (s *Storage) ExportCachedObjects(cachedObjects *database.CachedObjects) (err error) {
+	for prefix, storage := range map[byte]*objectstorage.ObjectStorage{
+		PrefixMessage:                   s.messageStorage,
+		PrefixMessageMetadata:           s.messageMetadataStorage,
+		PrefixApprovers:                 s.approverStorage,
+		PrefixMissingMessage:            s.missingMessageStorage,
+		PrefixAttachments:               s.attachmentStorage,
+		PrefixMarkerBranchIDMapping:     s.markerIndexBranchIDMappingStorage,
+		PrefixIndividuallyMappedMessage: s.individuallyMappedMessageStorage,
+		PrefixSequenceSupporters:        s.sequenceSupportersStorage,
+		PrefixBranchSupporters:          s.branchSupportersStorage,
+		PrefixStatement:                 s.statementStorage,
+		PrefixBranchWeight:              s.branchWeightStorage,
+		PrefixMarkerMessageMapping:      s.markerMessageMappingStorage,
+	} {
+		if err = database.ExportCachedObjects(cachedObjects, []byte{database.PrefixTangle, prefix}, storage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Shutdown marks the tangle as stopped, so it will not accept any new messages (waits for all backgroundTasks to finish).
 func (s *Storage) Shutdown() {
 	s.messageStorage.Shutdown()
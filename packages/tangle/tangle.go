@@ -16,6 +16,7 @@ import (
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/mr-tron/base58"
 
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/markers"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 )
@@ -65,9 +66,10 @@ type ConfirmationOracle interface {
 
 // ConfirmationEvents are events entailing confirmation.
 type ConfirmationEvents struct {
-	MessageConfirmed     *events.Event
-	BranchConfirmed      *events.Event
-	TransactionConfirmed *events.Event
+	MessageConfirmed      *events.Event
+	BranchConfirmed       *events.Event
+	TransactionConfirmed  *events.Event
+	TransactionGoFChanged *events.Event
 }
 
 // New is the constructor for the Tangle.
@@ -169,6 +171,22 @@ func (t *Tangle) Prune() (err error) {
 	return t.Storage.Prune()
 }
 
+// ExportCachedObjects adds the objects that are kept in the caches of the object storages of the Tangle to the given
+// CachedObjects, e.g. to complete a checkpoint of the database.
+func (t *Tangle) ExportCachedObjects(cachedObjects *database.CachedObjects) (err error) {
+	if err = t.Storage.ExportCachedObjects(cachedObjects); err != nil {
+		return err
+	}
+	if err = t.LedgerState.BranchDAG.ExportCachedObjects(cachedObjects); err != nil {
+		return err
+	}
+	if err = t.LedgerState.UTXODAG.ExportCachedObjects(cachedObjects); err != nil {
+		return err
+	}
+
+	return t.Booker.MarkersManager.Manager.ExportCachedObjects(cachedObjects)
+}
+
 // Shutdown marks the tangle as stopped, so it will not accept any new messages (waits for all backgroundTasks to finish).
 func (t *Tangle) Shutdown() {
 	t.Requester.Shutdown()
@@ -232,6 +250,12 @@ func MessageCaller(handler interface{}, params ...interface{}) {
 	handler.(func(*Message))(params[0].(*Message))
 }
 
+// TransactionGoFChangedCaller is the caller function for events that hand over a TransactionID and its new
+// gof.GradeOfFinality.
+func TransactionGoFChangedCaller(handler interface{}, params ...interface{}) {
+	handler.(func(ledgerstate.TransactionID, gof.GradeOfFinality))(params[0].(ledgerstate.TransactionID), params[1].(gof.GradeOfFinality))
+}
+
 // MessageInvalidCaller is the caller function for events that had over an invalid message.
 func MessageInvalidCaller(handler interface{}, params ...interface{}) {
 	handler.(func(ev *MessageInvalidEvent))(params[0].(*MessageInvalidEvent))
@@ -813,9 +813,10 @@ func (m *MockConfirmationOracle) IsOutputConfirmed(outputID ledgerstate.OutputID
 // Events mocks its interface function.
 func (m *MockConfirmationOracle) Events() *ConfirmationEvents {
 	return &ConfirmationEvents{
-		MessageConfirmed:     events.NewEvent(nil),
-		TransactionConfirmed: events.NewEvent(nil),
-		BranchConfirmed:      events.NewEvent(nil),
+		MessageConfirmed:      events.NewEvent(nil),
+		TransactionConfirmed:  events.NewEvent(nil),
+		BranchConfirmed:       events.NewEvent(nil),
+		TransactionGoFChanged: events.NewEvent(nil),
 	}
 }
 
@@ -0,0 +1,199 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ServiceName is the name of the service the exported spans are attributed to.
+const ServiceName = "goshimmer"
+
+// SpanExporter exports the spans of finished traces.
+type SpanExporter interface {
+	// ExportSpans exports the given spans.
+	ExportSpans(spans []*Span) error
+	// Shutdown flushes and closes the exporter.
+	Shutdown() error
+}
+
+// region FileExporter /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// FileExporter is a SpanExporter that appends the spans to a file, one OTLP/JSON encoded request per line.
+type FileExporter struct {
+	file  *os.File
+	mutex sync.Mutex
+}
+
+// NewFileExporter creates a FileExporter that appends to the file at the given path.
+func NewFileExporter(path string) (*FileExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open trace file %s", path)
+	}
+	return &FileExporter{file: file}, nil
+}
+
+// ExportSpans writes the given spans to the file.
+func (f *FileExporter) ExportSpans(spans []*Span) error {
+	data, err := MarshalOTLPJSON(spans)
+	if err != nil {
+		return err
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if _, err = f.file.Write(append(data, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write spans")
+	}
+	return nil
+}
+
+// Shutdown closes the file.
+func (f *FileExporter) Shutdown() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.file.Close()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OTLPHTTPExporter /////////////////////////////////////////////////////////////////////////////////////////////
+
+// OTLPHTTPExporter is a SpanExporter that sends the spans to an OpenTelemetry collector using OTLP/HTTP with JSON
+// encoding.
+type OTLPHTTPExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewOTLPHTTPExporter creates an OTLPHTTPExporter that sends the spans to the given endpoint, e.g.
+// http://localhost:4318/v1/traces.
+func NewOTLPHTTPExporter(endpoint string) *OTLPHTTPExporter {
+	return &OTLPHTTPExporter{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ExportSpans sends the given spans to the collector.
+func (o *OTLPHTTPExporter) ExportSpans(spans []*Span) error {
+	data, err := MarshalOTLPJSON(spans)
+	if err != nil {
+		return err
+	}
+
+	res, err := o.client.Post(o.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "failed to send spans to the collector")
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("collector responded with status %s", res.Status)
+	}
+	return nil
+}
+
+// Shutdown releases the idle connections to the collector.
+func (o *OTLPHTTPExporter) Shutdown() error {
+	o.client.CloseIdleConnections()
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OTLP/JSON encoding ///////////////////////////////////////////////////////////////////////////////////////////
+
+// MarshalOTLPJSON encodes the given spans as an OTLP ExportTraceServiceRequest in the JSON encoding.
+func MarshalOTLPJSON(spans []*Span) ([]byte, error) {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		otlpSpans[i] = otlpSpan{
+			TraceID:           hex.EncodeToString(span.TraceID[:]),
+			SpanID:            hex.EncodeToString(span.SpanID[:]),
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+			Attributes:        otlpAttributes(span.Attributes),
+		}
+		if span.ParentSpanID != (SpanID{}) {
+			otlpSpans[i].ParentSpanID = hex.EncodeToString(span.ParentSpanID[:])
+		}
+	}
+
+	data, err := json.Marshal(otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{Attributes: otlpAttributes(map[string]string{"service.name": ServiceName})},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/iotaledger/goshimmer/packages/tracing"},
+				Spans: otlpSpans,
+			}},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode spans: %w", err)
+	}
+	return data, nil
+}
+
+const otlpSpanKindInternal = 1
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func otlpAttributes(attributes map[string]string) (keyValues []otlpKeyValue) {
+	for key, value := range attributes {
+		keyValues = append(keyValues, otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}})
+	}
+	return keyValues
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
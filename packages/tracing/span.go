@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	statusConfirmed = "confirmed"
+	statusDiscarded = "discarded"
+	statusInvalid   = "invalid"
+	statusExpired   = "expired"
+
+	// rootSpanName is the name of the span that covers the whole lifecycle of a message.
+	rootSpanName = "message"
+)
+
+// TraceID is the identifier of the trace of a message. It is derived from the message ID.
+type TraceID [16]byte
+
+// SpanID is the identifier of a span.
+type SpanID [8]byte
+
+// Span is a timed operation within the trace of a message.
+type Span struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	Name         string
+	Start        time.Time
+	End          time.Time
+	Attributes   map[string]string
+}
+
+// newRootSpan returns the span covering the whole traced lifecycle of a message.
+func newRootSpan(messageID tangle.MessageID, status string, start, end time.Time) *Span {
+	return &Span{
+		TraceID: traceID(messageID),
+		SpanID:  spanID(messageID, rootSpanName),
+		Name:    rootSpanName,
+		Start:   start,
+		End:     end,
+		Attributes: map[string]string{
+			"message.id": messageID.Base58(),
+			"status":     status,
+		},
+	}
+}
+
+// newStageSpan returns the span of the given stage of a message.
+func newStageSpan(messageID tangle.MessageID, stage Stage, start, end time.Time) *Span {
+	return &Span{
+		TraceID:      traceID(messageID),
+		SpanID:       spanID(messageID, string(stage)),
+		ParentSpanID: spanID(messageID, rootSpanName),
+		Name:         string(stage),
+		Start:        start,
+		End:          end,
+	}
+}
+
+func traceID(messageID tangle.MessageID) (id TraceID) {
+	copy(id[:], messageID[:])
+	return id
+}
+
+func spanID(messageID tangle.MessageID, name string) (id SpanID) {
+	hash := sha256.Sum256(append(messageID.Bytes(), name...))
+	copy(id[:], hash[:])
+	return id
+}
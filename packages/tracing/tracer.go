@@ -0,0 +1,307 @@
+package tracing
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Stage ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Stage is a step in the lifecycle of a message that is traced.
+type Stage string
+
+const (
+	// StageParsed is reached when a message received from a neighbor was parsed.
+	StageParsed Stage = "parsed"
+	// StageStored is reached when a message was stored. It is the first stage of messages issued by the node itself.
+	StageStored Stage = "stored"
+	// StageSolid is reached when the past cone of a message is known.
+	StageSolid Stage = "solid"
+	// StageScheduled is reached when the scheduler scheduled a message.
+	StageScheduled Stage = "scheduled"
+	// StageOrdered is reached when all parents of a message were ordered. The message is gossiped at this point.
+	StageOrdered Stage = "ordered"
+	// StageBooked is reached when a message was booked.
+	StageBooked Stage = "booked"
+	// StageWeighted is reached when the ApprovalWeightManager processed a message.
+	StageWeighted Stage = "weighted"
+	// StageConfirmed is reached when the finality gadget confirmed a message. It is the last stage.
+	StageConfirmed Stage = "confirmed"
+)
+
+// Stages contains all the stages in the order they are passed by a message.
+var Stages = []Stage{StageParsed, StageStored, StageSolid, StageScheduled, StageOrdered, StageBooked, StageWeighted, StageConfirmed}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Tracer ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// DefaultMaxAge is the default time after which unfinished traces are dropped.
+	DefaultMaxAge = 10 * time.Minute
+
+	exportQueueSize = 1024
+
+	// minCleanupInterval is the minimum interval in which the traces that exceeded the maximum age are dropped.
+	minCleanupInterval = time.Second
+)
+
+// Tracer follows sampled messages through the components of the Tangle, measures the latency of every stage and
+// exports the resulting spans.
+type Tracer struct {
+	// Events contains the events of the Tracer.
+	Events *Events
+
+	options     *Options
+	traces      map[tangle.MessageID]*trace
+	tracesMutex sync.Mutex
+	exportQueue chan []*Span
+}
+
+// NewTracer creates a new Tracer that traces the messages of the given Tangle.
+func NewTracer(t *tangle.Tangle, opts ...Option) *Tracer {
+	tracer := newTracer(opts...)
+	tracer.attach(t)
+	return tracer
+}
+
+func newTracer(opts ...Option) *Tracer {
+	options := &Options{
+		SampleRate: 1,
+		MaxAge:     DefaultMaxAge,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Tracer{
+		Events: &Events{
+			StageCompleted: events.NewEvent(stageCompletedEventCaller),
+			Error:          events.NewEvent(events.ErrorCaller),
+		},
+		options:     options,
+		traces:      make(map[tangle.MessageID]*trace),
+		exportQueue: make(chan []*Span, exportQueueSize),
+	}
+}
+
+// Run exports the finished traces and drops the ones that exceeded the maximum age until the shutdown signal is
+// received.
+func (t *Tracer) Run(shutdownSignal <-chan struct{}) {
+	cleanupInterval := t.options.MaxAge / 2
+	if cleanupInterval < minCleanupInterval {
+		cleanupInterval = minCleanupInterval
+	}
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-shutdownSignal:
+			if t.options.Exporter != nil {
+				_ = t.options.Exporter.Shutdown()
+			}
+			return
+		case spans := <-t.exportQueue:
+			if t.options.Exporter != nil {
+				if err := t.options.Exporter.ExportSpans(spans); err != nil {
+					t.Events.Error.Trigger(err)
+				}
+			}
+		case <-ticker.C:
+			t.dropExpiredTraces(time.Now())
+		}
+	}
+}
+
+// Sampled returns true if the message with the given ID is traced. The decision is derived from the message ID, so
+// all nodes with the same sample rate trace the same messages.
+func (t *Tracer) Sampled(messageID tangle.MessageID) bool {
+	switch {
+	case t.options.SampleRate >= 1:
+		return true
+	case t.options.SampleRate <= 0:
+		return false
+	default:
+		return float64(binary.LittleEndian.Uint64(messageID[:8])) < t.options.SampleRate*math.MaxUint64
+	}
+}
+
+func (t *Tracer) attach(tng *tangle.Tangle) {
+	tng.Parser.Events.MessageParsed.Attach(events.NewClosure(func(event *tangle.MessageParsedEvent) {
+		t.record(event.Message.ID(), StageParsed, time.Now())
+	}))
+	t.attachStage(tng.Storage.Events.MessageStored, StageStored)
+	t.attachStage(tng.Solidifier.Events.MessageSolid, StageSolid)
+	t.attachStage(tng.Scheduler.Events.MessageScheduled, StageScheduled)
+	t.attachStage(tng.Orderer.Events.MessageOrdered, StageOrdered)
+	t.attachStage(tng.Booker.Events.MessageBooked, StageBooked)
+	t.attachStage(tng.ApprovalWeightManager.Events.MessageProcessed, StageWeighted)
+	tng.ConfirmationOracle.Events().MessageConfirmed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+		now := time.Now()
+		t.record(messageID, StageConfirmed, now)
+		t.finish(messageID, statusConfirmed, now)
+	}))
+
+	tng.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+		t.finish(messageID, statusDiscarded, time.Now())
+	}))
+	tng.Events.MessageInvalid.Attach(events.NewClosure(func(event *tangle.MessageInvalidEvent) {
+		t.finish(event.MessageID, statusInvalid, time.Now())
+	}))
+}
+
+func (t *Tracer) attachStage(event *events.Event, stage Stage) {
+	event.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+		t.record(messageID, stage, time.Now())
+	}))
+}
+
+// record adds a span for the given stage to the trace of the message.
+func (t *Tracer) record(messageID tangle.MessageID, stage Stage, now time.Time) {
+	if !t.Sampled(messageID) {
+		return
+	}
+
+	t.tracesMutex.Lock()
+	msgTrace, exists := t.traces[messageID]
+	if !exists {
+		// traces only start at the entry points of the Tangle
+		if stage != StageParsed && stage != StageStored {
+			t.tracesMutex.Unlock()
+			return
+		}
+		t.traces[messageID] = &trace{start: now, last: now}
+		t.tracesMutex.Unlock()
+		return
+	}
+	latency := now.Sub(msgTrace.last)
+	msgTrace.spans = append(msgTrace.spans, newStageSpan(messageID, stage, msgTrace.last, now))
+	msgTrace.last = now
+	total := now.Sub(msgTrace.start)
+	t.tracesMutex.Unlock()
+
+	t.Events.StageCompleted.Trigger(&StageCompletedEvent{
+		MessageID: messageID,
+		Stage:     stage,
+		Latency:   latency,
+		Total:     total,
+	})
+}
+
+// finish removes the trace of the message and queues its spans for export.
+func (t *Tracer) finish(messageID tangle.MessageID, status string, now time.Time) {
+	t.tracesMutex.Lock()
+	msgTrace, exists := t.traces[messageID]
+	if exists {
+		delete(t.traces, messageID)
+	}
+	t.tracesMutex.Unlock()
+	if !exists || t.options.Exporter == nil {
+		return
+	}
+
+	spans := append([]*Span{newRootSpan(messageID, status, msgTrace.start, now)}, msgTrace.spans...)
+	select {
+	case t.exportQueue <- spans:
+	default:
+		// drop the trace rather than blocking the processing of messages
+	}
+}
+
+// dropExpiredTraces finishes all the traces that were started before the maximum age.
+func (t *Tracer) dropExpiredTraces(now time.Time) {
+	var expired []tangle.MessageID
+	t.tracesMutex.Lock()
+	for messageID, msgTrace := range t.traces {
+		if now.Sub(msgTrace.start) > t.options.MaxAge {
+			expired = append(expired, messageID)
+		}
+	}
+	t.tracesMutex.Unlock()
+
+	for _, messageID := range expired {
+		t.finish(messageID, statusExpired, now)
+	}
+}
+
+// trace contains the spans of a message that has not finished its lifecycle yet.
+type trace struct {
+	start time.Time
+	last  time.Time
+	spans []*Span
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option is a function setting an option of the Tracer.
+type Option func(options *Options)
+
+// Options contains the options of the Tracer.
+type Options struct {
+	// SampleRate is the fraction of messages that are traced.
+	SampleRate float64
+	// MaxAge is the time after which the trace of a message that was not confirmed is dropped.
+	MaxAge time.Duration
+	// Exporter is used to export the spans of finished traces (optional).
+	Exporter SpanExporter
+}
+
+// WithSampleRate returns an Option that sets the fraction of messages that are traced.
+func WithSampleRate(sampleRate float64) Option {
+	return func(options *Options) {
+		options.SampleRate = sampleRate
+	}
+}
+
+// WithMaxAge returns an Option that sets the time after which unfinished traces are dropped.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(options *Options) {
+		options.MaxAge = maxAge
+	}
+}
+
+// WithExporter returns an Option that sets the SpanExporter used to export the finished traces.
+func WithExporter(exporter SpanExporter) Option {
+	return func(options *Options) {
+		options.Exporter = exporter
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events contains the events of the Tracer.
+type Events struct {
+	// StageCompleted is triggered when a traced message completed a stage.
+	StageCompleted *events.Event
+	// Error is triggered when the spans could not be exported.
+	Error *events.Event
+}
+
+// StageCompletedEvent contains the latency of a stage of a traced message.
+type StageCompletedEvent struct {
+	// MessageID contains the identifier of the message.
+	MessageID tangle.MessageID
+	// Stage contains the completed stage.
+	Stage Stage
+	// Latency contains the time passed since the previous stage was completed.
+	Latency time.Duration
+	// Total contains the time passed since the message entered the Tangle.
+	Total time.Duration
+}
+
+func stageCompletedEventCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*StageCompletedEvent))(params[0].(*StageCompletedEvent))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
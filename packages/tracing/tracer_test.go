@@ -0,0 +1,125 @@
+package tracing
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestTracer_Sampled(t *testing.T) {
+	low := tangle.MessageID{0x01}
+	high := tangle.MessageID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	assert.True(t, newTracer().Sampled(high))
+	assert.False(t, newTracer(WithSampleRate(0)).Sampled(low))
+
+	tracer := newTracer(WithSampleRate(0.5))
+	assert.True(t, tracer.Sampled(low))
+	assert.False(t, tracer.Sampled(high))
+}
+
+func TestTracer_Record(t *testing.T) {
+	exporter := &mockExporter{}
+	tracer := newTracer(WithExporter(exporter))
+
+	var completed []*StageCompletedEvent
+	tracer.Events.StageCompleted.Attach(events.NewClosure(func(event *StageCompletedEvent) {
+		completed = append(completed, event)
+	}))
+
+	messageID := tangle.MessageID{1, 2, 3}
+	start := time.Now()
+
+	// traces only start at the entry points of the Tangle
+	tracer.record(messageID, StageSolid, start)
+	assert.Empty(t, tracer.traces)
+
+	tracer.record(messageID, StageStored, start)
+	tracer.record(messageID, StageSolid, start.Add(10*time.Millisecond))
+	tracer.record(messageID, StageBooked, start.Add(30*time.Millisecond))
+	tracer.finish(messageID, statusConfirmed, start.Add(30*time.Millisecond))
+	assert.Empty(t, tracer.traces)
+
+	require.Len(t, completed, 2)
+	assert.Equal(t, StageSolid, completed[0].Stage)
+	assert.Equal(t, 10*time.Millisecond, completed[0].Latency)
+	assert.Equal(t, StageBooked, completed[1].Stage)
+	assert.Equal(t, 20*time.Millisecond, completed[1].Latency)
+	assert.Equal(t, 30*time.Millisecond, completed[1].Total)
+
+	spans := <-tracer.exportQueue
+	require.Len(t, spans, 3)
+	assert.Equal(t, rootSpanName, spans[0].Name)
+	assert.Equal(t, statusConfirmed, spans[0].Attributes["status"])
+	for _, span := range spans[1:] {
+		assert.Equal(t, spans[0].TraceID, span.TraceID)
+		assert.Equal(t, spans[0].SpanID, span.ParentSpanID)
+	}
+}
+
+func TestTracer_DropExpiredTraces(t *testing.T) {
+	tracer := newTracer(WithMaxAge(time.Minute), WithExporter(&mockExporter{}))
+
+	now := time.Now()
+	tracer.record(tangle.MessageID{1}, StageParsed, now.Add(-2*time.Minute))
+	tracer.record(tangle.MessageID{2}, StageParsed, now)
+	tracer.dropExpiredTraces(now)
+
+	assert.Len(t, tracer.traces, 1)
+	spans := <-tracer.exportQueue
+	assert.Equal(t, statusExpired, spans[0].Attributes["status"])
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	exporter, err := NewFileExporter(path)
+	require.NoError(t, err)
+
+	messageID := tangle.MessageID{1, 2, 3}
+	start := time.Unix(0, 1000)
+	require.NoError(t, exporter.ExportSpans([]*Span{
+		newRootSpan(messageID, statusConfirmed, start, start.Add(time.Second)),
+		newStageSpan(messageID, StageSolid, start, start.Add(time.Millisecond)),
+	}))
+	require.NoError(t, exporter.Shutdown())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	require.True(t, scanner.Scan())
+
+	var request otlpRequest
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &request))
+	require.Len(t, request.ResourceSpans, 1)
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	assert.Equal(t, hex.EncodeToString(messageID[:16]), spans[0].TraceID)
+	assert.Empty(t, spans[0].ParentSpanID)
+	assert.Equal(t, "1000", spans[0].StartTimeUnixNano)
+	assert.Equal(t, spans[0].SpanID, spans[1].ParentSpanID)
+	assert.Equal(t, string(StageSolid), spans[1].Name)
+}
+
+type mockExporter struct {
+	spans [][]*Span
+}
+
+func (m *mockExporter) ExportSpans(spans []*Span) error {
+	m.spans = append(m.spans, spans)
+	return nil
+}
+
+func (m *mockExporter) Shutdown() error {
+	return nil
+}
@@ -1,8 +1,11 @@
 package client
 
 import (
+	"crypto/tls"
 	"net"
+	"sync"
 
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/logger"
 
@@ -12,13 +15,32 @@ import (
 
 // Client represents the client-side connection to a txstream server.
 type Client struct {
-	clientID      string
-	log           *logger.Logger
-	chSend        chan txstream.Message
-	chSubscribe   chan ledgerstate.Address
-	chUnsubscribe chan ledgerstate.Address
-	shutdown      chan bool
-	Events        Events
+	clientID        string
+	log             *logger.Logger
+	chSend          chan txstream.Message
+	chSubscribe     chan ledgerstate.Address
+	chUnsubscribe   chan ledgerstate.Address
+	chSubscribeTx   chan ledgerstate.TransactionID
+	chUnsubscribeTx chan ledgerstate.TransactionID
+	chAddressUpdate chan txstream.Message
+	chTxUpdate      chan txstream.Message
+	shutdown        chan bool
+	keyPair         *ed25519.KeyPair
+	Events          Events
+
+	nextRequestID        uint32
+	pendingRequests      map[uint32]chan *txstream.MsgResponse
+	pendingRequestsMutex sync.Mutex
+}
+
+// Option is a function that configures optional parameters of the Client.
+type Option func(*Client)
+
+// WithKeyPair is an Option that sets the key pair used to answer the authentication challenge of the server.
+func WithKeyPair(keyPair *ed25519.KeyPair) Option {
+	return func(n *Client) {
+		n.keyPair = keyPair
+	}
 }
 
 // Events contains all events emitted by the Client.
@@ -31,13 +53,27 @@ type Events struct {
 	OutputReceived *events.Event
 	// UnspentAliasOutputReceived is triggered whenever an unspent AliasOutput is received
 	UnspentAliasOutputReceived *events.Event
+	// TxGoFChanged is triggered whenever the GoF of a subscribed transaction is received
+	TxGoFChanged *events.Event
 	// Connected is triggered when the client connects successfully to the server
 	Connected *events.Event
+	// SubscriptionUpdateFailed is triggered when the server rejects a subscription update (e.g. because the
+	// subscription limit is exceeded) or does not acknowledge it in time
+	SubscriptionUpdateFailed *events.Event
 }
 
 // DialFunc is a function that performs the TCP connection to the server.
 type DialFunc func() (addr string, conn net.Conn, err error)
 
+// TLSDialFunc returns a DialFunc that connects to the given address using TLS with the given configuration. Mutual
+// TLS is achieved by setting the client certificate in config.Certificates.
+func TLSDialFunc(addr string, config *tls.Config) DialFunc {
+	return func() (string, net.Conn, error) {
+		conn, err := tls.Dial("tcp", addr, config)
+		return addr, conn, err
+	}
+}
+
 func handleTransactionReceived(handler interface{}, params ...interface{}) {
 	handler.(func(*txstream.MsgTransaction))(params[0].(*txstream.MsgTransaction))
 }
@@ -54,29 +90,48 @@ func handleInclusionStateReceived(handler interface{}, params ...interface{}) {
 	handler.(func(*txstream.MsgTxGoF))(params[0].(*txstream.MsgTxGoF))
 }
 
+func handleTxGoFChanged(handler interface{}, params ...interface{}) {
+	handler.(func(*txstream.MsgTxGoFChanged))(params[0].(*txstream.MsgTxGoFChanged))
+}
+
+func handleSubscriptionUpdateFailed(handler interface{}, params ...interface{}) {
+	handler.(func(err error))(params[0].(error))
+}
+
 func handleConnected(handler interface{}, params ...interface{}) {
 	handler.(func())()
 }
 
 // New creates a new client.
-func New(clientID string, log *logger.Logger, dial DialFunc) *Client {
+func New(clientID string, log *logger.Logger, dial DialFunc, opts ...Option) *Client {
 	n := &Client{
-		clientID:      clientID,
-		log:           log,
-		chSend:        make(chan txstream.Message),
-		chSubscribe:   make(chan ledgerstate.Address),
-		chUnsubscribe: make(chan ledgerstate.Address),
-		shutdown:      make(chan bool),
+		clientID:        clientID,
+		log:             log,
+		chSend:          make(chan txstream.Message),
+		chSubscribe:     make(chan ledgerstate.Address),
+		chUnsubscribe:   make(chan ledgerstate.Address),
+		chSubscribeTx:   make(chan ledgerstate.TransactionID),
+		chUnsubscribeTx: make(chan ledgerstate.TransactionID),
+		chAddressUpdate: make(chan txstream.Message, 1),
+		chTxUpdate:      make(chan txstream.Message, 1),
+		shutdown:        make(chan bool),
+		pendingRequests: make(map[uint32]chan *txstream.MsgResponse),
 		Events: Events{
 			TransactionReceived:        events.NewEvent(handleTransactionReceived),
 			InclusionStateReceived:     events.NewEvent(handleInclusionStateReceived),
 			OutputReceived:             events.NewEvent(handleOutputReceived),
 			UnspentAliasOutputReceived: events.NewEvent(handleUnspentAliasOutputReceived),
+			TxGoFChanged:               events.NewEvent(handleTxGoFChanged),
 			Connected:                  events.NewEvent(handleConnected),
+			SubscriptionUpdateFailed:   events.NewEvent(handleSubscriptionUpdateFailed),
 		},
 	}
+	for _, opt := range opts {
+		opt(n)
+	}
 
 	go n.subscriptionsLoop()
+	go n.subscriptionUpdatesLoop()
 	go n.connectLoop(dial)
 
 	return n
@@ -89,5 +144,7 @@ func (n *Client) Close() {
 	n.Events.InclusionStateReceived.DetachAll()
 	n.Events.OutputReceived.DetachAll()
 	n.Events.UnspentAliasOutputReceived.DetachAll()
+	n.Events.TxGoFChanged.DetachAll()
 	n.Events.Connected.DetachAll()
+	n.Events.SubscriptionUpdateFailed.DetachAll()
 }
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -20,6 +21,10 @@ const (
 	dialRetries  = 10
 	backoffDelay = 500 * time.Millisecond
 	retryAfter   = 8 * time.Second
+
+	// authChallengeTimeout is the time a client with a key pair waits for the authentication challenge of the server
+	// before it assumes that the server does not require authentication.
+	authChallengeTimeout = 5 * time.Second
 )
 
 // retry net.Dial once, on fail after 0.5s.
@@ -99,21 +104,37 @@ func (n *Client) connect(dial DialFunc, msgChopper *chopper.Chopper) bool {
 	}()
 
 	// send client ID
-	if err := n.send(&txstream.MsgSetID{ClientID: n.clientID}, bconn, msgChopper); err != nil {
+	if err := n.send(&txstream.MsgSetID{ClientID: n.clientID, ProtocolVersion: txstream.ProtocolVersion}, bconn, msgChopper); err != nil {
 		n.log.Errorf("sending client ID to server: %v", err)
 	}
 
+	// the server expects the authentication response right after the client ID, so the queued messages are held back
+	// until it was sent
+	sendQueue := n.chSend
+	var authChallengeTimedOut <-chan time.Time
+	if n.keyPair != nil {
+		sendQueue = nil
+		authChallengeTimedOut = time.After(authChallengeTimeout)
+	}
+
 	// r/w loop
 	for {
 		select {
-		case msg := <-n.chSend:
+		case msg := <-sendQueue:
 			if err := n.send(msg, bconn, msgChopper); err != nil {
 				n.log.Errorf("sending message to server (%T): %v", msg, err)
 			}
 		case d := <-dataReceived:
-			if err := n.decodeReceivedMessage(d, msgChopper); err != nil {
+			authenticated, err := n.decodeReceivedMessage(d, bconn, msgChopper)
+			if err != nil {
 				n.log.Errorf("decoding message from server: %v", err)
 			}
+			if authenticated {
+				sendQueue, authChallengeTimedOut = n.chSend, nil
+			}
+		case <-authChallengeTimedOut:
+			n.log.Debugf("server did not request authentication")
+			sendQueue, authChallengeTimedOut = n.chSend, nil
 		case <-n.shutdown:
 			return false
 		case <-connectionClosed:
@@ -122,21 +143,35 @@ func (n *Client) connect(dial DialFunc, msgChopper *chopper.Chopper) bool {
 	}
 }
 
-func (n *Client) decodeReceivedMessage(data []byte, msgChopper *chopper.Chopper) error {
+// decodeReceivedMessage processes a message from the server and returns true if it was the authentication challenge
+// and the response was sent.
+func (n *Client) decodeReceivedMessage(data []byte, bconn *buffconn.BufferedConnection, msgChopper *chopper.Chopper) (authenticated bool, err error) {
 	msg, err := txstream.DecodeMsg(data, txstream.FlagServerToClient)
 	if err != nil {
-		return fmt.Errorf("txstream.DecodeMsg: %w", err)
+		return false, fmt.Errorf("txstream.DecodeMsg: %w", err)
 	}
 
 	switch msg := msg.(type) {
 	case *txstream.MsgChunk:
 		finalData, err := msgChopper.IncomingChunk(msg.Data, tangle.MaxMessageSize, txstream.ChunkMessageHeaderSize)
 		if err != nil {
-			return fmt.Errorf("receiving msgchunk: %w", err)
+			return false, fmt.Errorf("receiving msgchunk: %w", err)
 		}
 		if finalData != nil {
-			return n.decodeReceivedMessage(finalData, msgChopper)
+			return n.decodeReceivedMessage(finalData, bconn, msgChopper)
 		}
+	case *txstream.MsgAuthChallenge:
+		n.log.Debugf("received message from server: %T", msg)
+		if n.keyPair == nil {
+			return false, fmt.Errorf("server requires authentication but no key pair is configured")
+		}
+		if err := n.send(&txstream.MsgAuthResponse{
+			PublicKey: n.keyPair.PublicKey,
+			Signature: n.keyPair.PrivateKey.Sign(txstream.AuthChallengeData(msg.Nonce, n.clientID)),
+		}, bconn, msgChopper); err != nil {
+			return false, fmt.Errorf("sending authentication response: %w", err)
+		}
+		return true, nil
 	case *txstream.MsgTransaction:
 		n.log.Debugf("received message from server: %T", msg)
 		n.Events.TransactionReceived.Trigger(msg)
@@ -153,10 +188,18 @@ func (n *Client) decodeReceivedMessage(data []byte, msgChopper *chopper.Chopper)
 		n.log.Debugf("received message from server: %T", msg)
 		n.Events.UnspentAliasOutputReceived.Trigger(msg)
 
+	case *txstream.MsgTxGoFChanged:
+		n.log.Debugf("received message from server: %T", msg)
+		n.Events.TxGoFChanged.Trigger(msg)
+
+	case *txstream.MsgResponse:
+		n.log.Debugf("received message from server: %T", msg)
+		n.processResponse(msg)
+
 	default:
 		n.log.Errorf("received unknkwn message from server: %T", msg)
 	}
-	return nil
+	return false, nil
 }
 
 // sendMessage is a thread-safe request to send a message to the server.
@@ -164,6 +207,19 @@ func (n *Client) sendMessage(msg txstream.Message) {
 	n.chSend <- msg
 }
 
+// sendMessageWithContext is a thread-safe request to send a message to the server that aborts when the context is
+// done or the client is closed.
+func (n *Client) sendMessageWithContext(ctx context.Context, msg txstream.Message) error {
+	select {
+	case n.chSend <- msg:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-n.shutdown:
+		return ErrClientClosed
+	}
+}
+
 // send writes a message into the server connection.
 func (n *Client) send(msg txstream.Message, bconn *buffconn.BufferedConnection, msgChopper *chopper.Chopper) error {
 	n.log.Debugf("sending message to server: %T", msg)
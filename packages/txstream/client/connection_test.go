@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/txstream"
+	"github.com/iotaledger/goshimmer/packages/txstream/server"
+)
+
+func TestAuthenticatedRequest(t *testing.T) {
+	keyPair := ed25519.GenerateKeyPair()
+	n, ledger := start(t, []server.Option{server.WithAllowedClients(keyPair.PublicKey)}, WithKeyPair(&keyPair))
+
+	// the request is queued before the server sent its challenge, so it must wait for the authentication response
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	address := ledgerstate.NewED25519Address(keyPair.PublicKey)
+	backlog, err := n.GetBacklog(ctx, address)
+	require.NoError(t, err)
+	assert.Empty(t, backlog)
+
+	select {
+	case requested := <-ledger.unspentOutputsRequested:
+		assert.Equal(t, address.Array(), requested.Array())
+	default:
+		t.Fatal("backlog was not requested from the ledger")
+	}
+}
+
+func TestSubscriptionUpdateFailed(t *testing.T) {
+	n, ledger := start(t, []server.Option{server.WithMaxSubscriptions(1)})
+
+	failed := make(chan error, 1)
+	n.Events.SubscriptionUpdateFailed.Attach(events.NewClosure(func(err error) { failed <- err }))
+
+	n.Subscribe(ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))
+	<-ledger.unspentOutputsRequested
+	n.Subscribe(ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))
+
+	select {
+	case err := <-failed:
+		assert.ErrorIs(t, err, txstream.ErrLimitExceeded)
+	case <-time.After(5 * time.Second):
+		t.Fatal("rejected subscription update was not reported")
+	}
+}
+
+// start runs a server with the given options on one end of a pipe and connects a client with the given options to
+// the other end.
+func start(t *testing.T, serverOpts []server.Option, opts ...Option) (*Client, *mockLedger) {
+	ledger := newMockLedger()
+
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+
+	serverConn, clientConn := net.Pipe()
+	go server.Run(serverConn, logger.NewExampleLogger("txstream/server"), ledger, done, serverOpts...)
+
+	var dialed int32
+	dial := func() (string, net.Conn, error) {
+		if !atomic.CompareAndSwapInt32(&dialed, 0, 1) {
+			return "", nil, errors.New("already dialed")
+		}
+		return "pipe", clientConn, nil
+	}
+
+	n := New("test", logger.NewExampleLogger("txstream/client"), dial, opts...)
+	t.Cleanup(n.Close)
+
+	return n, ledger
+}
+
+type mockLedger struct {
+	unspentOutputsRequested chan ledgerstate.Address
+	bookedEvent             *events.Event
+	gofChangedEvent         *events.Event
+}
+
+func newMockLedger() *mockLedger {
+	return &mockLedger{
+		unspentOutputsRequested: make(chan ledgerstate.Address, 1),
+		bookedEvent: events.NewEvent(func(handler interface{}, params ...interface{}) {
+			handler.(func(*ledgerstate.Transaction))(params[0].(*ledgerstate.Transaction))
+		}),
+		gofChangedEvent: events.NewEvent(tangle.TransactionGoFChangedCaller),
+	}
+}
+
+func (m *mockLedger) GetUnspentOutputs(addr ledgerstate.Address, _ func(ledgerstate.Output)) {
+	m.unspentOutputsRequested <- addr
+}
+
+func (m *mockLedger) GetOutput(ledgerstate.OutputID, func(ledgerstate.Output)) bool {
+	return false
+}
+
+func (m *mockLedger) GetOutputMetadata(ledgerstate.OutputID, func(*ledgerstate.OutputMetadata)) bool {
+	return false
+}
+
+func (m *mockLedger) GetHighGoFTransaction(ledgerstate.TransactionID, func(*ledgerstate.Transaction)) bool {
+	return false
+}
+
+func (m *mockLedger) GetTransactionGoF(ledgerstate.TransactionID) (gof.GradeOfFinality, bool) {
+	return gof.None, false
+}
+
+func (m *mockLedger) GetInclusionProof(ledgerstate.TransactionID) (*inclusionproof.Proof, bool) {
+	return nil, false
+}
+
+func (m *mockLedger) EventTransactionBooked() *events.Event {
+	return m.bookedEvent
+}
+
+func (m *mockLedger) EventTransactionGoFChanged() *events.Event {
+	return m.gofChangedEvent
+}
+
+func (m *mockLedger) PostTransaction(*ledgerstate.Transaction) error {
+	return nil
+}
+
+func (m *mockLedger) Detach() {}
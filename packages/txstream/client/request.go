@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/txstream"
+)
+
+// ErrClientClosed is returned if a request is aborted because the client was closed.
+var ErrClientClosed = errors.New("client closed")
+
+// GetConfirmedTransaction requests a specific confirmed transaction and waits for the reply of the server. It returns
+// txstream.ErrNotFound if the transaction is unknown or not confirmed.
+func (n *Client) GetConfirmedTransaction(ctx context.Context, addr ledgerstate.Address, txid ledgerstate.TransactionID) (*ledgerstate.Transaction, error) {
+	response, err := n.request(ctx, &txstream.MsgGetConfirmedTransaction{Address: addr, TxID: txid})
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := response.(*txstream.MsgTransaction)
+	if !ok {
+		return nil, unexpectedResponse(response)
+	}
+	return msg.Tx, nil
+}
+
+// GetTxInclusionState requests the GoF of a transaction and waits for the reply of the server. It returns
+// txstream.ErrNotFound if the transaction is unknown.
+func (n *Client) GetTxInclusionState(ctx context.Context, addr ledgerstate.Address, txid ledgerstate.TransactionID) (gof.GradeOfFinality, error) {
+	response, err := n.request(ctx, &txstream.MsgGetTxInclusionState{Address: addr, TxID: txid})
+	if err != nil {
+		return gof.None, err
+	}
+	msg, ok := response.(*txstream.MsgTxGoF)
+	if !ok {
+		return gof.None, unexpectedResponse(response)
+	}
+	return msg.GradeOfFinality, nil
+}
+
+// GetConfirmedOutput requests a specific confirmed output and waits for the reply of the server. It returns
+// txstream.ErrNotFound if the output is unknown or not confirmed.
+func (n *Client) GetConfirmedOutput(ctx context.Context, addr ledgerstate.Address, outputID ledgerstate.OutputID) (*txstream.MsgOutput, error) {
+	response, err := n.request(ctx, &txstream.MsgGetConfirmedOutput{Address: addr, OutputID: outputID})
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := response.(*txstream.MsgOutput)
+	if !ok {
+		return nil, unexpectedResponse(response)
+	}
+	return msg, nil
+}
+
+// GetUnspentAliasOutput requests the unique unspent alias output for the given AliasAddress and waits for the reply
+// of the server. It returns txstream.ErrNotFound if there is no such output.
+func (n *Client) GetUnspentAliasOutput(ctx context.Context, addr *ledgerstate.AliasAddress) (*txstream.MsgUnspentAliasOutput, error) {
+	response, err := n.request(ctx, &txstream.MsgGetUnspentAliasOutput{AliasAddress: addr})
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := response.(*txstream.MsgUnspentAliasOutput)
+	if !ok {
+		return nil, unexpectedResponse(response)
+	}
+	return msg, nil
+}
+
+// GetBacklog requests the confirmed transactions with unspent outputs targeted to the given address and waits for
+// the reply of the server.
+func (n *Client) GetBacklog(ctx context.Context, addr ledgerstate.Address) ([]*ledgerstate.Transaction, error) {
+	response, err := n.request(ctx, &txstream.MsgGetBacklog{Address: addr})
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := response.(*txstream.MsgBacklog)
+	if !ok {
+		return nil, unexpectedResponse(response)
+	}
+	return msg.Transactions, nil
+}
+
+// GetInclusionProof requests a proof that the given transaction is part of the tangle and waits for the reply of the
+// server. It returns txstream.ErrNotFound if the transaction is unknown or not yet approved by a confirmed marker. The
+// proof should be checked with an inclusionproof.Verifier before it is trusted.
+func (n *Client) GetInclusionProof(ctx context.Context, txid ledgerstate.TransactionID) (*inclusionproof.Proof, error) {
+	response, err := n.request(ctx, &txstream.MsgGetInclusionProof{TxID: txid})
+	if err != nil {
+		return nil, err
+	}
+	msg, ok := response.(*txstream.MsgInclusionProof)
+	if !ok {
+		return nil, unexpectedResponse(response)
+	}
+	return msg.Proof, nil
+}
+
+// PostTransactionAndWait posts a transaction to the ledger and waits until the server accepted it.
+func (n *Client) PostTransactionAndWait(ctx context.Context, tx *ledgerstate.Transaction) error {
+	response, err := n.request(ctx, &txstream.MsgPostTransaction{Tx: tx})
+	if err != nil {
+		return err
+	}
+	if _, ok := response.(*txstream.MsgTransactionPosted); !ok {
+		return unexpectedResponse(response)
+	}
+	return nil
+}
+
+// request sends the given message wrapped in a MsgRequest and waits for the corresponding MsgResponse.
+func (n *Client) request(ctx context.Context, msg txstream.Message) (txstream.Message, error) {
+	requestID := atomic.AddUint32(&n.nextRequestID, 1)
+	responseChan := make(chan *txstream.MsgResponse, 1)
+
+	n.pendingRequestsMutex.Lock()
+	n.pendingRequests[requestID] = responseChan
+	n.pendingRequestsMutex.Unlock()
+
+	defer func() {
+		n.pendingRequestsMutex.Lock()
+		delete(n.pendingRequests, requestID)
+		n.pendingRequestsMutex.Unlock()
+	}()
+
+	if err := n.sendMessageWithContext(ctx, &txstream.MsgRequest{RequestID: requestID, Request: msg}); err != nil {
+		return nil, err
+	}
+
+	select {
+	case response := <-responseChan:
+		if err := response.ErrorCode.Err(); err != nil {
+			return nil, err
+		}
+		return response.Response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-n.shutdown:
+		return nil, ErrClientClosed
+	}
+}
+
+// processResponse hands the response over to the pending request with the same ID.
+func (n *Client) processResponse(msg *txstream.MsgResponse) {
+	n.pendingRequestsMutex.Lock()
+	defer n.pendingRequestsMutex.Unlock()
+
+	responseChan, exists := n.pendingRequests[msg.RequestID]
+	if !exists {
+		n.log.Debugf("received response for unknown request %d", msg.RequestID)
+		return
+	}
+	delete(n.pendingRequests, msg.RequestID)
+	responseChan <- msg
+}
+
+func unexpectedResponse(response txstream.Message) error {
+	return fmt.Errorf("unexpected response type: %T", response)
+}
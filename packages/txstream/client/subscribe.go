@@ -1,12 +1,16 @@
 package client
 
 import (
+	"context"
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/txstream"
 )
 
+// subscriptionUpdateTimeout is the time the client waits for the server to acknowledge a subscription update.
+const subscriptionUpdateTimeout = 10 * time.Second
+
 // Subscribe subscribes to real-time updates for the given address.
 func (n *Client) Subscribe(addr ledgerstate.Address) {
 	n.chSubscribe <- addr
@@ -17,8 +21,19 @@ func (n *Client) Unsubscribe(addr ledgerstate.Address) {
 	n.chUnsubscribe <- addr
 }
 
+// SubscribeTxGoF subscribes to GoF changes of the given transaction.
+func (n *Client) SubscribeTxGoF(txID ledgerstate.TransactionID) {
+	n.chSubscribeTx <- txID
+}
+
+// UnsubscribeTxGoF unsubscribes from GoF changes of the given transaction.
+func (n *Client) UnsubscribeTxGoF(txID ledgerstate.TransactionID) {
+	n.chUnsubscribeTx <- txID
+}
+
 func (n *Client) subscriptionsLoop() {
 	subscriptions := make(map[[ledgerstate.AddressLength]byte]ledgerstate.Address)
+	txSubscriptions := make(map[ledgerstate.TransactionID]bool)
 
 	ticker1m := time.NewTicker(time.Minute)
 	defer ticker1m.Stop()
@@ -37,9 +52,23 @@ func (n *Client) subscriptionsLoop() {
 			}
 		case addr := <-n.chUnsubscribe:
 			delete(subscriptions, addr.Array())
+		case txID := <-n.chSubscribeTx:
+			if !txSubscriptions[txID] {
+				n.log.Infof("subscribed to GoF of transaction %s", txID.Base58())
+				txSubscriptions[txID] = true
+				n.sendTxSubscriptions(txSubscriptions)
+			}
+		case txID := <-n.chUnsubscribeTx:
+			if txSubscriptions[txID] {
+				delete(txSubscriptions, txID)
+				n.sendTxSubscriptions(txSubscriptions)
+			}
 		case <-ticker1m.C:
 			// send subscriptions once every minute
 			n.sendSubscriptions(subscriptions)
+			if len(txSubscriptions) > 0 {
+				n.sendTxSubscriptions(txSubscriptions)
+			}
 		}
 	}
 }
@@ -58,5 +87,51 @@ func (n *Client) sendSubscriptions(subscriptions map[[ledgerstate.AddressLength]
 		}
 	}
 
-	n.sendMessage(&txstream.MsgUpdateSubscriptions{Addresses: addrs})
+	queueSubscriptionUpdate(n.chAddressUpdate, &txstream.MsgUpdateSubscriptions{Addresses: addrs})
+}
+
+func (n *Client) sendTxSubscriptions(txSubscriptions map[ledgerstate.TransactionID]bool) {
+	txIDs := make([]ledgerstate.TransactionID, 0, len(txSubscriptions))
+	for txID := range txSubscriptions {
+		txIDs = append(txIDs, txID)
+	}
+
+	queueSubscriptionUpdate(n.chTxUpdate, &txstream.MsgSubscribeTxGoF{TxIDs: txIDs})
+}
+
+// queueSubscriptionUpdate queues the given subscription update without blocking. An update that is still waiting to be
+// sent is outdated, as every update replaces all subscriptions of its kind, so it is dropped.
+func queueSubscriptionUpdate(queue chan txstream.Message, msg txstream.Message) {
+	select {
+	case <-queue:
+	default:
+	}
+	queue <- msg
+}
+
+// subscriptionUpdatesLoop sends the queued subscription updates one after another, so they reach the server in order
+// without blocking the subscriptionsLoop while waiting for the acknowledgements.
+func (n *Client) subscriptionUpdatesLoop() {
+	for {
+		select {
+		case <-n.shutdown:
+			return
+		case msg := <-n.chAddressUpdate:
+			n.updateSubscriptions(msg)
+		case msg := <-n.chTxUpdate:
+			n.updateSubscriptions(msg)
+		}
+	}
+}
+
+// updateSubscriptions sends the given subscription update as a request, so a rejection by the server (e.g. because the
+// subscription limit is exceeded) is reported through the SubscriptionUpdateFailed event.
+func (n *Client) updateSubscriptions(msg txstream.Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), subscriptionUpdateTimeout)
+	defer cancel()
+
+	if _, err := n.request(ctx, msg); err != nil {
+		n.log.Errorf("subscription update rejected: %v", err)
+		n.Events.SubscriptionUpdateFailed.Trigger(err)
+	}
 }
@@ -3,6 +3,8 @@ package txstream
 import (
 	"github.com/iotaledger/hive.go/events"
 
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
 
@@ -12,7 +14,10 @@ type Ledger interface {
 	GetOutput(outID ledgerstate.OutputID, f func(ledgerstate.Output)) bool
 	GetOutputMetadata(outID ledgerstate.OutputID, f func(*ledgerstate.OutputMetadata)) bool
 	GetHighGoFTransaction(txid ledgerstate.TransactionID, f func(*ledgerstate.Transaction)) bool
+	GetTransactionGoF(txid ledgerstate.TransactionID) (gof.GradeOfFinality, bool)
+	GetInclusionProof(txid ledgerstate.TransactionID) (*inclusionproof.Proof, bool)
 	EventTransactionBooked() *events.Event
+	EventTransactionGoFChanged() *events.Event
 	PostTransaction(tx *ledgerstate.Transaction) error
 	Detach()
 }
@@ -1,12 +1,15 @@
 package txstream
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/marshalutil"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
 
@@ -34,8 +37,75 @@ const (
 	msgTypeTxGoF
 	msgTypeOutput
 	msgTypeUnspentAliasOutput
+
+	msgTypeAuthChallenge = MessageType(FlagServerToClient + iota)
+	msgTypeAuthResponse  = MessageType(FlagClientToServer + iota)
+
+	msgTypeRequest = MessageType(FlagClientToServer + iota)
+	msgTypeSubscribeTxGoF
+
+	msgTypeResponse = MessageType(FlagServerToClient + iota)
+	msgTypeTxGoFChanged
+	msgTypeBacklog
+
+	msgTypeGetInclusionProof = MessageType(FlagClientToServer + iota)
+	msgTypeInclusionProof    = MessageType(FlagServerToClient + iota)
+
+	msgTypeTransactionPosted = MessageType(FlagServerToClient + iota)
+	msgTypeSubscriptionsUpdated
+)
+
+const (
+	// ProtocolVersion is the version of the txstream protocol implemented by this package. Version 1 clients do not
+	// send their version in MsgSetID and do not support request IDs.
+	ProtocolVersion = 2
+
+	// protocolVersionLegacy is the protocol version assumed if a client does not send its version.
+	protocolVersionLegacy = 1
+)
+
+// ErrorCode represents the error reported by the server in a MsgResponse.
+type ErrorCode byte
+
+const (
+	// ErrorCodeNone signals that the request was successful.
+	ErrorCodeNone ErrorCode = iota
+	// ErrorCodeNotFound signals that the requested object was not found.
+	ErrorCodeNotFound
+	// ErrorCodeInvalidRequest signals that the request can not be handled by the server.
+	ErrorCodeInvalidRequest
+	// ErrorCodeLimitExceeded signals that the request exceeds the limits configured for the client.
+	ErrorCodeLimitExceeded
+)
+
+var (
+	// ErrNotFound is returned if the server does not find the requested object.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidRequest is returned if the server can not handle the request.
+	ErrInvalidRequest = errors.New("invalid request")
+	// ErrLimitExceeded is returned if the request exceeds the limits configured for the client.
+	ErrLimitExceeded = errors.New("limit exceeded")
 )
 
+// Err returns the error corresponding to the ErrorCode or nil if the ErrorCode is ErrorCodeNone.
+func (e ErrorCode) Err() error {
+	switch e {
+	case ErrorCodeNone:
+		return nil
+	case ErrorCodeNotFound:
+		return ErrNotFound
+	case ErrorCodeInvalidRequest:
+		return ErrInvalidRequest
+	case ErrorCodeLimitExceeded:
+		return ErrLimitExceeded
+	default:
+		return fmt.Errorf("unknown error code %d", e)
+	}
+}
+
+// AuthNonceLength is the length of the nonce sent by the server in MsgAuthChallenge.
+const AuthNonceLength = 32
+
 // Message is the common interface of all messages in the txstream protocol.
 type Message interface {
 	Write(w *marshalutil.MarshalUtil)
@@ -55,7 +125,7 @@ type MsgChunk struct {
 
 // MsgPostTransaction is a request from the client to post a
 // transaction in the ledger.
-// No reply from server.
+// No reply from server, unless it is wrapped in a MsgRequest: then the server replies with a MsgTransactionPosted.
 type MsgPostTransaction struct {
 	Tx *ledgerstate.Transaction
 }
@@ -102,9 +172,39 @@ type MsgGetBacklog struct {
 	Address ledgerstate.Address
 }
 
-// MsgSetID is a message from client informing its ID, used mostly for tracing/loging.
+// MsgSetID is a message from client informing its ID, used mostly for tracing/loging, and the version of the
+// protocol it implements.
 type MsgSetID struct {
-	ClientID string
+	ClientID        string
+	ProtocolVersion uint8
+}
+
+// MsgAuthResponse is the reply of the client to MsgAuthChallenge. It contains the public key of the client and
+// the signature of the challenge nonce concatenated with the client ID.
+type MsgAuthResponse struct {
+	PublicKey ed25519.PublicKey
+	Signature ed25519.Signature
+}
+
+// MsgRequest wraps a request from the client with a RequestID. Server replies with a MsgResponse carrying the same
+// RequestID, either containing the result or an error.
+type MsgRequest struct {
+	RequestID uint32
+	Request   Message
+}
+
+// MsgSubscribeTxGoF is a request from the client to subscribe to GoF changes of the given transactions. It replaces
+// the previous transaction subscriptions. Server replies with MsgTxGoFChanged for each newly subscribed transaction
+// and whenever the GoF of a subscribed transaction changes. If it is wrapped in a MsgRequest, the server also replies
+// with a MsgSubscriptionsUpdated or reports that the subscription limit was exceeded.
+type MsgSubscribeTxGoF struct {
+	TxIDs []ledgerstate.TransactionID
+}
+
+// MsgGetInclusionProof is a request to get a proof that the given transaction is part of the Tangle. It has to be
+// wrapped in a MsgRequest. Server replies with a MsgInclusionProof.
+type MsgGetInclusionProof struct {
+	TxID ledgerstate.TransactionID
 }
 
 // endregion
@@ -142,8 +242,60 @@ type MsgUnspentAliasOutput struct {
 	Timestamp      time.Time
 }
 
+// MsgAuthChallenge is sent by the server right after receiving MsgSetID if client authentication is enabled.
+// The client must reply with MsgAuthResponse.
+type MsgAuthChallenge struct {
+	Nonce [AuthNonceLength]byte
+}
+
+// MsgResponse is the reply to a MsgRequest with the same RequestID. Response is nil if ErrorCode is not
+// ErrorCodeNone.
+type MsgResponse struct {
+	RequestID uint32
+	ErrorCode ErrorCode
+	Response  Message
+}
+
+// MsgTxGoFChanged informs the client about the GoF of a subscribed transaction.
+type MsgTxGoFChanged struct {
+	TxID            ledgerstate.TransactionID
+	GradeOfFinality gof.GradeOfFinality
+}
+
+// MsgBacklog is the response for a MsgGetBacklog wrapped in a MsgRequest. It contains all transactions with unspent
+// outputs targeted to the address.
+type MsgBacklog struct {
+	Address      ledgerstate.Address
+	Transactions []*ledgerstate.Transaction
+}
+
+// MsgInclusionProof is the response for a MsgGetInclusionProof wrapped in a MsgRequest. The proof can be verified
+// by the client with an inclusionproof.Verifier.
+type MsgInclusionProof struct {
+	TxID  ledgerstate.TransactionID
+	Proof *inclusionproof.Proof
+}
+
+// MsgTransactionPosted is the response for a MsgPostTransaction wrapped in a MsgRequest. It acknowledges that the
+// transaction was accepted by the node.
+type MsgTransactionPosted struct {
+	TxID ledgerstate.TransactionID
+}
+
+// MsgSubscriptionsUpdated is the response for a MsgUpdateSubscriptions or MsgSubscribeTxGoF wrapped in a MsgRequest.
+// It acknowledges that the subscriptions were replaced. Updates exceeding the subscription limit of the client are
+// answered with ErrorCodeLimitExceeded instead.
+type MsgSubscriptionsUpdated struct {
+	Count uint16
+}
+
 // endregion
 
+// AuthChallengeData returns the data that has to be signed by the client in order to answer the given challenge.
+func AuthChallengeData(nonce [AuthNonceLength]byte, clientID string) []byte {
+	return append(nonce[:], []byte(clientID)...)
+}
+
 // EncodeMsg encodes the given Message as a byte slice.
 func EncodeMsg(msg Message) []byte {
 	m := marshalutil.New()
@@ -204,6 +356,39 @@ func DecodeMsg(data []byte, expectedFlags uint8) (interface{}, error) {
 	case msgTypeUnspentAliasOutput:
 		ret = &MsgUnspentAliasOutput{}
 
+	case msgTypeAuthChallenge:
+		ret = &MsgAuthChallenge{}
+
+	case msgTypeAuthResponse:
+		ret = &MsgAuthResponse{}
+
+	case msgTypeRequest:
+		ret = &MsgRequest{}
+
+	case msgTypeSubscribeTxGoF:
+		ret = &MsgSubscribeTxGoF{}
+
+	case msgTypeResponse:
+		ret = &MsgResponse{}
+
+	case msgTypeTxGoFChanged:
+		ret = &MsgTxGoFChanged{}
+
+	case msgTypeBacklog:
+		ret = &MsgBacklog{}
+
+	case msgTypeGetInclusionProof:
+		ret = &MsgGetInclusionProof{}
+
+	case msgTypeInclusionProof:
+		ret = &MsgInclusionProof{}
+
+	case msgTypeTransactionPosted:
+		ret = &MsgTransactionPosted{}
+
+	case msgTypeSubscriptionsUpdated:
+		ret = &MsgSubscriptionsUpdated{}
+
 	default:
 		return nil, fmt.Errorf("unknown message type %d", msgType)
 	}
@@ -315,6 +500,7 @@ func (msg *MsgGetBacklog) Type() MessageType {
 func (msg *MsgSetID) Write(w *marshalutil.MarshalUtil) {
 	w.WriteUint16(uint16(len(msg.ClientID)))
 	w.WriteBytes([]byte(msg.ClientID))
+	w.WriteUint8(msg.ProtocolVersion)
 }
 
 func (msg *MsgSetID) Read(m *marshalutil.MarshalUtil) error {
@@ -328,7 +514,18 @@ func (msg *MsgSetID) Read(m *marshalutil.MarshalUtil) error {
 		return err
 	}
 	msg.ClientID = string(clientID)
-	return nil
+
+	// legacy clients do not send the protocol version
+	done, err := m.DoneReading()
+	if err != nil {
+		return err
+	}
+	if done {
+		msg.ProtocolVersion = protocolVersionLegacy
+		return nil
+	}
+	msg.ProtocolVersion, err = m.ReadUint8()
+	return err
 }
 
 // Type returns the Message type.
@@ -368,6 +565,11 @@ func (msg *MsgTxGoF) Read(m *marshalutil.MarshalUtil) error {
 	if msg.Address, err = ledgerstate.AddressFromMarshalUtil(m); err != nil {
 		return err
 	}
+	gradeOfFinality, err := m.ReadUint8()
+	if err != nil {
+		return err
+	}
+	msg.GradeOfFinality = gof.GradeOfFinality(gradeOfFinality)
 	if msg.TxID, err = ledgerstate.TransactionIDFromMarshalUtil(m); err != nil {
 		return err
 	}
@@ -481,6 +683,261 @@ func (msg *MsgUnspentAliasOutput) Type() MessageType {
 	return msgTypeUnspentAliasOutput
 }
 
+func (msg *MsgAuthChallenge) Write(w *marshalutil.MarshalUtil) {
+	w.WriteBytes(msg.Nonce[:])
+}
+
+func (msg *MsgAuthChallenge) Read(m *marshalutil.MarshalUtil) error {
+	nonce, err := m.ReadBytes(AuthNonceLength)
+	if err != nil {
+		return err
+	}
+	copy(msg.Nonce[:], nonce)
+	return nil
+}
+
+// Type returns the Message type.
+func (msg *MsgAuthChallenge) Type() MessageType {
+	return msgTypeAuthChallenge
+}
+
+func (msg *MsgAuthResponse) Write(w *marshalutil.MarshalUtil) {
+	w.Write(msg.PublicKey)
+	w.Write(msg.Signature)
+}
+
+func (msg *MsgAuthResponse) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	if msg.PublicKey, err = ed25519.ParsePublicKey(m); err != nil {
+		return err
+	}
+	msg.Signature, err = ed25519.ParseSignature(m)
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgAuthResponse) Type() MessageType {
+	return msgTypeAuthResponse
+}
+
+func (msg *MsgRequest) Write(w *marshalutil.MarshalUtil) {
+	w.WriteUint32(msg.RequestID)
+	writeEmbeddedMsg(w, msg.Request)
+}
+
+func (msg *MsgRequest) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	if msg.RequestID, err = m.ReadUint32(); err != nil {
+		return err
+	}
+	msg.Request, err = readEmbeddedMsg(m, FlagClientToServer)
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgRequest) Type() MessageType {
+	return msgTypeRequest
+}
+
+func (msg *MsgSubscribeTxGoF) Write(w *marshalutil.MarshalUtil) {
+	w.WriteUint16(uint16(len(msg.TxIDs)))
+	for _, txID := range msg.TxIDs {
+		w.Write(txID)
+	}
+}
+
+func (msg *MsgSubscribeTxGoF) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	var size uint16
+	if size, err = m.ReadUint16(); err != nil {
+		return err
+	}
+	msg.TxIDs = make([]ledgerstate.TransactionID, size)
+	for i := uint16(0); i < size; i++ {
+		if msg.TxIDs[i], err = ledgerstate.TransactionIDFromMarshalUtil(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type returns the Message type.
+func (msg *MsgSubscribeTxGoF) Type() MessageType {
+	return msgTypeSubscribeTxGoF
+}
+
+func (msg *MsgResponse) Write(w *marshalutil.MarshalUtil) {
+	w.WriteUint32(msg.RequestID)
+	w.WriteByte(byte(msg.ErrorCode))
+	if msg.ErrorCode == ErrorCodeNone {
+		writeEmbeddedMsg(w, msg.Response)
+	}
+}
+
+func (msg *MsgResponse) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	if msg.RequestID, err = m.ReadUint32(); err != nil {
+		return err
+	}
+	errorCode, err := m.ReadByte()
+	if err != nil {
+		return err
+	}
+	msg.ErrorCode = ErrorCode(errorCode)
+	if msg.ErrorCode != ErrorCodeNone {
+		return nil
+	}
+	msg.Response, err = readEmbeddedMsg(m, FlagServerToClient)
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgResponse) Type() MessageType {
+	return msgTypeResponse
+}
+
+func (msg *MsgTxGoFChanged) Write(w *marshalutil.MarshalUtil) {
+	w.Write(msg.TxID)
+	w.Write(msg.GradeOfFinality)
+}
+
+func (msg *MsgTxGoFChanged) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	if msg.TxID, err = ledgerstate.TransactionIDFromMarshalUtil(m); err != nil {
+		return err
+	}
+	gradeOfFinality, err := m.ReadUint8()
+	if err != nil {
+		return err
+	}
+	msg.GradeOfFinality = gof.GradeOfFinality(gradeOfFinality)
+	return nil
+}
+
+// Type returns the Message type.
+func (msg *MsgTxGoFChanged) Type() MessageType {
+	return msgTypeTxGoFChanged
+}
+
+func (msg *MsgBacklog) Write(w *marshalutil.MarshalUtil) {
+	w.Write(msg.Address)
+	w.WriteUint16(uint16(len(msg.Transactions)))
+	for _, tx := range msg.Transactions {
+		w.Write(tx)
+	}
+}
+
+func (msg *MsgBacklog) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	if msg.Address, err = ledgerstate.AddressFromMarshalUtil(m); err != nil {
+		return err
+	}
+	var size uint16
+	if size, err = m.ReadUint16(); err != nil {
+		return err
+	}
+	msg.Transactions = make([]*ledgerstate.Transaction, size)
+	for i := uint16(0); i < size; i++ {
+		if msg.Transactions[i], err = ledgerstate.TransactionFromMarshalUtil(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type returns the Message type.
+func (msg *MsgBacklog) Type() MessageType {
+	return msgTypeBacklog
+}
+
+func (msg *MsgGetInclusionProof) Write(w *marshalutil.MarshalUtil) {
+	w.Write(msg.TxID)
+}
+
+func (msg *MsgGetInclusionProof) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	msg.TxID, err = ledgerstate.TransactionIDFromMarshalUtil(m)
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgGetInclusionProof) Type() MessageType {
+	return msgTypeGetInclusionProof
+}
+
+func (msg *MsgInclusionProof) Write(w *marshalutil.MarshalUtil) {
+	w.Write(msg.TxID)
+	w.WriteBytes(msg.Proof.Bytes())
+}
+
+func (msg *MsgInclusionProof) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	if msg.TxID, err = ledgerstate.TransactionIDFromMarshalUtil(m); err != nil {
+		return err
+	}
+	msg.Proof, err = inclusionproof.FromMarshalUtil(m)
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgInclusionProof) Type() MessageType {
+	return msgTypeInclusionProof
+}
+
+func (msg *MsgTransactionPosted) Write(w *marshalutil.MarshalUtil) {
+	w.Write(msg.TxID)
+}
+
+func (msg *MsgTransactionPosted) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	msg.TxID, err = ledgerstate.TransactionIDFromMarshalUtil(m)
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgTransactionPosted) Type() MessageType {
+	return msgTypeTransactionPosted
+}
+
+func (msg *MsgSubscriptionsUpdated) Write(w *marshalutil.MarshalUtil) {
+	w.WriteUint16(msg.Count)
+}
+
+func (msg *MsgSubscriptionsUpdated) Read(m *marshalutil.MarshalUtil) error {
+	var err error
+	msg.Count, err = m.ReadUint16()
+	return err
+}
+
+// Type returns the Message type.
+func (msg *MsgSubscriptionsUpdated) Type() MessageType {
+	return msgTypeSubscriptionsUpdated
+}
+
+// writeEmbeddedMsg writes a length prefixed encoded Message.
+func writeEmbeddedMsg(w *marshalutil.MarshalUtil, msg Message) {
+	data := EncodeMsg(msg)
+	w.WriteUint32(uint32(len(data)))
+	w.WriteBytes(data)
+}
+
+// readEmbeddedMsg reads a length prefixed encoded Message.
+func readEmbeddedMsg(m *marshalutil.MarshalUtil, expectedFlags uint8) (Message, error) {
+	size, err := m.ReadUint32()
+	if err != nil {
+		return nil, err
+	}
+	data, err := m.ReadBytes(int(size))
+	if err != nil {
+		return nil, err
+	}
+	msg, err := DecodeMsg(data, expectedFlags)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(Message), nil
+}
+
 func (msg *MsgChunk) Write(w *marshalutil.MarshalUtil) {
 	w.WriteUint16(uint16(len(msg.Data)))
 	w.WriteBytes(msg.Data)
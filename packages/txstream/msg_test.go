@@ -0,0 +1,94 @@
+package txstream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestMsgSetID(t *testing.T) {
+	msg, err := DecodeMsg(EncodeMsg(&MsgSetID{ClientID: "test", ProtocolVersion: ProtocolVersion}), FlagClientToServer)
+	require.NoError(t, err)
+	assert.Equal(t, &MsgSetID{ClientID: "test", ProtocolVersion: ProtocolVersion}, msg)
+
+	// legacy clients do not send the protocol version
+	legacy := marshalutil.New()
+	legacy.WriteByte(byte(msgTypeSetID))
+	legacy.WriteUint16(4)
+	legacy.WriteBytes([]byte("test"))
+	msg, err = DecodeMsg(legacy.Bytes(), FlagClientToServer)
+	require.NoError(t, err)
+	assert.Equal(t, &MsgSetID{ClientID: "test", ProtocolVersion: protocolVersionLegacy}, msg)
+}
+
+func TestMsgRequestResponse(t *testing.T) {
+	txID := ledgerstate.TransactionID{1, 2, 3}
+
+	request := &MsgRequest{RequestID: 42, Request: &MsgSubscribeTxGoF{TxIDs: []ledgerstate.TransactionID{txID}}}
+	msg, err := DecodeMsg(EncodeMsg(request), FlagClientToServer)
+	require.NoError(t, err)
+	assert.Equal(t, request, msg)
+
+	response := &MsgResponse{RequestID: 42, Response: &MsgTxGoFChanged{TxID: txID, GradeOfFinality: gof.Medium}}
+	msg, err = DecodeMsg(EncodeMsg(response), FlagServerToClient)
+	require.NoError(t, err)
+	assert.Equal(t, response, msg)
+
+	posted := &MsgResponse{RequestID: 42, Response: &MsgTransactionPosted{TxID: txID}}
+	msg, err = DecodeMsg(EncodeMsg(posted), FlagServerToClient)
+	require.NoError(t, err)
+	assert.Equal(t, posted, msg)
+
+	updated := &MsgResponse{RequestID: 42, Response: &MsgSubscriptionsUpdated{Count: 3}}
+	msg, err = DecodeMsg(EncodeMsg(updated), FlagServerToClient)
+	require.NoError(t, err)
+	assert.Equal(t, updated, msg)
+
+	notFound := &MsgResponse{RequestID: 43, ErrorCode: ErrorCodeNotFound}
+	msg, err = DecodeMsg(EncodeMsg(notFound), FlagServerToClient)
+	require.NoError(t, err)
+	assert.Equal(t, notFound, msg)
+	assert.ErrorIs(t, msg.(*MsgResponse).ErrorCode.Err(), ErrNotFound)
+}
+
+func TestMsgTxGoF(t *testing.T) {
+	txGoF := &MsgTxGoF{
+		Address:         ledgerstate.NewED25519Address([32]byte{1}),
+		TxID:            ledgerstate.TransactionID{1},
+		GradeOfFinality: gof.High,
+	}
+	msg, err := DecodeMsg(EncodeMsg(txGoF), FlagServerToClient)
+	require.NoError(t, err)
+	assert.Equal(t, txGoF.GradeOfFinality, msg.(*MsgTxGoF).GradeOfFinality)
+	assert.Equal(t, txGoF.TxID, msg.(*MsgTxGoF).TxID)
+}
+
+func TestMsgInclusionProof(t *testing.T) {
+	txID := ledgerstate.TransactionID{1}
+
+	request := &MsgRequest{RequestID: 1, Request: &MsgGetInclusionProof{TxID: txID}}
+	msg, err := DecodeMsg(EncodeMsg(request), FlagClientToServer)
+	require.NoError(t, err)
+	assert.Equal(t, request, msg)
+
+	message, err := tangle.NewMessage(tangle.MessageIDs{tangle.EmptyMessageID}, nil, nil, nil, time.Now(), ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte("test")), 0, ed25519.Signature{})
+	require.NoError(t, err)
+	proof, err := inclusionproof.NewProof([]*tangle.Message{message}, gof.High, 0.5)
+	require.NoError(t, err)
+
+	msg, err = DecodeMsg(EncodeMsg(&MsgResponse{RequestID: 1, Response: &MsgInclusionProof{TxID: txID, Proof: proof}}), FlagServerToClient)
+	require.NoError(t, err)
+	inclusionProof := msg.(*MsgResponse).Response.(*MsgInclusionProof)
+	assert.Equal(t, txID, inclusionProof.TxID)
+	assert.Equal(t, proof.Bytes(), inclusionProof.Proof.Bytes())
+}
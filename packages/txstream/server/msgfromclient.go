@@ -4,6 +4,13 @@ package server
 // SPDX-License-Identifier: Apache-2.0
 
 import (
+	"errors"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/packages/txstream"
 
@@ -11,16 +18,36 @@ import (
 )
 
 // process first message from client.
-func (c *Connection) receiveClientID(data []byte) (string, error) {
+func (c *Connection) receiveClientID(data []byte) (string, uint8, error) {
 	msg, err := txstream.DecodeMsg(data, txstream.FlagClientToServer)
 	if err != nil {
-		return "", xerrors.Errorf("DecodeMsg: %v", err)
+		return "", 0, xerrors.Errorf("DecodeMsg: %v", err)
 	}
 
 	if msg, ok := msg.(*txstream.MsgSetID); ok {
-		return msg.ClientID, nil
+		return msg.ClientID, msg.ProtocolVersion, nil
+	}
+	return "", 0, xerrors.Errorf("wrong msg type: %T", msg)
+}
+
+// process the reply of the client to the authentication challenge.
+func (c *Connection) receiveAuthResponse(data []byte, nonce [txstream.AuthNonceLength]byte, clientID string) (ed25519.PublicKey, error) {
+	msg, err := txstream.DecodeMsg(data, txstream.FlagClientToServer)
+	if err != nil {
+		return ed25519.PublicKey{}, xerrors.Errorf("DecodeMsg: %v", err)
 	}
-	return "", xerrors.Errorf("wrong msg type: %T", msg)
+
+	response, ok := msg.(*txstream.MsgAuthResponse)
+	if !ok {
+		return ed25519.PublicKey{}, xerrors.Errorf("wrong msg type: %T", msg)
+	}
+	if !c.options.isAllowed(response.PublicKey) {
+		return ed25519.PublicKey{}, xerrors.Errorf("public key %s is not allowed", response.PublicKey.String())
+	}
+	if !response.PublicKey.VerifySignature(txstream.AuthChallengeData(nonce, clientID), response.Signature) {
+		return ed25519.PublicKey{}, xerrors.Errorf("invalid signature for public key %s", response.PublicKey.String())
+	}
+	return response.PublicKey, nil
 }
 
 // process messages received from the client.
@@ -41,18 +68,24 @@ func (c *Connection) processMessageFromClient(data []byte) error {
 		}
 
 	case *txstream.MsgPostTransaction:
-		c.postTransaction(msg.Tx)
+		_ = c.postTransaction(msg.Tx)
 
 	case *txstream.MsgUpdateSubscriptions:
-		newAddrs := c.setSubscriptions(msg.Addresses)
-		// send backlogs of newly subscribed addresses
-		for _, addr := range newAddrs {
-			c.getBacklog(addr)
+		if !c.updateSubscriptions(msg) {
+			return xerrors.Errorf("subscription update rejected: %w", txstream.ErrLimitExceeded)
+		}
+
+	case *txstream.MsgSubscribeTxGoF:
+		if !c.subscribeTxGoF(msg) {
+			return xerrors.Errorf("transaction subscription update rejected: %w", txstream.ErrLimitExceeded)
 		}
 
 	case *txstream.MsgGetConfirmedTransaction:
 		c.pushTransaction(msg.TxID, msg.Address)
 
+	case *txstream.MsgGetTxInclusionState:
+		c.sendTxInclusionStateOf(msg.TxID, msg.Address)
+
 	case *txstream.MsgGetBacklog:
 		c.getBacklog(msg.Address)
 
@@ -62,8 +95,112 @@ func (c *Connection) processMessageFromClient(data []byte) error {
 	case *txstream.MsgGetUnspentAliasOutput:
 		c.sendUnspentAliasOutput(msg.AliasAddress)
 
+	case *txstream.MsgRequest:
+		c.processRequest(msg)
+
 	default:
 		return xerrors.Errorf("wrong msg type: %T", msg)
 	}
 	return nil
 }
+
+// process a request wrapped in a MsgRequest and reply with a MsgResponse carrying the same request ID.
+func (c *Connection) processRequest(req *txstream.MsgRequest) {
+	var response txstream.Message
+	found := true
+
+	switch msg := req.Request.(type) {
+	case *txstream.MsgPostTransaction:
+		if err := c.postTransaction(msg.Tx); err != nil {
+			c.sendErrorResponse(req.RequestID, errorCode(err))
+			return
+		}
+		response = &txstream.MsgTransactionPosted{TxID: msg.Tx.ID()}
+
+	case *txstream.MsgUpdateSubscriptions:
+		if !c.updateSubscriptions(msg) {
+			c.sendErrorResponse(req.RequestID, txstream.ErrorCodeLimitExceeded)
+			return
+		}
+		response = &txstream.MsgSubscriptionsUpdated{Count: uint16(len(msg.Addresses))}
+
+	case *txstream.MsgSubscribeTxGoF:
+		if !c.subscribeTxGoF(msg) {
+			c.sendErrorResponse(req.RequestID, txstream.ErrorCodeLimitExceeded)
+			return
+		}
+		response = &txstream.MsgSubscriptionsUpdated{Count: uint16(len(msg.TxIDs))}
+
+	case *txstream.MsgGetConfirmedTransaction:
+		var tx *ledgerstate.Transaction
+		if tx, found = c.confirmedTransaction(msg.TxID); found {
+			response = &txstream.MsgTransaction{Address: msg.Address, Tx: tx}
+		}
+
+	case *txstream.MsgGetTxInclusionState:
+		var gradeOfFinality gof.GradeOfFinality
+		if gradeOfFinality, found = c.ledger.GetTransactionGoF(msg.TxID); found {
+			response = &txstream.MsgTxGoF{Address: msg.Address, TxID: msg.TxID, GradeOfFinality: gradeOfFinality}
+		}
+
+	case *txstream.MsgGetBacklog:
+		response = c.backlog(msg.Address)
+
+	case *txstream.MsgGetConfirmedOutput:
+		response, found = c.output(msg.OutputID, msg.Address)
+
+	case *txstream.MsgGetUnspentAliasOutput:
+		response, found = c.unspentAliasOutput(msg.AliasAddress)
+
+	case *txstream.MsgGetInclusionProof:
+		var proof *inclusionproof.Proof
+		if proof, found = c.ledger.GetInclusionProof(msg.TxID); found {
+			response = &txstream.MsgInclusionProof{TxID: msg.TxID, Proof: proof}
+		}
+
+	default:
+		c.log.Warnf("processRequest: unsupported request type: %T", msg)
+		c.sendErrorResponse(req.RequestID, txstream.ErrorCodeInvalidRequest)
+		return
+	}
+
+	if !found {
+		c.sendErrorResponse(req.RequestID, txstream.ErrorCodeNotFound)
+		return
+	}
+	c.sendResponse(req.RequestID, response)
+}
+
+// updateSubscriptions replaces the address subscriptions and sends the backlogs of the newly subscribed addresses. It
+// returns false if the update exceeds the subscription limit.
+func (c *Connection) updateSubscriptions(msg *txstream.MsgUpdateSubscriptions) bool {
+	newAddrs, ok := c.setSubscriptions(msg.Addresses)
+	for _, addr := range newAddrs {
+		c.getBacklog(addr)
+	}
+	return ok
+}
+
+// subscribeTxGoF replaces the transaction subscriptions and sends the current GoF of the newly subscribed
+// transactions. It returns false if the update exceeds the subscription limit.
+func (c *Connection) subscribeTxGoF(msg *txstream.MsgSubscribeTxGoF) bool {
+	newTxIDs, ok := c.setTxGoFSubscriptions(msg.TxIDs)
+	for _, txID := range newTxIDs {
+		if gradeOfFinality, found := c.ledger.GetTransactionGoF(txID); found {
+			c.sendTxGoFChanged(txID, gradeOfFinality)
+		}
+	}
+	return ok
+}
+
+// errorCode returns the txstream.ErrorCode corresponding to the given error.
+func errorCode(err error) txstream.ErrorCode {
+	switch {
+	case errors.Is(err, txstream.ErrNotFound):
+		return txstream.ErrorCodeNotFound
+	case errors.Is(err, txstream.ErrLimitExceeded):
+		return txstream.ErrorCodeLimitExceeded
+	default:
+		return txstream.ErrorCodeInvalidRequest
+	}
+}
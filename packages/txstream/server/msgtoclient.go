@@ -51,41 +51,94 @@ func (c *Connection) sendTxInclusionState(txid ledgerstate.TransactionID, addr l
 	})
 }
 
+func (c *Connection) sendTxGoFChanged(txid ledgerstate.TransactionID, gradeOfFinality gof.GradeOfFinality) {
+	c.sendMsgToClient(&txstream.MsgTxGoFChanged{
+		TxID:            txid,
+		GradeOfFinality: gradeOfFinality,
+	})
+}
+
+func (c *Connection) sendResponse(requestID uint32, response txstream.Message) {
+	c.sendMsgToClient(&txstream.MsgResponse{
+		RequestID: requestID,
+		Response:  response,
+	})
+}
+
+func (c *Connection) sendErrorResponse(requestID uint32, errorCode txstream.ErrorCode) {
+	c.sendMsgToClient(&txstream.MsgResponse{
+		RequestID: requestID,
+		ErrorCode: errorCode,
+	})
+}
+
 func (c *Connection) pushTransaction(txid ledgerstate.TransactionID, addr ledgerstate.Address) {
-	found := c.ledger.GetHighGoFTransaction(txid, func(tx *ledgerstate.Transaction) {
-		c.sendMsgToClient(&txstream.MsgTransaction{
-			Address: addr,
-			Tx:      tx,
-		})
+	tx, found := c.confirmedTransaction(txid)
+	if !found {
+		c.log.Warnf("pushTransaction: not found %s", txid.Base58())
+		return
+	}
+	c.sendMsgToClient(&txstream.MsgTransaction{
+		Address: addr,
+		Tx:      tx,
 	})
+}
+
+func (c *Connection) sendTxInclusionStateOf(txid ledgerstate.TransactionID, addr ledgerstate.Address) {
+	gradeOfFinality, found := c.ledger.GetTransactionGoF(txid)
 	if !found {
-		c.log.Warnf("pushTransaction: not found %c", txid.String())
+		c.log.Warnf("sendTxInclusionStateOf: not found %s", txid.Base58())
+		return
 	}
+	c.sendTxInclusionState(txid, addr, gradeOfFinality)
 }
 
 func (c *Connection) sendOutput(outputID ledgerstate.OutputID, addr ledgerstate.Address) {
-	validOutput := true
-	foundTx := c.ledger.GetHighGoFTransaction(outputID.TransactionID(), func(tx *ledgerstate.Transaction) {
+	msg, found := c.output(outputID, addr)
+	if !found {
+		c.log.Warnf("sendOutput: not found output %s", outputID.String())
+		return
+	}
+	c.sendMsgToClient(msg)
+}
+
+func (c *Connection) sendUnspentAliasOutput(addr *ledgerstate.AliasAddress) {
+	msg, found := c.unspentAliasOutput(addr)
+	if !found {
+		c.log.Warnf("sendUnspentAliasOutput: not found alias output for address %s", addr.Base58())
+		return
+	}
+	c.sendMsgToClient(msg)
+}
+
+// confirmedTransaction returns the transaction with the given ID if its GoF is high.
+func (c *Connection) confirmedTransaction(txid ledgerstate.TransactionID) (tx *ledgerstate.Transaction, found bool) {
+	found = c.ledger.GetHighGoFTransaction(txid, func(transaction *ledgerstate.Transaction) {
+		tx = transaction
+	})
+	return tx, found && tx != nil
+}
+
+// output returns the MsgOutput for the confirmed output with the given ID.
+func (c *Connection) output(outputID ledgerstate.OutputID, addr ledgerstate.Address) (msg *txstream.MsgOutput, found bool) {
+	c.ledger.GetHighGoFTransaction(outputID.TransactionID(), func(tx *ledgerstate.Transaction) {
 		idx := outputID.OutputIndex()
 		if int(idx) >= len(tx.Essence().Outputs()) {
-			validOutput = false
 			return
 		}
-		validOutput = c.ledger.GetOutputMetadata(outputID, func(meta *ledgerstate.OutputMetadata) {
-			c.sendMsgToClient(&txstream.MsgOutput{
+		found = c.ledger.GetOutputMetadata(outputID, func(meta *ledgerstate.OutputMetadata) {
+			msg = &txstream.MsgOutput{
 				Address:        addr,
-				Output:         tx.Essence().Outputs()[outputID.OutputIndex()].UpdateMintingColor(),
+				Output:         tx.Essence().Outputs()[idx].UpdateMintingColor(),
 				OutputMetadata: meta,
-			})
+			}
 		})
 	})
-	if !foundTx || !validOutput {
-		c.log.Warnf("sendOutput: not found output %s", outputID.String())
-	}
+	return msg, found
 }
 
-func (c *Connection) sendUnspentAliasOutput(addr *ledgerstate.AliasAddress) {
-	found := false
+// unspentAliasOutput returns the MsgUnspentAliasOutput for the unique unspent AliasOutput of the given AliasAddress.
+func (c *Connection) unspentAliasOutput(addr *ledgerstate.AliasAddress) (msg *txstream.MsgUnspentAliasOutput, found bool) {
 	c.ledger.GetUnspentOutputs(addr, func(out ledgerstate.Output) {
 		if found {
 			return
@@ -100,16 +153,14 @@ func (c *Connection) sendUnspentAliasOutput(addr *ledgerstate.AliasAddress) {
 					return
 				}
 				found = true
-				c.sendMsgToClient(&txstream.MsgUnspentAliasOutput{
+				msg = &txstream.MsgUnspentAliasOutput{
 					AliasAddress:   addr,
 					AliasOutput:    aliasOut,
 					OutputMetadata: meta,
 					Timestamp:      timestamp,
-				})
+				}
 			})
 		}
 	})
-	if !found {
-		c.log.Warnf("sendUnspentAliasOutput: not found alias output for address %s", addr.Base58())
-	}
+	return msg, found
 }
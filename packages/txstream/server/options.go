@@ -0,0 +1,117 @@
+package server
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+)
+
+// Option represents the return type of optional parameters that can be handed into Listen and Run to configure the
+// behavior of the server.
+type Option func(*Options)
+
+// Options is a container for all configurable parameters of the txstream server.
+type Options struct {
+	// TLSConfig enables TLS on the listener if set. Mutual TLS is achieved by setting ClientAuth and ClientCAs.
+	TLSConfig *tls.Config
+	// AllowedClients contains the public keys of the clients that are allowed to connect. If empty, the ed25519
+	// challenge-response handshake is disabled and any client is accepted.
+	AllowedClients []ed25519.PublicKey
+	// MaxSubscriptions is the maximum number of addresses and the maximum number of transactions a single client can
+	// subscribe to across all of its connections (0 means unlimited).
+	MaxSubscriptions int
+	// MaxPostedTransactions is the maximum number of transactions a single client can post within
+	// PostedTransactionsInterval (0 means unlimited).
+	MaxPostedTransactions int
+	// PostedTransactionsInterval is the time window used to enforce MaxPostedTransactions.
+	PostedTransactionsInterval time.Duration
+
+	// postLimiter is shared by all connections of a server to count the posted transactions per client.
+	postLimiter *postLimiter
+	// addressSubscriptions and txSubscriptions are shared by all connections of a server to count the subscriptions
+	// per client.
+	addressSubscriptions *subscriptionLimiter
+	txSubscriptions      *subscriptionLimiter
+}
+
+// defaultOptions returns the default options of the server.
+func defaultOptions() *Options {
+	return &Options{
+		PostedTransactionsInterval: time.Minute,
+	}
+}
+
+// buildOptions applies the given Options on top of the default options.
+func buildOptions(opts ...Option) *Options {
+	options := defaultOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.postLimiter == nil {
+		options.postLimiter = newPostLimiter(options.MaxPostedTransactions, options.PostedTransactionsInterval)
+	}
+	if options.addressSubscriptions == nil {
+		options.addressSubscriptions = newSubscriptionLimiter(options.MaxSubscriptions)
+	}
+	if options.txSubscriptions == nil {
+		options.txSubscriptions = newSubscriptionLimiter(options.MaxSubscriptions)
+	}
+	return options
+}
+
+// withSharedLimits is an Option that makes the connections share the per-client limits of the given Options.
+func withSharedLimits(shared *Options) Option {
+	return func(options *Options) {
+		options.postLimiter = shared.postLimiter
+		options.addressSubscriptions = shared.addressSubscriptions
+		options.txSubscriptions = shared.txSubscriptions
+	}
+}
+
+// WithTLSConfig is an Option that enables TLS with the given configuration.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(options *Options) {
+		options.TLSConfig = config
+	}
+}
+
+// WithAllowedClients is an Option that enables the ed25519 challenge-response handshake and only accepts clients
+// authenticating with one of the given public keys.
+func WithAllowedClients(publicKeys ...ed25519.PublicKey) Option {
+	return func(options *Options) {
+		options.AllowedClients = publicKeys
+	}
+}
+
+// WithMaxSubscriptions is an Option that limits the number of addresses and transactions a single client can subscribe
+// to.
+func WithMaxSubscriptions(maxSubscriptions int) Option {
+	return func(options *Options) {
+		options.MaxSubscriptions = maxSubscriptions
+	}
+}
+
+// WithMaxPostedTransactions is an Option that limits the number of transactions a single client can post within the
+// given interval.
+func WithMaxPostedTransactions(maxPostedTransactions int, interval time.Duration) Option {
+	return func(options *Options) {
+		options.MaxPostedTransactions = maxPostedTransactions
+		options.PostedTransactionsInterval = interval
+	}
+}
+
+// authenticationEnabled returns true if clients have to authenticate via the ed25519 challenge-response handshake.
+func (o *Options) authenticationEnabled() bool {
+	return len(o.AllowedClients) > 0
+}
+
+// isAllowed returns true if the given public key is contained in the allow-list.
+func (o *Options) isAllowed(publicKey ed25519.PublicKey) bool {
+	for _, allowed := range o.AllowedClients {
+		if allowed == publicKey {
+			return true
+		}
+	}
+	return false
+}
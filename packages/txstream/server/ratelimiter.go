@@ -0,0 +1,130 @@
+package server
+
+import (
+	"sync"
+	"time"
+)
+
+// postLimiter enforces the limit of posted transactions per client across all connections of the server, so clients
+// do not get a fresh quota by reconnecting.
+type postLimiter struct {
+	maxPosted   int
+	interval    time.Duration
+	windows     map[string]*postWindow
+	lastCleanup time.Time
+	mutex       sync.Mutex
+}
+
+// postWindow contains the amount of transactions a client posted since the start of the current time window.
+type postWindow struct {
+	start time.Time
+	count int
+}
+
+// newPostLimiter creates a new postLimiter that allows maxPosted transactions per client within the given interval.
+func newPostLimiter(maxPosted int, interval time.Duration) *postLimiter {
+	return &postLimiter{
+		maxPosted: maxPosted,
+		interval:  interval,
+		windows:   make(map[string]*postWindow),
+	}
+}
+
+// allow returns true if the client identified by the given key has not yet exceeded its limit of posted transactions
+// within the current time window.
+func (p *postLimiter) allow(clientKey string) bool {
+	if p.maxPosted <= 0 {
+		return true
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	p.cleanup(now)
+
+	window, exists := p.windows[clientKey]
+	if !exists || now.Sub(window.start) >= p.interval {
+		window = &postWindow{start: now}
+		p.windows[clientKey] = window
+	}
+	if window.count >= p.maxPosted {
+		return false
+	}
+	window.count++
+
+	return true
+}
+
+// cleanup removes the expired time windows once per interval, so the map does not grow with every client that ever
+// posted a transaction.
+func (p *postLimiter) cleanup(now time.Time) {
+	if now.Sub(p.lastCleanup) < p.interval {
+		return
+	}
+	p.lastCleanup = now
+
+	for clientKey, window := range p.windows {
+		if now.Sub(window.start) >= p.interval {
+			delete(p.windows, clientKey)
+		}
+	}
+}
+
+// subscriptionLimiter enforces the limit of subscriptions per client across all connections of the server, so clients
+// can not exceed it by opening several connections.
+type subscriptionLimiter struct {
+	maxSubscriptions int
+	counts           map[string]map[*Connection]int
+	mutex            sync.Mutex
+}
+
+// newSubscriptionLimiter creates a new subscriptionLimiter that allows maxSubscriptions subscriptions per client.
+func newSubscriptionLimiter(maxSubscriptions int) *subscriptionLimiter {
+	return &subscriptionLimiter{
+		maxSubscriptions: maxSubscriptions,
+		counts:           make(map[string]map[*Connection]int),
+	}
+}
+
+// set replaces the number of subscriptions of the given connection and returns false if the subscriptions of all
+// connections of the client would exceed the limit.
+func (s *subscriptionLimiter) set(clientKey string, conn *Connection, count int) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	connections, exists := s.counts[clientKey]
+	if !exists {
+		connections = make(map[*Connection]int)
+		s.counts[clientKey] = connections
+	}
+
+	if s.maxSubscriptions > 0 {
+		total := count
+		for other, otherCount := range connections {
+			if other != conn {
+				total += otherCount
+			}
+		}
+		if total > s.maxSubscriptions {
+			if len(connections) == 0 {
+				delete(s.counts, clientKey)
+			}
+			return false
+		}
+	}
+	connections[conn] = count
+
+	return true
+}
+
+// release removes the subscriptions of the given connection.
+func (s *subscriptionLimiter) release(clientKey string, conn *Connection) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.counts[clientKey], conn)
+	if len(s.counts[clientKey]) == 0 {
+		delete(s.counts, clientKey)
+	}
+}
@@ -1,12 +1,16 @@
 package server
 
 import (
+	"crypto/rand"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"net"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/netutil/buffconn"
@@ -23,22 +27,44 @@ type Connection struct {
 	bconn         *buffconn.BufferedConnection
 	chopper       *chopper.Chopper
 	subscriptions map[[ledgerstate.AddressLength]byte]bool
+	txGoFSubs     map[ledgerstate.TransactionID]bool
+	txGoFSubsMu   sync.RWMutex
 	ledger        txstream.Ledger
 	log           *logger.Logger
+	options       *Options
+
+	// clientKey identifies the client for the limits of subscriptions and posted transactions: the public key of
+	// authenticated clients or the host of the remote address otherwise.
+	clientKey string
 }
 
 type (
 	wrapBookedTx *ledgerstate.Transaction
+
+	wrapTxGoFChanged struct {
+		txID            ledgerstate.TransactionID
+		gradeOfFinality gof.GradeOfFinality
+	}
 )
 
 const rcvClientIDTimeout = 5 * time.Second
 
+// txGoFQueueSize is the amount of GoF changes of subscribed transactions that can be queued for a client. A client that
+// falls behind by more is disconnected, so it can not stall the ledger and does not miss GoF changes silently.
+const txGoFQueueSize = 1024
+
 // Listen starts a TCP listener and starts a Connection for each accepted connection.
-func Listen(ledger txstream.Ledger, bindAddress string, log *logger.Logger, shutdownSignal <-chan struct{}) error {
+func Listen(ledger txstream.Ledger, bindAddress string, log *logger.Logger, shutdownSignal <-chan struct{}, opts ...Option) error {
+	options := buildOptions(opts...)
+	opts = append(opts, withSharedLimits(options))
+
 	listener, err := net.Listen("tcp", bindAddress)
 	if err != nil {
 		return fmt.Errorf("failed to start TXStream daemon: %w", err)
 	}
+	if options.TLSConfig != nil {
+		listener = tls.NewListener(listener, options.TLSConfig)
+	}
 
 	go func() {
 		for {
@@ -47,7 +73,7 @@ func Listen(ledger txstream.Ledger, bindAddress string, log *logger.Logger, shut
 				return
 			}
 			log.Debugf("accepted connection from %s", conn.RemoteAddr().String())
-			go Run(conn, log, ledger, shutdownSignal)
+			go Run(conn, log, ledger, shutdownSignal, opts...)
 		}
 	}()
 
@@ -64,13 +90,16 @@ func Listen(ledger txstream.Ledger, bindAddress string, log *logger.Logger, shut
 }
 
 // Run starts the server-side handling code for an already accepted connection from a client.
-func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSignal <-chan struct{}) {
+func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSignal <-chan struct{}, opts ...Option) {
 	c := &Connection{
 		bconn:         buffconn.NewBufferedConnection(conn, tangle.MaxMessageSize),
 		chopper:       chopper.NewChopper(),
 		subscriptions: make(map[[ledgerstate.AddressLength]byte]bool),
+		txGoFSubs:     make(map[ledgerstate.TransactionID]bool),
 		ledger:        ledger,
 		log:           log,
+		options:       buildOptions(opts...),
+		clientKey:     remoteHost(conn),
 	}
 
 	defer c.bconn.Close()
@@ -79,15 +108,21 @@ func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSign
 	bconnDataReceived, bconnClosed := c.bconnReadLoop()
 
 	// expect first message received from client == MsgSetID
+	var clientID string
 	select {
 	case data := <-bconnDataReceived:
-		id, err := c.receiveClientID(data)
+		id, protocolVersion, err := c.receiveClientID(data)
 		if err != nil {
 			c.log.Errorf("first message from client: %v", err)
 			return
 		}
+		clientID = id
 		c.log = c.log.Named(id)
-		c.log.Infof("client connection id has been set to '%s' for '%s'", id, c.bconn.RemoteAddr().String())
+		c.log.Infof("client connection id has been set to '%s' for '%s' (protocol version %d)", id, c.bconn.RemoteAddr().String(), protocolVersion)
+		if protocolVersion > txstream.ProtocolVersion {
+			c.log.Errorf("unsupported protocol version %d", protocolVersion)
+			return
+		}
 	case <-shutdownSignal:
 		c.log.Infof("shutdown signal received")
 		return
@@ -99,7 +134,18 @@ func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSign
 		return
 	}
 
+	if c.options.authenticationEnabled() {
+		publicKey, authenticated := c.authenticate(clientID, bconnDataReceived, bconnClosed, shutdownSignal)
+		if !authenticated {
+			return
+		}
+		c.clientKey = publicKey.String()
+	}
+	defer c.options.addressSubscriptions.release(c.clientKey, c)
+	defer c.options.txSubscriptions.release(c.clientKey, c)
+
 	txFromLedgerQueue := make(chan interface{})
+	txGoFQueue := make(chan wrapTxGoFChanged, txGoFQueueSize)
 
 	{
 		cl := events.NewClosure(func(tx *ledgerstate.Transaction) {
@@ -109,6 +155,22 @@ func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSign
 		c.ledger.EventTransactionBooked().Attach(cl)
 		defer c.ledger.EventTransactionBooked().Detach(cl)
 	}
+	{
+		// the GoF changes are triggered synchronously by the ledger, so they are filtered and queued without blocking
+		cl := events.NewClosure(func(txID ledgerstate.TransactionID, gradeOfFinality gof.GradeOfFinality) {
+			if !c.isTxGoFSubscribed(txID) {
+				return
+			}
+			select {
+			case txGoFQueue <- wrapTxGoFChanged{txID: txID, gradeOfFinality: gradeOfFinality}:
+			default:
+				c.log.Warnf("client does not keep up with the GoF changes of its %d subscribed transactions: disconnecting", c.txGoFSubscriptionCount())
+				_ = c.bconn.Close()
+			}
+		})
+		c.ledger.EventTransactionGoFChanged().Attach(cl)
+		defer c.ledger.EventTransactionGoFChanged().Detach(cl)
+	}
 
 	c.log.Debugf("started txStream")
 	defer c.log.Debugf("stopped txStream")
@@ -123,6 +185,8 @@ func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSign
 			default:
 				c.log.Panicf("wrong type")
 			}
+		case update := <-txGoFQueue:
+			c.processTxGoFChanged(update.txID, update.gradeOfFinality)
 		case data := <-bconnDataReceived:
 			if err := c.processMessageFromClient(data); err != nil {
 				c.log.Errorf("processMessageFromClient: %v", err)
@@ -137,6 +201,45 @@ func Run(conn net.Conn, log *logger.Logger, ledger txstream.Ledger, shutdownSign
 	}
 }
 
+// authenticate performs the ed25519 challenge-response handshake with the client and returns true if the client
+// proved the possession of a private key belonging to one of the allowed public keys, along with that key.
+func (c *Connection) authenticate(clientID string, bconnDataReceived chan []byte, bconnClosed chan bool, shutdownSignal <-chan struct{}) (ed25519.PublicKey, bool) {
+	challenge := &txstream.MsgAuthChallenge{}
+	if _, err := rand.Read(challenge.Nonce[:]); err != nil {
+		c.log.Errorf("failed to generate authentication challenge: %v", err)
+		return ed25519.PublicKey{}, false
+	}
+	c.sendMsgToClient(challenge)
+
+	select {
+	case data := <-bconnDataReceived:
+		publicKey, err := c.receiveAuthResponse(data, challenge.Nonce, clientID)
+		if err != nil {
+			c.log.Errorf("authentication of client failed: %v", err)
+			return ed25519.PublicKey{}, false
+		}
+		c.log.Infof("client authenticated with public key %s", publicKey.String())
+		return publicKey, true
+	case <-shutdownSignal:
+		c.log.Infof("shutdown signal received")
+	case <-bconnClosed:
+		c.log.Errorf("connection lost")
+	case <-time.After(rcvClientIDTimeout):
+		c.log.Errorf("timeout receiving authentication response")
+	}
+	return ed25519.PublicKey{}, false
+}
+
+// remoteHost returns the host of the remote address of the given connection, so all connections of a client share the
+// same limits.
+func remoteHost(conn net.Conn) string {
+	host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		return conn.RemoteAddr().String()
+	}
+	return host
+}
+
 func (c *Connection) bconnReadLoop() (chan []byte, chan bool) {
 	bconnDataReceived := make(chan []byte)
 	bconnClosed := make(chan bool)
@@ -170,7 +273,12 @@ func (c *Connection) bconnReadLoop() (chan []byte, chan bool) {
 	return bconnDataReceived, bconnClosed
 }
 
-func (c *Connection) setSubscriptions(addrs []ledgerstate.Address) (newAddrs []ledgerstate.Address) {
+func (c *Connection) setSubscriptions(addrs []ledgerstate.Address) (newAddrs []ledgerstate.Address, ok bool) {
+	if !c.options.addressSubscriptions.set(c.clientKey, c, len(addrs)) {
+		c.log.Warnf("subscription update rejected: %d addresses exceed the limit of %d of the client", len(addrs), c.options.MaxSubscriptions)
+		return nil, false
+	}
+
 	subscriptions := make(map[[ledgerstate.AddressLength]byte]bool)
 	for _, addr := range addrs {
 		if !c.isSubscribed(addr) {
@@ -179,7 +287,45 @@ func (c *Connection) setSubscriptions(addrs []ledgerstate.Address) (newAddrs []l
 		subscriptions[addr.Array()] = true
 	}
 	c.subscriptions = subscriptions
-	return
+	return newAddrs, true
+}
+
+func (c *Connection) setTxGoFSubscriptions(txIDs []ledgerstate.TransactionID) (newTxIDs []ledgerstate.TransactionID, ok bool) {
+	if !c.options.txSubscriptions.set(c.clientKey, c, len(txIDs)) {
+		c.log.Warnf("transaction subscription update rejected: %d transactions exceed the limit of %d of the client", len(txIDs), c.options.MaxSubscriptions)
+		return nil, false
+	}
+
+	txGoFSubs := make(map[ledgerstate.TransactionID]bool)
+	for _, txID := range txIDs {
+		if !c.isTxGoFSubscribed(txID) {
+			newTxIDs = append(newTxIDs, txID)
+		}
+		txGoFSubs[txID] = true
+	}
+
+	c.txGoFSubsMu.Lock()
+	defer c.txGoFSubsMu.Unlock()
+	c.txGoFSubs = txGoFSubs
+
+	return newTxIDs, true
+}
+
+// isTxGoFSubscribed returns true if the client is subscribed to the GoF changes of the given transaction. It is safe
+// to call from the event handlers of the ledger.
+func (c *Connection) isTxGoFSubscribed(txID ledgerstate.TransactionID) bool {
+	c.txGoFSubsMu.RLock()
+	defer c.txGoFSubsMu.RUnlock()
+
+	return c.txGoFSubs[txID]
+}
+
+// txGoFSubscriptionCount returns the number of transactions the client is subscribed to.
+func (c *Connection) txGoFSubscriptionCount() int {
+	c.txGoFSubsMu.RLock()
+	defer c.txGoFSubsMu.RUnlock()
+
+	return len(c.txGoFSubs)
 }
 
 func (c *Connection) isSubscribed(addr ledgerstate.Address) bool {
@@ -214,18 +360,54 @@ func (c *Connection) processBookedTransaction(tx *ledgerstate.Transaction) {
 	}
 }
 
+func (c *Connection) processTxGoFChanged(txID ledgerstate.TransactionID, gradeOfFinality gof.GradeOfFinality) {
+	if !c.isTxGoFSubscribed(txID) {
+		return
+	}
+	c.log.Debugf("tx GoF changed -> client -- txid: %s, GoF: %s", txID.Base58(), gradeOfFinality)
+	c.sendTxGoFChanged(txID, gradeOfFinality)
+}
+
 func (c *Connection) getBacklog(addr ledgerstate.Address) {
+	for txid := range c.backlogTransactionIDs(addr) {
+		c.pushTransaction(txid, addr)
+	}
+}
+
+// backlog returns the confirmed transactions with unspent outputs targeted to the given address.
+func (c *Connection) backlog(addr ledgerstate.Address) *txstream.MsgBacklog {
+	backlog := &txstream.MsgBacklog{Address: addr}
+	for txid := range c.backlogTransactionIDs(addr) {
+		if tx, found := c.confirmedTransaction(txid); found {
+			backlog.Transactions = append(backlog.Transactions, tx)
+		}
+	}
+	return backlog
+}
+
+func (c *Connection) backlogTransactionIDs(addr ledgerstate.Address) map[ledgerstate.TransactionID]bool {
 	txs := make(map[ledgerstate.TransactionID]bool)
 	c.ledger.GetUnspentOutputs(addr, func(out ledgerstate.Output) {
 		txs[out.ID().TransactionID()] = true
 	})
-	for txid := range txs {
-		c.pushTransaction(txid, addr)
-	}
+	return txs
 }
 
-func (c *Connection) postTransaction(tx *ledgerstate.Transaction) {
+func (c *Connection) postTransaction(tx *ledgerstate.Transaction) error {
+	if !c.allowPostTransaction() {
+		c.log.Warnf("posted transaction rejected: limit of %d transactions per %v exceeded: %s", c.options.MaxPostedTransactions, c.options.PostedTransactionsInterval, tx.ID().Base58())
+		return txstream.ErrLimitExceeded
+	}
+
 	if err := c.ledger.PostTransaction(tx); err != nil {
 		c.log.Debugf("%v: %s", err, tx.ID().Base58())
+		return txstream.ErrInvalidRequest
 	}
+	return nil
+}
+
+// allowPostTransaction returns true if the client has not yet exceeded its limit of posted transactions within the
+// current time window. The limit is shared by all connections of the same client.
+func (c *Connection) allowPostTransaction() bool {
+	return c.options.postLimiter.allow(c.clientKey)
 }
@@ -0,0 +1,384 @@
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/netutil/buffconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/txstream"
+)
+
+func TestAuthentication(t *testing.T) {
+	allowedKeyPair := ed25519.GenerateKeyPair()
+	otherKeyPair := ed25519.GenerateKeyPair()
+
+	t.Run("allowed client", func(t *testing.T) {
+		ledger, conn, closed := start(t, WithAllowedClients(allowedKeyPair.PublicKey))
+		authenticate(t, conn, &allowedKeyPair, "test")
+
+		address := ledgerstate.NewED25519Address(allowedKeyPair.PublicKey)
+		_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgGetBacklog{Address: address}))
+		require.NoError(t, err)
+
+		select {
+		case requested := <-ledger.unspentOutputsRequested:
+			assert.Equal(t, address.Array(), requested.Array())
+		case <-closed:
+			t.Fatal("connection closed")
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+	})
+
+	t.Run("unknown client", func(t *testing.T) {
+		_, conn, closed := start(t, WithAllowedClients(allowedKeyPair.PublicKey))
+		authenticate(t, conn, &otherKeyPair, "test")
+		assertClosed(t, closed)
+	})
+
+	t.Run("wrong client ID", func(t *testing.T) {
+		_, conn, closed := start(t, WithAllowedClients(allowedKeyPair.PublicKey))
+		challenge := sendClientID(t, conn, "test")
+		_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgAuthResponse{
+			PublicKey: allowedKeyPair.PublicKey,
+			Signature: allowedKeyPair.PrivateKey.Sign(txstream.AuthChallengeData(challenge.Nonce, "other")),
+		}))
+		require.NoError(t, err)
+		assertClosed(t, closed)
+	})
+}
+
+func TestRequestResponse(t *testing.T) {
+	ledger, conn, _ := start(t)
+	received := receivedMessages(conn)
+
+	_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgSetID{ClientID: "test", ProtocolVersion: txstream.ProtocolVersion}))
+	require.NoError(t, err)
+
+	txID := ledgerstate.TransactionID{1}
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{
+		RequestID: 1,
+		Request:   &txstream.MsgGetTxInclusionState{Address: ledgerstate.NewED25519Address(ed25519.PublicKey{}), TxID: txID},
+	}))
+	require.NoError(t, err)
+
+	response := receiveMessage(t, received).(*txstream.MsgResponse)
+	assert.Equal(t, uint32(1), response.RequestID)
+	assert.Equal(t, txstream.ErrorCodeNotFound, response.ErrorCode)
+	assert.Nil(t, response.Response)
+
+	address := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{
+		RequestID: 2,
+		Request:   &txstream.MsgGetBacklog{Address: address},
+	}))
+	require.NoError(t, err)
+	<-ledger.unspentOutputsRequested
+
+	response = receiveMessage(t, received).(*txstream.MsgResponse)
+	assert.Equal(t, uint32(2), response.RequestID)
+	assert.Equal(t, txstream.ErrorCodeNone, response.ErrorCode)
+	require.IsType(t, &txstream.MsgBacklog{}, response.Response)
+	assert.Equal(t, address.Array(), response.Response.(*txstream.MsgBacklog).Address.Array())
+	assert.Empty(t, response.Response.(*txstream.MsgBacklog).Transactions)
+}
+
+func TestTxGoFSubscription(t *testing.T) {
+	ledger, conn, _ := start(t)
+	received := receivedMessages(conn)
+
+	_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgSetID{ClientID: "test", ProtocolVersion: txstream.ProtocolVersion}))
+	require.NoError(t, err)
+
+	subscribedTxID := ledgerstate.TransactionID{1}
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgSubscribeTxGoF{TxIDs: []ledgerstate.TransactionID{subscribedTxID}}))
+	require.NoError(t, err)
+
+	// wait until the subscription has been processed
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{RequestID: 1, Request: &txstream.MsgGetBacklog{Address: ledgerstate.NewED25519Address(ed25519.PublicKey{})}}))
+	require.NoError(t, err)
+	<-ledger.unspentOutputsRequested
+	require.IsType(t, &txstream.MsgResponse{}, receiveMessage(t, received))
+
+	ledger.gofChangedEvent.Trigger(ledgerstate.TransactionID{2}, gof.Medium)
+	ledger.gofChangedEvent.Trigger(subscribedTxID, gof.High)
+
+	gofChanged := receiveMessage(t, received).(*txstream.MsgTxGoFChanged)
+	assert.Equal(t, subscribedTxID, gofChanged.TxID)
+	assert.Equal(t, gof.High, gofChanged.GradeOfFinality)
+}
+
+func TestTxGoFQueueOverflow(t *testing.T) {
+	ledger, conn, closed := start(t)
+	received := receivedMessages(conn)
+
+	_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgSetID{ClientID: "test", ProtocolVersion: txstream.ProtocolVersion}))
+	require.NoError(t, err)
+
+	subscribedTxID := ledgerstate.TransactionID{1}
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgSubscribeTxGoF{TxIDs: []ledgerstate.TransactionID{subscribedTxID}}))
+	require.NoError(t, err)
+
+	// wait until the subscription has been processed
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{RequestID: 1, Request: &txstream.MsgGetBacklog{Address: ledgerstate.NewED25519Address(ed25519.PublicKey{})}}))
+	require.NoError(t, err)
+	<-ledger.unspentOutputsRequested
+	require.IsType(t, &txstream.MsgResponse{}, receiveMessage(t, received))
+
+	// the client stops reading, so the server can not send the GoF changes anymore
+	blocked := make(chan struct{})
+	conn.Events.ReceiveMessage.Attach(events.NewClosure(func([]byte) { <-blocked }))
+
+	triggered := make(chan struct{})
+	go func() {
+		defer close(triggered)
+		for i := 0; i < 2*txGoFQueueSize; i++ {
+			ledger.gofChangedEvent.Trigger(ledgerstate.TransactionID{2}, gof.Medium)
+			ledger.gofChangedEvent.Trigger(subscribedTxID, gof.High)
+		}
+	}()
+
+	select {
+	case <-triggered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow client blocked the ledger")
+	}
+
+	close(blocked)
+	assertClosed(t, closed)
+}
+
+func TestRequestAcknowledgements(t *testing.T) {
+	_, conn, _ := start(t, WithMaxSubscriptions(1))
+	received := receivedMessages(conn)
+
+	_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgSetID{ClientID: "test", ProtocolVersion: txstream.ProtocolVersion}))
+	require.NoError(t, err)
+
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{
+		RequestID: 1,
+		Request:   &txstream.MsgSubscribeTxGoF{TxIDs: []ledgerstate.TransactionID{{1}, {2}}},
+	}))
+	require.NoError(t, err)
+	response := receiveMessage(t, received).(*txstream.MsgResponse)
+	assert.Equal(t, uint32(1), response.RequestID)
+	assert.Equal(t, txstream.ErrorCodeLimitExceeded, response.ErrorCode)
+
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{
+		RequestID: 2,
+		Request:   &txstream.MsgSubscribeTxGoF{TxIDs: []ledgerstate.TransactionID{{1}}},
+	}))
+	require.NoError(t, err)
+	response = receiveMessage(t, received).(*txstream.MsgResponse)
+	assert.Equal(t, uint32(2), response.RequestID)
+	assert.Equal(t, &txstream.MsgSubscriptionsUpdated{Count: 1}, response.Response)
+
+	essence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(100, ledgerstate.NewED25519Address(ed25519.PublicKey{}))),
+	)
+	tx := ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewReferenceUnlockBlock(0)})
+	_, err = conn.Write(txstream.EncodeMsg(&txstream.MsgRequest{RequestID: 3, Request: &txstream.MsgPostTransaction{Tx: tx}}))
+	require.NoError(t, err)
+	response = receiveMessage(t, received).(*txstream.MsgResponse)
+	assert.Equal(t, uint32(3), response.RequestID)
+	assert.Equal(t, &txstream.MsgTransactionPosted{TxID: tx.ID()}, response.Response)
+}
+
+func TestMaxSubscriptions(t *testing.T) {
+	c := &Connection{
+		subscriptions: make(map[[ledgerstate.AddressLength]byte]bool),
+		log:           logger.NewExampleLogger("txstream"),
+		options:       buildOptions(WithMaxSubscriptions(1)),
+	}
+
+	address1 := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	address2 := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	newAddrs, ok := c.setSubscriptions([]ledgerstate.Address{address1})
+	assert.True(t, ok)
+	assert.Len(t, newAddrs, 1)
+	newAddrs, ok = c.setSubscriptions([]ledgerstate.Address{address1, address2})
+	assert.False(t, ok)
+	assert.Empty(t, newAddrs)
+	assert.True(t, c.isSubscribed(address1))
+	assert.False(t, c.isSubscribed(address2))
+
+	// a second connection of the same client shares the limit
+	other := &Connection{
+		subscriptions: make(map[[ledgerstate.AddressLength]byte]bool),
+		log:           c.log,
+		options:       buildOptions(WithMaxSubscriptions(1), withSharedLimits(c.options)),
+	}
+	_, ok = other.setSubscriptions([]ledgerstate.Address{address2})
+	assert.False(t, ok)
+
+	c.options.addressSubscriptions.release(c.clientKey, c)
+	_, ok = other.setSubscriptions([]ledgerstate.Address{address2})
+	assert.True(t, ok)
+}
+
+func TestMaxPostedTransactions(t *testing.T) {
+	options := buildOptions(WithMaxPostedTransactions(2, time.Hour))
+	c := &Connection{options: options, clientKey: "client"}
+
+	assert.True(t, c.allowPostTransaction())
+	assert.True(t, c.allowPostTransaction())
+	assert.False(t, c.allowPostTransaction())
+
+	// reconnecting does not reset the quota of the client
+	reconnected := &Connection{options: buildOptions(WithMaxPostedTransactions(2, time.Hour), withSharedLimits(options)), clientKey: "client"}
+	assert.False(t, reconnected.allowPostTransaction())
+	other := &Connection{options: reconnected.options, clientKey: "other"}
+	assert.True(t, other.allowPostTransaction())
+
+	options.postLimiter.windows["client"].start = time.Now().Add(-time.Hour)
+	assert.True(t, c.allowPostTransaction())
+}
+
+func start(t *testing.T, opts ...Option) (*mockLedger, *buffconn.BufferedConnection, chan bool) {
+	ledger := &mockLedger{
+		unspentOutputsRequested: make(chan ledgerstate.Address, 1),
+		bookedEvent: events.NewEvent(func(handler interface{}, params ...interface{}) {
+			handler.(func(*ledgerstate.Transaction))(params[0].(*ledgerstate.Transaction))
+		}),
+		gofChangedEvent: events.NewEvent(tangle.TransactionGoFChangedCaller),
+	}
+
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+
+	serverConn, clientConn := net.Pipe()
+	go Run(serverConn, logger.NewExampleLogger("txstream/server"), ledger, done, opts...)
+
+	conn := buffconn.NewBufferedConnection(clientConn, tangle.MaxMessageSize)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	closed := make(chan bool)
+	go func() {
+		_ = conn.Read()
+		close(closed)
+	}()
+
+	return ledger, conn, closed
+}
+
+func receivedMessages(conn *buffconn.BufferedConnection) chan []byte {
+	received := make(chan []byte, 10)
+	conn.Events.ReceiveMessage.Attach(events.NewClosure(func(data []byte) {
+		d := make([]byte, len(data))
+		copy(d, data)
+		received <- d
+	}))
+	return received
+}
+
+func receiveMessage(t *testing.T, received chan []byte) txstream.Message {
+	select {
+	case data := <-received:
+		msg, err := txstream.DecodeMsg(data, txstream.FlagServerToClient)
+		require.NoError(t, err)
+		return msg.(txstream.Message)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for message")
+	}
+	return nil
+}
+
+func sendClientID(t *testing.T, conn *buffconn.BufferedConnection, clientID string) *txstream.MsgAuthChallenge {
+	received := make(chan []byte, 1)
+	closure := events.NewClosure(func(data []byte) {
+		d := make([]byte, len(data))
+		copy(d, data)
+		received <- d
+	})
+	conn.Events.ReceiveMessage.Attach(closure)
+	defer conn.Events.ReceiveMessage.Detach(closure)
+
+	_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgSetID{ClientID: clientID}))
+	require.NoError(t, err)
+
+	select {
+	case data := <-received:
+		msg, err := txstream.DecodeMsg(data, txstream.FlagServerToClient)
+		require.NoError(t, err)
+		require.IsType(t, &txstream.MsgAuthChallenge{}, msg)
+		return msg.(*txstream.MsgAuthChallenge)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for authentication challenge")
+	}
+	return nil
+}
+
+func authenticate(t *testing.T, conn *buffconn.BufferedConnection, keyPair *ed25519.KeyPair, clientID string) {
+	challenge := sendClientID(t, conn, clientID)
+	_, err := conn.Write(txstream.EncodeMsg(&txstream.MsgAuthResponse{
+		PublicKey: keyPair.PublicKey,
+		Signature: keyPair.PrivateKey.Sign(txstream.AuthChallengeData(challenge.Nonce, clientID)),
+	}))
+	require.NoError(t, err)
+}
+
+func assertClosed(t *testing.T, closed chan bool) {
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection was not closed")
+	}
+}
+
+type mockLedger struct {
+	unspentOutputsRequested chan ledgerstate.Address
+	bookedEvent             *events.Event
+	gofChangedEvent         *events.Event
+}
+
+func (m *mockLedger) GetUnspentOutputs(addr ledgerstate.Address, _ func(ledgerstate.Output)) {
+	m.unspentOutputsRequested <- addr
+}
+
+func (m *mockLedger) GetOutput(ledgerstate.OutputID, func(ledgerstate.Output)) bool {
+	return false
+}
+
+func (m *mockLedger) GetOutputMetadata(ledgerstate.OutputID, func(*ledgerstate.OutputMetadata)) bool {
+	return false
+}
+
+func (m *mockLedger) GetHighGoFTransaction(ledgerstate.TransactionID, func(*ledgerstate.Transaction)) bool {
+	return false
+}
+
+func (m *mockLedger) GetTransactionGoF(ledgerstate.TransactionID) (gof.GradeOfFinality, bool) {
+	return gof.None, false
+}
+
+func (m *mockLedger) GetInclusionProof(ledgerstate.TransactionID) (*inclusionproof.Proof, bool) {
+	return nil, false
+}
+
+func (m *mockLedger) EventTransactionBooked() *events.Event {
+	return m.bookedEvent
+}
+
+func (m *mockLedger) EventTransactionGoFChanged() *events.Event {
+	return m.gofChangedEvent
+}
+
+func (m *mockLedger) PostTransaction(*ledgerstate.Transaction) error {
+	return nil
+}
+
+func (m *mockLedger) Detach() {}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/iotaledger/hive.go/events"
 
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/packages/txstream"
@@ -15,6 +17,8 @@ type TangleLedger struct {
 	tangleInstance  *tangle.Tangle
 	txBookedClosure *events.Closure
 	txBookedEvent   *events.Event
+	txGoFClosure    *events.Closure
+	txGoFEvent      *events.Event
 }
 
 // ensure conformance to Ledger interface.
@@ -29,6 +33,7 @@ func New(tangleInstance *tangle.Tangle) *TangleLedger {
 	t := &TangleLedger{
 		tangleInstance: tangleInstance,
 		txBookedEvent:  events.NewEvent(txEventHandler),
+		txGoFEvent:     events.NewEvent(tangle.TransactionGoFChangedCaller),
 	}
 
 	t.txBookedClosure = events.NewClosure(func(id tangle.MessageID) {
@@ -40,12 +45,20 @@ func New(tangleInstance *tangle.Tangle) *TangleLedger {
 	})
 	t.tangleInstance.Booker.Events.MessageBooked.Attach(t.txBookedClosure)
 
+	// GoF changes are triggered synchronously, so clients receive them in the order they happened. The handlers must
+	// not block the ConfirmationOracle.
+	t.txGoFClosure = events.NewClosure(func(txID ledgerstate.TransactionID, gradeOfFinality gof.GradeOfFinality) {
+		t.txGoFEvent.Trigger(txID, gradeOfFinality)
+	})
+	t.tangleInstance.ConfirmationOracle.Events().TransactionGoFChanged.Attach(t.txGoFClosure)
+
 	return t
 }
 
 // Detach detaches the event handlers.
 func (t *TangleLedger) Detach() {
 	t.tangleInstance.Booker.Events.MessageBooked.Detach(t.txBookedClosure)
+	t.tangleInstance.ConfirmationOracle.Events().TransactionGoFChanged.Detach(t.txGoFClosure)
 }
 
 // EventTransactionBooked returns an event that triggers when a transaction is booked.
@@ -53,6 +66,11 @@ func (t *TangleLedger) EventTransactionBooked() *events.Event {
 	return t.txBookedEvent
 }
 
+// EventTransactionGoFChanged returns an event that triggers when the GoF of a transaction changes.
+func (t *TangleLedger) EventTransactionGoFChanged() *events.Event {
+	return t.txGoFEvent
+}
+
 // GetUnspentOutputs returns the available UTXOs for an address.
 func (t *TangleLedger) GetUnspentOutputs(addr ledgerstate.Address, f func(output ledgerstate.Output)) {
 	t.tangleInstance.LedgerState.CachedOutputsOnAddress(addr).Consume(func(output ledgerstate.Output) {
@@ -81,6 +99,20 @@ func (t *TangleLedger) GetHighGoFTransaction(txid ledgerstate.TransactionID, f f
 	return
 }
 
+// GetTransactionGoF returns the GoF of the transaction with the given ID.
+func (t *TangleLedger) GetTransactionGoF(txid ledgerstate.TransactionID) (gradeOfFinality gof.GradeOfFinality, found bool) {
+	found = t.tangleInstance.LedgerState.TransactionMetadata(txid).Consume(func(txmeta *ledgerstate.TransactionMetadata) {
+		gradeOfFinality = txmeta.GradeOfFinality()
+	})
+	return
+}
+
+// GetInclusionProof creates a proof that the transaction with the given ID is part of the tangle.
+func (t *TangleLedger) GetInclusionProof(txid ledgerstate.TransactionID) (proof *inclusionproof.Proof, found bool) {
+	proof, err := inclusionproof.NewProver(t.tangleInstance).TransactionProof(txid)
+	return proof, err == nil
+}
+
 // PostTransaction posts a transaction to the ledger.
 func (t *TangleLedger) PostTransaction(tx *ledgerstate.Transaction) error {
 	_, err := t.tangleInstance.IssuePayload(tx)
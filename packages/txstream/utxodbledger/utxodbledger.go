@@ -5,6 +5,7 @@ import (
 	"github.com/iotaledger/hive.go/logger"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate/utxodb"
 	"github.com/iotaledger/goshimmer/packages/tangle"
@@ -16,6 +17,7 @@ type UtxoDBLedger struct {
 	tangleInstance   *tangle.Tangle
 	txConfirmedEvent *events.Event
 	txBookedEvent    *events.Event
+	txGoFEvent       *events.Event
 	log              *logger.Logger
 }
 
@@ -30,6 +32,7 @@ func New(log *logger.Logger, tangleInstance *tangle.Tangle) *UtxoDBLedger {
 		tangleInstance:   tangleInstance,
 		txConfirmedEvent: events.NewEvent(txEventHandler),
 		txBookedEvent:    events.NewEvent(txEventHandler),
+		txGoFEvent:       events.NewEvent(tangle.TransactionGoFChangedCaller),
 		log:              log.Named("txstream/UtxoDBLedger"),
 	}
 }
@@ -44,6 +47,7 @@ func (u *UtxoDBLedger) PostTransaction(tx *ledgerstate.Transaction) error {
 	err := u.AddTransaction(tx)
 	if err == nil {
 		go u.txConfirmedEvent.Trigger(tx)
+		go u.txGoFEvent.Trigger(tx.ID(), gof.High)
 	}
 	return err
 }
@@ -67,6 +71,19 @@ func (u *UtxoDBLedger) GetHighGoFTransaction(txid ledgerstate.TransactionID, f f
 	return
 }
 
+// GetTransactionGoF returns the GoF of the transaction with the given ID. Transactions in UTXODB are always confirmed.
+func (u *UtxoDBLedger) GetTransactionGoF(txid ledgerstate.TransactionID) (gof.GradeOfFinality, bool) {
+	if _, ok := u.UtxoDB.GetTransaction(txid); !ok {
+		return gof.None, false
+	}
+	return gof.High, true
+}
+
+// GetInclusionProof always fails, since transactions in UTXODB are not attached to the tangle.
+func (u *UtxoDBLedger) GetInclusionProof(ledgerstate.TransactionID) (*inclusionproof.Proof, bool) {
+	return nil, false
+}
+
 // RequestFunds requests funds from the faucet.
 func (u *UtxoDBLedger) RequestFunds(target ledgerstate.Address) error {
 	_, err := u.UtxoDB.RequestFunds(target)
@@ -83,5 +100,10 @@ func (u *UtxoDBLedger) EventTransactionBooked() *events.Event {
 	return u.txBookedEvent
 }
 
+// EventTransactionGoFChanged returns an event that triggers when the GoF of a transaction changes.
+func (u *UtxoDBLedger) EventTransactionGoFChanged() *events.Event {
+	return u.txGoFEvent
+}
+
 // Detach detaches the event handlers.
 func (u *UtxoDBLedger) Detach() {}
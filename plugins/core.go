@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/database"
 	"github.com/iotaledger/goshimmer/plugins/drng"
+	"github.com/iotaledger/goshimmer/plugins/epochs"
 	"github.com/iotaledger/goshimmer/plugins/faucet"
 	"github.com/iotaledger/goshimmer/plugins/gossip"
 	"github.com/iotaledger/goshimmer/plugins/gracefulshutdown"
@@ -44,6 +45,7 @@ var Core = node.Plugins(
 	messagelayer.Plugin,
 	gossip.Plugin,
 	messagelayer.ManaPlugin,
+	epochs.Plugin,
 	manarefresher.Plugin,
 	drng.Plugin,
 	faucet.Plugin,
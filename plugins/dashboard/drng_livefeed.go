@@ -34,17 +34,7 @@ func configureDrngLiveFeed() {
 		newRandomness := task.Param(0).(*drngpkg.State)
 
 		// assign the name of the instance based on its instanceID
-		var name string
-		switch newRandomness.Committee().InstanceID {
-		case drng.Pollen:
-			name = "DevNet"
-		case drng.XTeam:
-			name = "X-Team"
-		case drng.Community:
-			name = "Community"
-		default:
-			name = "Custom"
-		}
+		name := drng.CommitteeName(newRandomness.Committee().InstanceID)
 
 		broadcastWsMessage(&wsmsg{MsgTypeDrng, &drngMsg{
 			Instance:      newRandomness.Committee().InstanceID,
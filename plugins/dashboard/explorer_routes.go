@@ -175,6 +175,7 @@ func setupExplorerRoutes(routeGroup *echo.Group) {
 	routeGroup.GET("/output/:outputID", ledgerstateAPI.GetOutput)
 	routeGroup.GET("/output/:outputID/metadata", ledgerstateAPI.GetOutputMetadata)
 	routeGroup.GET("/output/:outputID/consumers", ledgerstateAPI.GetOutputConsumers)
+	routeGroup.GET("/token/:color", ledgerstateAPI.GetTokenSupply)
 	routeGroup.GET("/mana/pending", manaAPI.GetPendingMana)
 	routeGroup.GET("/branch/:branchID", ledgerstateAPI.GetBranch)
 	routeGroup.GET("/branch/:branchID/children", ledgerstateAPI.GetBranchChildren)
@@ -118,6 +118,16 @@ export class ExtendedLockedOutput {
 
 }
 
+export class FoundryOutput {
+    balances: Map<string,number>;
+    aliasAddress: string;
+    tokenColor: string;
+    isOrigin: boolean;
+    circulatingSupply: number;
+    maximumSupply: number;
+    tokenMetadata: any;
+}
+
 export class Balance {
     value: number;
     color: string;
@@ -1,6 +1,7 @@
 import {
     AliasOutput,
     ExtendedLockedOutput,
+    FoundryOutput,
     Output,
     SigLockedColoredOutput,
     SigLockedSingleOutput
@@ -10,6 +11,7 @@ import * as React from "react";
 import {SigLockedColoredOutputComponent} from "app/components/SigLockedColoredOutputComponent";
 import {AliasOutputComponent} from "app/components/AliasOutputComponent.tsx";
 import {ExtendedLockedOutputComponent} from "app/components/ExtendedLockedOutput";
+import {FoundryOutputComponent} from "app/components/FoundryOutputComponent";
 import {ExplorerOutput, GoF} from "app/stores/ExplorerStore";
 import {Base58EncodedColorIOTA, resolveColor} from "app/utils/color";
 
@@ -23,6 +25,8 @@ export function outputToComponent(output: Output) {
             return <AliasOutputComponent output={output.output as AliasOutput} id={output.outputID}/>;
         case "ExtendedLockedOutputType":
             return <ExtendedLockedOutputComponent output={output.output as ExtendedLockedOutput} id={output.outputID}/>;
+        case "FoundryOutputType":
+            return <FoundryOutputComponent output={output.output as FoundryOutput} id={output.outputID}/>;
         default:
             return;
     }
@@ -0,0 +1,264 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/timeutil"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const checkpointPrefix = "checkpoint_"
+
+var (
+	// ErrDatabaseClosed is returned if a checkpoint is requested after the database was closed.
+	ErrDatabaseClosed = errors.New("database is closed")
+
+	checkpointMutex        sync.Mutex
+	dbClosed               bool
+	cachedObjectsExporters []CachedObjectsExporter
+)
+
+// CachedObjectsExporter adds the objects that are kept in the caches of the object storages of a component to the
+// given CachedObjects.
+type CachedObjectsExporter func(cachedObjects *database.CachedObjects) error
+
+// RegisterCachedObjectsExporter registers a component whose cached objects are added to every checkpoint, as the object
+// storages can not be flushed while the node is running.
+func RegisterCachedObjectsExporter(exporter CachedObjectsExporter) {
+	checkpointMutex.Lock()
+	defer checkpointMutex.Unlock()
+
+	cachedObjectsExporters = append(cachedObjectsExporters, exporter)
+}
+
+// CreateCheckpoint writes a consistent copy of the running database to a new directory in the checkpoint directory and
+// returns its path. The writes to the database are paused while the checkpoint is created and the objects that are
+// kept in the caches of the registered components are collected, so every object is either part of the checkpoint or
+// of the collected objects, which are written to the checkpoint afterwards.
+func CreateCheckpoint() (dirname string, err error) {
+	checkpointMutex.Lock()
+	defer checkpointMutex.Unlock()
+
+	if db == nil || dbClosed {
+		return "", ErrDatabaseClosed
+	}
+
+	if dirname, err = newCheckpointDirname(); err != nil {
+		return "", err
+	}
+
+	log.Infof("Creating database checkpoint in %s...", dirname)
+	start := time.Now()
+	cachedObjects := database.NewCachedObjects(store)
+	store.Pause()
+	if err = db.Checkpoint(dirname); err == nil {
+		err = exportCachedObjects(cachedObjects)
+	}
+	store.Resume()
+	if err == nil {
+		err = writeCachedObjects(dirname, cachedObjects)
+	}
+	if err != nil {
+		if removeErr := os.RemoveAll(dirname); removeErr != nil {
+			log.Warnf("Failed to remove incomplete checkpoint %s: %s", dirname, removeErr)
+		}
+		return "", err
+	}
+	log.Infof("Creating database checkpoint in %s... done, took %v", dirname, time.Since(start))
+
+	pruneCheckpoints()
+
+	return dirname, nil
+}
+
+// newCheckpointDirname returns the path of a new checkpoint that is named after the current time in nanoseconds. The
+// names have the same length, so they sort chronologically, and the time is increased if the directory exists already.
+func newCheckpointDirname() (dirname string, err error) {
+	if err = os.MkdirAll(Parameters.Checkpoint.Directory, 0o755); err != nil {
+		return "", errors.Errorf("failed to create checkpoint directory: %w", err)
+	}
+
+	for timestamp := time.Now().UnixNano(); ; timestamp++ {
+		dirname = filepath.Join(Parameters.Checkpoint.Directory, fmt.Sprintf("%s%019d", checkpointPrefix, timestamp))
+		if _, err = os.Stat(dirname); os.IsNotExist(err) {
+			return dirname, nil
+		} else if err != nil {
+			return "", errors.Errorf("failed to check checkpoint directory '%s': %w", dirname, err)
+		}
+	}
+}
+
+// exportCachedObjects collects the cached objects of all registered components.
+func exportCachedObjects(cachedObjects *database.CachedObjects) error {
+	for _, exporter := range cachedObjectsExporters {
+		if err := exporter(cachedObjects); err != nil {
+			return errors.Errorf("failed to export cached objects: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// writeCachedObjects writes the collected cached objects to the checkpoint in the given directory.
+func writeCachedObjects(dirname string, cachedObjects *database.CachedObjects) (err error) {
+	checkpointDB, err := database.NewDBWithEngine(dirname, database.Engine(Parameters.Engine))
+	if err != nil {
+		return errors.Errorf("failed to open checkpoint: %w", err)
+	}
+
+	err = cachedObjects.WriteTo(checkpointDB.NewStore())
+	if closeErr := checkpointDB.Close(); err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Errorf("failed to write cached objects to checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// closeDatabase closes the database after the running checkpoint finished.
+func closeDatabase() error {
+	checkpointMutex.Lock()
+	defer checkpointMutex.Unlock()
+
+	dbClosed = true
+
+	return db.Close()
+}
+
+// createCheckpoints periodically creates checkpoints of the database until the node shuts down.
+func createCheckpoints(ctx context.Context) {
+	timeutil.NewTicker(func() {
+		if _, err := CreateCheckpoint(); err != nil {
+			log.Errorf("Failed to create database checkpoint: %s", err)
+		}
+	}, Parameters.Checkpoint.Interval, ctx)
+}
+
+// pruneCheckpoints removes the oldest checkpoints, so only the configured amount of checkpoints is kept.
+func pruneCheckpoints() {
+	if Parameters.Checkpoint.Keep <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(Parameters.Checkpoint.Directory)
+	if err != nil {
+		log.Warnf("Failed to read checkpoint directory: %s", err)
+		return
+	}
+
+	var checkpoints []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), checkpointPrefix) {
+			checkpoints = append(checkpoints, entry.Name())
+		}
+	}
+	sort.Strings(checkpoints)
+
+	for len(checkpoints) > Parameters.Checkpoint.Keep {
+		if err = os.RemoveAll(filepath.Join(Parameters.Checkpoint.Directory, checkpoints[0])); err != nil {
+			log.Warnf("Failed to remove checkpoint %s: %s", checkpoints[0], err)
+		}
+		checkpoints = checkpoints[1:]
+	}
+}
+
+// restoreCheckpoint validates the given checkpoint and replaces the database with it. An existing database is moved
+// aside instead of being deleted.
+func restoreCheckpoint(checkpointDirectory string) (err error) {
+	if _, err = os.Stat(checkpointDirectory); err != nil {
+		return errors.Errorf("failed to open checkpoint: %w", err)
+	}
+
+	checkpointDB, err := database.NewDBWithEngine(checkpointDirectory, database.Engine(Parameters.Engine))
+	if err != nil {
+		return errors.Errorf("failed to open checkpoint: %w", err)
+	}
+	defer func() {
+		if closeErr := checkpointDB.Close(); closeErr != nil && err == nil {
+			err = errors.Errorf("failed to close checkpoint: %w", closeErr)
+		}
+	}()
+
+	log.Infof("Validating checkpoint %s...", checkpointDirectory)
+	if err = validateCheckpoint(checkpointDB.NewStore()); err != nil {
+		return err
+	}
+	log.Infof("Validating checkpoint %s... done", checkpointDirectory)
+
+	if _, err = os.Stat(Parameters.Directory); err == nil {
+		replacedDirectory := fmt.Sprintf("%s_%d_replaced", filepath.Clean(Parameters.Directory), time.Now().Unix())
+		if err = os.Rename(Parameters.Directory, replacedDirectory); err != nil {
+			return errors.Errorf("failed to move the database aside: %w", err)
+		}
+		log.Infof("Moved the existing database to %s", replacedDirectory)
+	}
+
+	log.Infof("Restoring the database from checkpoint %s...", checkpointDirectory)
+	start := time.Now()
+	restoredDB, err := database.NewDBWithEngine(Parameters.Directory, database.Engine(Parameters.Engine))
+	if err != nil {
+		return errors.Errorf("failed to create the database: %w", err)
+	}
+	restoredStore := restoredDB.NewStore()
+	copiedEntries, err := database.CopyStore(restoredStore, checkpointDB.NewStore(), kvstore.EmptyPrefix)
+	if err == nil {
+		// checkpoints of a running node are always marked as unhealthy, but the restored database was validated
+		if err = restoredStore.WithRealm([]byte{database.PrefixHealth}).Delete(healthKey); errors.Is(err, kvstore.ErrKeyNotFound) {
+			err = nil
+		}
+	}
+	if closeErr := restoredDB.Close(); err == nil && closeErr != nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Errorf("failed to restore the database: %w", err)
+	}
+	log.Infof("Restoring the database from checkpoint %s... done, copied %d entries in %v", checkpointDirectory, copiedEntries, time.Since(start))
+
+	return nil
+}
+
+// validateCheckpoint checks that the checkpoint has a supported (or migratable) schema version and that its ledger
+// state is consistent.
+func validateCheckpoint(store kvstore.KVStore) (err error) {
+	version, err := databaseVersion(store.WithRealm([]byte{database.PrefixHealth}))
+	if err != nil {
+		return errors.Errorf("failed to read the version of the checkpoint: %w", err)
+	}
+	if version != DBVersion {
+		migrator, migratorErr := database.NewMigrator(log, migrations...)
+		if migratorErr != nil {
+			return migratorErr
+		}
+		if _, err = migrator.Plan(version, DBVersion); err != nil {
+			return fmt.Errorf("%w: supported version: %d, version of checkpoint: %d (%v)", ErrDBVersionIncompatible, DBVersion, version, err)
+		}
+	}
+
+	cacheTimeProvider := database.NewCacheTimeProvider(0)
+	branchDAG := ledgerstate.NewBranchDAG(store, cacheTimeProvider)
+	utxoDAG := ledgerstate.NewUTXODAG(store, cacheTimeProvider, branchDAG)
+	defer func() {
+		utxoDAG.Shutdown()
+		branchDAG.Shutdown()
+	}()
+
+	if err = utxoDAG.CheckConsistency(); err != nil {
+		return errors.Errorf("ledger state of the checkpoint is invalid: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+)
+
+// migrations contains the Migrations of the database schema. Every time the DBVersion is increased, a Migration to the
+// new version that rewrites the affected prefixes should be added, so nodes do not have to delete their database.
+var migrations []*database.Migration
+
+// migrateDatabase upgrades the database to the DBVersion by running the required migrations. It returns an
+// ErrDBVersionIncompatible if the database can not be migrated.
+func migrateDatabase() error {
+	version, err := databaseVersion(healthStore)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := database.NewMigrator(log, migrations...)
+	if err != nil {
+		return err
+	}
+	if _, err = migrator.Plan(version, DBVersion); err != nil {
+		return fmt.Errorf("%w: supported version: %d, version of database: %d (%v)", ErrDBVersionIncompatible, DBVersion, version, err)
+	}
+	if IsDatabaseUnhealthy() {
+		return errors.New("the database is marked as not properly shutdown/corrupted and can not be migrated")
+	}
+
+	if Parameters.Migration.DryRun {
+		if err = migrator.DryRun(deps.Store, version, DBVersion); err != nil {
+			return err
+		}
+		log.Infof("Dry run of the database migration from version %d to %d succeeded, the database was not modified. Restart without database.migration.dryRun to migrate the database.", version, DBVersion)
+		if err = db.Close(); err != nil {
+			log.Errorf("Failed to close the database: %s", err)
+		}
+		os.Exit(0)
+	}
+
+	if Parameters.Migration.Backup {
+		if err = backupDatabase(version); err != nil {
+			return err
+		}
+	}
+
+	// the database is marked as unhealthy while the migration is running, so an interrupted migration is detected
+	MarkDatabaseUnhealthy()
+	if err = migrator.Migrate(deps.Store, version, DBVersion, func(version byte) error {
+		return setDatabaseVersion(healthStore, version)
+	}); err != nil {
+		return err
+	}
+	MarkDatabaseHealthy()
+
+	return nil
+}
+
+// backupDatabase copies all entries of the database into a new database in the backup directory.
+func backupDatabase(version byte) error {
+	if Parameters.InMemory {
+		log.Warn("Skipping the backup of the in-memory database")
+		return nil
+	}
+
+	backupDirectory := Parameters.Migration.BackupDirectory
+	if backupDirectory == "" {
+		backupDirectory = fmt.Sprintf("%s_v%d_backup", filepath.Clean(Parameters.Directory), version)
+	}
+	if _, err := os.Stat(backupDirectory); err == nil {
+		return errors.Errorf("failed to back up the database: %s already exists", backupDirectory)
+	}
+
+	log.Infof("Backing up the database to %s...", backupDirectory)
+	start := time.Now()
+	backupDB, err := database.NewDBWithEngine(backupDirectory, database.Engine(Parameters.Engine))
+	if err != nil {
+		return errors.Errorf("failed to create the backup database: %w", err)
+	}
+	copiedEntries, err := database.CopyStore(backupDB.NewStore(), deps.Store, kvstore.EmptyPrefix)
+	if err != nil {
+		_ = backupDB.Close()
+		return errors.Errorf("failed to back up the database: %w", err)
+	}
+	if err = backupDB.Close(); err != nil {
+		return errors.Errorf("failed to close the backup database: %w", err)
+	}
+	log.Infof("Backing up the database to %s... done, copied %d entries in %v", backupDirectory, copiedEntries, time.Since(start))
+
+	return nil
+}
@@ -11,12 +11,41 @@ type ParametersDefinition struct {
 	// Directory defines the directory of the database.
 	Directory string `default:"mainnetdb" usage:"path to the database directory"`
 
+	// Engine defines the database engine that is used to persist the database.
+	Engine string `default:"rocksdb" usage:"the database engine (rocksdb or pebble)"`
+
 	// InMemory defines whether to use an in-memory database.
 	InMemory bool `default:"false" usage:"whether the database is only kept in memory and not persisted"`
 
 	// Dirty defines whether to override the database dirty flag.
 	Dirty string `default:"false" usage:"set the dirty flag of the database"`
 
+	// Migration contains the parameters of the migrations of outdated databases.
+	Migration struct {
+		// DryRun defines whether to only test the migrations on an in-memory copy of the affected data and exit.
+		DryRun bool `default:"false" usage:"whether to test the migrations of an outdated database without modifying it and exit"`
+		// Backup defines whether to copy the database before it is migrated.
+		Backup bool `default:"false" usage:"whether to back up the database before it is migrated"`
+		// BackupDirectory defines the directory of the backup.
+		BackupDirectory string `usage:"path to the backup directory (default: the database directory with the suffix _v<version>_backup)"`
+	}
+
+	// Checkpoint contains the parameters of the checkpoints of the running database.
+	Checkpoint struct {
+		// Directory defines the directory in which the checkpoints are created.
+		Directory string `default:"checkpoints" usage:"path to the directory of the database checkpoints"`
+		// Interval defines the interval in which checkpoints are created while the node is running.
+		Interval time.Duration `default:"0s" usage:"the interval in which database checkpoints are created (0 disables periodic checkpoints)"`
+		// Keep defines the amount of checkpoints that are kept in the checkpoint directory.
+		Keep int `default:"3" usage:"the amount of database checkpoints that are kept (0 keeps all checkpoints)"`
+	}
+
+	// Restore contains the parameters of the restore of the database from a checkpoint.
+	Restore struct {
+		// From defines the checkpoint that the database is restored from before the node starts.
+		From string `usage:"path to a database checkpoint that replaces the database before the node starts"`
+	}
+
 	// ForceCacheTime is a new global cache time in seconds for object storage.
 	ForceCacheTime time.Duration `default:"-1s" usage:"interval of time for which objects should remain in memory. Zero time means no caching, negative value means use defaults"`
 }
@@ -1,4 +1,4 @@
-// Package database is a plugin that manages the RocksDB database (e.g. garbage collection).
+// Package database is a plugin that manages the database (e.g. garbage collection).
 package database
 
 import (
@@ -29,6 +29,7 @@ var (
 	log    *logger.Logger
 
 	db                database.DB
+	store             *database.PausableStore
 	cacheTimeProvider *database.CacheTimeProvider
 	cacheProviderOnce sync.Once
 )
@@ -61,23 +62,50 @@ func createCacheTimeProvider() {
 func createStore() kvstore.KVStore {
 	log = logger.NewLogger(PluginName)
 
+	if Parameters.Restore.From != "" {
+		if Parameters.InMemory {
+			log.Fatal("Invalid database.restore.from: the in-memory database can not be restored from a checkpoint")
+		}
+		if err := restoreCheckpoint(Parameters.Restore.From); err != nil {
+			log.Fatalf("Failed to restore the database from checkpoint %s: %s", Parameters.Restore.From, err)
+		}
+	}
+
 	var err error
 	if Parameters.InMemory {
 		db, err = database.NewMemDB()
 	} else {
-		db, err = database.NewDB(Parameters.Directory)
+		db, err = database.NewDBWithEngine(Parameters.Directory, database.Engine(Parameters.Engine))
+	}
+	if errors.Is(err, database.ErrUnknownEngine) {
+		log.Fatalf("Invalid database.engine: %s", err)
 	}
 	if err != nil {
 		log.Fatal("Unable to open the database, please delete the database folder. Error: %s", err)
 	}
 
-	return db.NewStore()
+	store = database.NewPausableStore(db.NewStore())
+
+	return store
+}
+
+// Size returns the size of the database in bytes.
+func Size() (int64, error) {
+	if db == nil {
+		return 0, errors.New("database is not initialized")
+	}
+
+	return db.Size()
 }
 
 func configure(_ *node.Plugin) {
 	configureHealthStore(deps.Store)
 
-	if err := checkDatabaseVersion(healthStore); err != nil {
+	err := checkDatabaseVersion(healthStore)
+	if errors.Is(err, ErrDBVersionIncompatible) {
+		err = migrateDatabase()
+	}
+	if err != nil {
 		if errors.Is(err, ErrDBVersionIncompatible) {
 			log.Fatalf("The database scheme was updated. Please delete the database folder. %s", err)
 		}
@@ -104,6 +132,12 @@ func configure(_ *node.Plugin) {
 		log.Fatalf("Failed to start as daemon: %s", err)
 	}
 
+	if Parameters.Checkpoint.Interval > 0 {
+		if err := daemon.BackgroundWorker("Database Checkpoints", createCheckpoints, shutdown.PriorityDatabaseCheckpoints); err != nil {
+			log.Fatalf("Failed to start as daemon: %s", err)
+		}
+	}
+
 	// run GC up on startup
 	runDatabaseGC()
 }
@@ -123,7 +157,7 @@ func manageDBLifetime(ctx context.Context) {
 	runDatabaseGC()
 	MarkDatabaseHealthy()
 	log.Infof("Syncing database to disk...")
-	if err := db.Close(); err != nil {
+	if err := closeDatabase(); err != nil {
 		log.Errorf("Failed to flush the database: %s", err)
 	}
 	log.Infof("Syncing database to disk... done")
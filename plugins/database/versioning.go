@@ -10,7 +10,8 @@ import (
 
 const (
 	// DBVersion defines the version of the database schema this version of GoShimmer supports.
-	// Every time there's a breaking change regarding the stored data, this version flag should be adjusted.
+	// Every time there's a breaking change regarding the stored data, this version flag should be adjusted and a
+	// migration to the new version should be added to the migrations.
 	DBVersion = 46
 )
 
@@ -24,19 +25,33 @@ var (
 // checks whether the database is compatible with the current schema version.
 // also automatically sets the version if the database is new.
 func checkDatabaseVersion(store kvstore.KVStore) error {
-	entry, err := store.Get(dbVersionKey)
+	version, err := databaseVersion(store)
 	if errors.Is(err, kvstore.ErrKeyNotFound) {
 		// set the version in an empty DB
-		return store.Set(dbVersionKey, []byte{DBVersion})
+		return setDatabaseVersion(store, DBVersion)
 	}
 	if err != nil {
 		return err
 	}
-	if len(entry) == 0 {
-		return fmt.Errorf("%w: no database version was persisted", ErrDBVersionIncompatible)
-	}
-	if entry[0] != DBVersion {
-		return fmt.Errorf("%w: supported version: %d, version of database: %d", ErrDBVersionIncompatible, DBVersion, entry[0])
+	if version != DBVersion {
+		return fmt.Errorf("%w: supported version: %d, version of database: %d", ErrDBVersionIncompatible, DBVersion, version)
 	}
 	return nil
 }
+
+// databaseVersion returns the schema version of the database.
+func databaseVersion(store kvstore.KVStore) (byte, error) {
+	entry, err := store.Get(dbVersionKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(entry) == 0 {
+		return 0, fmt.Errorf("%w: no database version was persisted", ErrDBVersionIncompatible)
+	}
+	return entry[0], nil
+}
+
+// setDatabaseVersion persists the schema version of the database.
+func setDatabaseVersion(store kvstore.KVStore, version byte) error {
+	return store.Set(dbVersionKey, []byte{version})
+}
@@ -0,0 +1,101 @@
+package drng
+
+import (
+	"github.com/iotaledger/hive.go/events"
+
+	"github.com/iotaledger/goshimmer/packages/drng"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// aliasInstances maps the addresses of the aliases defining a committee to their instance ID.
+var aliasInstances = make(map[ledgerstate.AliasAddress]uint32)
+
+// configureAliasCommittees creates the states of the committees defined through alias outputs and keeps them up to
+// date with the confirmed state of the aliases.
+func configureAliasCommittees() {
+	for _, definition := range aliasCommittees {
+		aliasAddress, err := ledgerstate.AliasAddressFromBase58EncodedString(definition.AliasAddress)
+		if err != nil {
+			Plugin.LogWarnf("Invalid alias address of committee %s: %s", definition.Name, err)
+			continue
+		}
+
+		aliasOutput := unspentAliasOutput(aliasAddress)
+		if aliasOutput == nil {
+			Plugin.LogWarnf("Unable to load committee %s: unspent alias output of %s not found", definition.Name, definition.AliasAddress)
+			continue
+		}
+		beaconType, committee, err := drng.CommitteeFromAliasOutput(aliasOutput)
+		if err != nil {
+			Plugin.LogWarnf("Invalid committee %s: %s", definition.Name, err)
+			continue
+		}
+		if _, exists := deps.DRNGInstance.State[committee.InstanceID]; exists {
+			Plugin.LogWarnf("Invalid committee %s: instanceID %d already in use by another committee", definition.Name, committee.InstanceID)
+			continue
+		}
+
+		deps.DRNGInstance.State[committee.InstanceID] = drng.NewState(stateOptions(beaconType, committee)...)
+		if err := deps.DRNGInstance.RestoreCommitteeRotations(committee.InstanceID); err != nil {
+			Plugin.LogWarnf("Unable to restore committee rotations of committee %s: %s", definition.Name, err)
+		}
+		aliasInstances[*aliasAddress] = committee.InstanceID
+		if definition.Name != "" {
+			committeeNames[committee.InstanceID] = definition.Name
+		}
+	}
+
+	if len(aliasInstances) == 0 {
+		return
+	}
+
+	deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(events.NewClosure(func(transactionID ledgerstate.TransactionID) {
+		deps.Tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+			for _, output := range transaction.Essence().Outputs() {
+				aliasOutput, ok := output.(*ledgerstate.AliasOutput)
+				if !ok {
+					continue
+				}
+				updateAliasCommittee(aliasOutput)
+			}
+		})
+	}))
+}
+
+// updateAliasCommittee updates the committee defined by the given alias output.
+func updateAliasCommittee(aliasOutput *ledgerstate.AliasOutput) {
+	instanceID, tracked := aliasInstances[*aliasOutput.GetAliasAddress()]
+	if !tracked {
+		return
+	}
+
+	beaconType, committee, err := drng.CommitteeFromAliasOutput(aliasOutput)
+	if err != nil {
+		Plugin.LogWarnf("Ignoring committee update: %s", err)
+		return
+	}
+	if committee.InstanceID != instanceID {
+		Plugin.LogWarnf("Ignoring committee update of alias %s: instanceID changed from %d to %d", aliasOutput.GetAliasAddress().Base58(), instanceID, committee.InstanceID)
+		return
+	}
+
+	state := deps.DRNGInstance.LoadState(instanceID)
+	state.UpdateCommittee(committee)
+	state.UpdateBeaconType(beaconType)
+}
+
+// unspentAliasOutput returns the unspent alias output of the given alias address.
+func unspentAliasOutput(aliasAddress *ledgerstate.AliasAddress) (aliasOutput *ledgerstate.AliasOutput) {
+	deps.Tangle.LedgerState.CachedOutputsOnAddress(aliasAddress).Consume(func(output ledgerstate.Output) {
+		candidate, ok := output.(*ledgerstate.AliasOutput)
+		if !ok || !candidate.GetAliasAddress().Equals(aliasAddress) {
+			return
+		}
+		deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+			if outputMetadata.ConsumerCount() == 0 {
+				aliasOutput = candidate
+			}
+		})
+	})
+	return
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/configuration"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/mr-tron/base58/base58"
 
 	"github.com/iotaledger/goshimmer/packages/drng"
@@ -22,92 +24,154 @@ const (
 	Community = 7438
 )
 
-// ErrParsingCommitteeMember is returned for an invalid committee member
-var ErrParsingCommitteeMember = errors.New("cannot parse committee member")
+var (
+	// ErrParsingCommitteeMember is returned for an invalid committee member
+	ErrParsingCommitteeMember = errors.New("cannot parse committee member")
 
-func configureDRNG() *drng.DRNG {
-	c := make(map[uint32][]drng.Option)
+	// ErrUnknownBeaconType is returned for an invalid beacon type in a committee definition.
+	ErrUnknownBeaconType = errors.New("unknown beacon type")
+)
 
-	// Pollen dRNG configuration
-	// parse identities of the committee members
-	committeeMembers, err := parseCommitteeMembers(Parameters.Pollen.CommitteeMembers)
-	if err != nil {
-		Plugin.LogWarnf("Invalid Pollen committee members: %s", err)
+// beaconTypes maps the beacon type names used in the configuration to the corresponding payload types.
+var beaconTypes = map[string]drng.Type{
+	"":                 drng.TypeCollectiveBeacon,
+	"collectiveBeacon": drng.TypeCollectiveBeacon,
+	"ed25519Beacon":    drng.TypeED25519Beacon,
+}
+
+var (
+	// committeeNames contains the names of the configured committees indexed by their instance ID.
+	committeeNames = map[uint32]string{
+		Pollen:    "DevNet",
+		XTeam:     "X-Team",
+		Community: "Community",
 	}
 
-	// parse distributed public key of the committee
-	dpk, err := parseDistributedPublicKey(Parameters.Pollen.DistributedPubKey)
-	if err != nil {
-		Plugin.LogWarn(err)
+	// aliasCommittees contains the committees that are defined through the state data of an alias output.
+	aliasCommittees []CommitteeDefinition
+)
+
+// CommitteeName returns the name of the committee with the given instance ID.
+func CommitteeName(instanceID uint32) string {
+	if name, exists := committeeNames[instanceID]; exists {
+		return name
 	}
+	return "Custom"
+}
 
-	// configure Pollen committee
-	pollenConf := &drng.Committee{
-		InstanceID:    Pollen,
-		Threshold:     uint8(Parameters.Pollen.Threshold),
-		DistributedPK: dpk,
-		Identities:    committeeMembers,
+func configureDRNG(config *configuration.Configuration, store kvstore.KVStore) *drng.DRNG {
+	c := make(map[uint32][]drng.Option)
+
+	// legacy committees
+	legacyCommittees := []struct {
+		name       string
+		parameters CommitteeDefinition
+	}{
+		{"Pollen", CommitteeDefinition{
+			InstanceID:        Parameters.Pollen.InstanceID,
+			Threshold:         Parameters.Pollen.Threshold,
+			DistributedPubKey: Parameters.Pollen.DistributedPubKey,
+			CommitteeMembers:  Parameters.Pollen.CommitteeMembers,
+		}},
+		{"X-Team", CommitteeDefinition{
+			InstanceID:        Parameters.XTeam.InstanceID,
+			Threshold:         Parameters.XTeam.Threshold,
+			DistributedPubKey: Parameters.XTeam.DistributedPubKey,
+			CommitteeMembers:  Parameters.XTeam.CommitteeMembers,
+		}},
+		{"Custom", CommitteeDefinition{
+			InstanceID:        Parameters.Custom.InstanceID,
+			Threshold:         Parameters.Custom.Threshold,
+			DistributedPubKey: Parameters.Custom.DistributedPubKey,
+			CommitteeMembers:  Parameters.Custom.CommitteeMembers,
+		}},
+	}
+	for _, legacyCommittee := range legacyCommittees {
+		beaconType, committee, err := parseCommittee(legacyCommittee.parameters)
+		if err != nil {
+			Plugin.LogWarnf("Invalid %s committee: %s", legacyCommittee.name, err)
+		}
+		if committee == nil || len(committee.Identities) == 0 {
+			continue
+		}
+		if _, exists := c[committee.InstanceID]; exists {
+			Plugin.LogWarnf("Invalid %s dRNG instanceID: %d, already in use by another committee", legacyCommittee.name, committee.InstanceID)
+			continue
+		}
+		c[committee.InstanceID] = stateOptions(beaconType, committee)
 	}
 
-	if len(committeeMembers) > 0 {
-		c[pollenConf.InstanceID] = []drng.Option{drng.SetCommittee(pollenConf)}
+	// committees defined in the list of committees
+	var definitions []CommitteeDefinition
+	if err := config.Unmarshal(CfgCommittees, &definitions); err != nil {
+		Plugin.LogWarnf("Invalid list of committees: %s", err)
 	}
+	for _, definition := range definitions {
+		if definition.AliasAddress != "" {
+			aliasCommittees = append(aliasCommittees, definition)
+			continue
+		}
 
-	// X-Team dRNG configuration
-	// parse identities of the x-team committee members
-	committeeMembers, err = parseCommitteeMembers(Parameters.XTeam.CommitteeMembers)
-	if err != nil {
-		Plugin.LogWarnf("Invalid X-Team committee members: %s", err)
+		beaconType, committee, err := parseCommittee(definition)
+		if err != nil {
+			Plugin.LogWarnf("Invalid committee %s: %s", definition.Name, err)
+		}
+		if committee == nil {
+			continue
+		}
+		if _, exists := c[committee.InstanceID]; exists {
+			Plugin.LogWarnf("Invalid committee %s: instanceID %d already in use by another committee", definition.Name, committee.InstanceID)
+			continue
+		}
+		c[committee.InstanceID] = stateOptions(beaconType, committee)
+		if definition.Name != "" {
+			committeeNames[committee.InstanceID] = definition.Name
+		}
 	}
 
-	// parse distributed public key of the committee
-	dpk, err = parseDistributedPublicKey(Parameters.XTeam.DistributedPubKey)
-	if err != nil {
-		Plugin.LogWarn(err)
+	drngInstance := drng.New(c)
+	if Parameters.BeaconStore.Enabled {
+		drngInstance.SetBeaconStore(drng.NewBeaconStore(store, uint64(Parameters.BeaconStore.Retention)))
+	}
+	drngInstance.SetRotationStore(drng.NewRotationStore(store))
+	if err := drngInstance.RestoreCommitteeRotations(); err != nil {
+		Plugin.LogWarnf("Unable to restore committee rotations: %s", err)
 	}
 
-	// configure X-Team committee
-	xTeamConf := &drng.Committee{
-		InstanceID:    XTeam,
-		Threshold:     uint8(Parameters.XTeam.Threshold),
-		DistributedPK: dpk,
-		Identities:    committeeMembers,
+	return drngInstance
+}
+
+func stateOptions(beaconType drng.Type, committee *drng.Committee) []drng.Option {
+	return []drng.Option{
+		drng.SetCommittee(committee),
+		drng.SetBeaconType(beaconType),
+		drng.SetHistorySize(Parameters.HistorySize),
 	}
+}
 
-	if len(committeeMembers) > 0 {
-		c[xTeamConf.InstanceID] = []drng.Option{drng.SetCommittee(xTeamConf)}
+// parseCommittee parses the given CommitteeDefinition. If only the distributed public key is invalid, the error is
+// returned together with the committee, so it is kept (without the key) as before.
+func parseCommittee(definition CommitteeDefinition) (beaconType drng.Type, committee *drng.Committee, err error) {
+	beaconType, exists := beaconTypes[definition.BeaconType]
+	if !exists {
+		return 0, nil, fmt.Errorf("%w: %s", ErrUnknownBeaconType, definition.BeaconType)
 	}
 
-	// Custom dRNG configuration
-	// parse identities of the x-team committee members
-	committeeMembers, err = parseCommitteeMembers(Parameters.Custom.CommitteeMembers)
+	// parse identities of the committee members
+	committeeMembers, err := parseCommitteeMembers(definition.CommitteeMembers)
 	if err != nil {
-		Plugin.LogWarn("Invalid custom committee members: %s", err)
+		return 0, nil, err
 	}
 
 	// parse distributed public key of the committee
-	dpk, err = parseDistributedPublicKey(Parameters.Custom.DistributedPubKey)
-	if err != nil {
-		Plugin.LogWarn(err)
-	}
+	dpk, err := parseDistributedPublicKey(definition.DistributedPubKey)
 
-	// configure Custom committee
-	customConf := &drng.Committee{
-		InstanceID:    uint32(Parameters.Custom.InstanceID),
-		Threshold:     uint8(Parameters.Custom.Threshold),
+	return beaconType, &drng.Committee{
+		InstanceID:    uint32(definition.InstanceID),
+		Threshold:     uint8(definition.Threshold),
 		DistributedPK: dpk,
 		Identities:    committeeMembers,
-	}
-
-	if len(committeeMembers) > 0 {
-		if customConf.InstanceID != Pollen && customConf.InstanceID != XTeam {
-			c[customConf.InstanceID] = []drng.Option{drng.SetCommittee(customConf)}
-		} else {
-			Plugin.LogWarnf("Invalid Custom dRNG instanceID: %d, must be different than both Pollen and X-Team dRNG instance IDs (%d - %d)", customConf.InstanceID, pollenConf.InstanceID, xTeamConf.InstanceID)
-		}
-	}
-
-	return drng.New(c)
+	}, err
 }
 
 func parseCommitteeMembers(committeeMembers []string) (result []ed25519.PublicKey, err error) {
@@ -4,6 +4,10 @@ import (
 	"github.com/iotaledger/hive.go/configuration"
 )
 
+// CfgCommittees defines the config key of the list of additional committees. Each entry is unmarshaled into a
+// CommitteeDefinition.
+const CfgCommittees = "drng.committees"
+
 // ParametersDefinition contains the definition of configuration parameters used by the drng plugin.
 type ParametersDefinition struct {
 	// Pollen contains the configuration parameters of GoShimmer DRNG committee.
@@ -50,6 +54,37 @@ type ParametersDefinition struct {
 		// CommitteeMembers defines the config flag of the DRNG committee members identities.
 		CommitteeMembers []string `usage:"list of committee members of the custom drng"`
 	}
+
+	// HistorySize defines the number of past randomness values kept in memory for each instance.
+	HistorySize int `default:"100" usage:"the number of past randomness values kept in memory for each drng instance"`
+
+	// BeaconStore contains the configuration parameters of the persistent storage of the beacons.
+	BeaconStore struct {
+		// Enabled defines whether the beacons are persisted.
+		Enabled bool `default:"true" usage:"whether the beacons are persisted in the database"`
+
+		// Retention defines the number of rounds of each instance for which the beacons are kept.
+		Retention int `default:"100000" usage:"the number of rounds for which the beacons of each instance are kept (0 keeps all)"`
+	}
+}
+
+// CommitteeDefinition contains the definition of a committee configured in the list of committees.
+type CommitteeDefinition struct {
+	// Name is the human readable name of the committee.
+	Name string `koanf:"name"`
+	// InstanceID is the instance ID of the committee.
+	InstanceID int `koanf:"instanceId"`
+	// BeaconType is the type of the beacons issued by the committee (collectiveBeacon or ed25519Beacon).
+	BeaconType string `koanf:"beaconType"`
+	// Threshold is the BLS threshold of the committee.
+	Threshold int `koanf:"threshold"`
+	// DistributedPubKey is the distributed public key of the committee (hex encoded).
+	DistributedPubKey string `koanf:"distributedPubKey"`
+	// CommitteeMembers are the identities of the committee members (base58 encoded).
+	CommitteeMembers []string `koanf:"committeeMembers"`
+	// AliasAddress is the base58 encoded address of an alias output whose state data defines the committee. If set,
+	// all the other fields but Name are ignored and the committee follows the state of the alias.
+	AliasAddress string `koanf:"aliasAddress"`
 }
 
 // Parameters contains the configuration parameters of the drng plugin.
@@ -45,6 +45,7 @@ func init() {
 }
 
 func configure(_ *node.Plugin) {
+	configureAliasCommittees()
 	configureEvents()
 }
 
@@ -93,6 +94,13 @@ func configureEvents() {
 		return
 	}
 
+	deps.DRNGInstance.Events.CommitteeRotation.Attach(events.NewClosure(func(event *drng.CommitteeRotationEvent) {
+		Plugin.LogInfof("Committee rotation of instance %d scheduled for round %d", event.InstanceID, event.Round)
+	}))
+	deps.DRNGInstance.Events.CommitteeRotated.Attach(events.NewClosure(func(state *drng.State) {
+		Plugin.LogInfof("Committee of instance %d rotated", state.Committee().InstanceID)
+	}))
+
 	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
 		select {
 		case inbox <- messageID:
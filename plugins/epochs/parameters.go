@@ -0,0 +1,28 @@
+package epochs
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the epochs plugin.
+type ParametersDefinition struct {
+	// Interval defines the duration of an epoch.
+	Interval time.Duration `default:"1m" usage:"the duration of an epoch"`
+
+	// CommitmentDelay defines how long to wait for late confirmations after the end of an epoch before committing it.
+	CommitmentDelay time.Duration `default:"10m30s" usage:"the time to wait for late confirmations after the end of an epoch before committing it (at least the maximum reattachment time)"`
+
+	// StartEpoch defines the epoch at which the chain of commitments starts. It has to be set to a recent epoch (e.g.
+	// the epoch of the snapshot of the network), as the node refuses to start if it would have to commit more than a
+	// week of epochs to catch up.
+	StartEpoch uint64 `default:"0" usage:"the epoch at which the chain of commitments starts (has to be the same on all nodes of the network and at most a week old)"`
+}
+
+// Parameters contains the configuration used by the epochs plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "epochs")
+}
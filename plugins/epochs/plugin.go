@@ -0,0 +1,84 @@
+package epochs
+
+import (
+	"context"
+	"time"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/database"
+)
+
+const (
+	// PluginName is the name of the epochs plugin.
+	PluginName = "Epochs"
+
+	// maxCatchUpDuration defines how far the chain of commitments may lag behind the current time at startup. A larger
+	// gap indicates that the start epoch is not set to the epoch of the snapshot of the network.
+	maxCatchUpDuration = 7 * 24 * time.Hour
+)
+
+var (
+	// Plugin is the plugin instance of the epochs plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	EpochManager *epochs.Manager
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newManager); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func newManager(tangleInstance *tangle.Tangle) *epochs.Manager {
+	return epochs.NewManager(tangleInstance,
+		epochs.Interval(Parameters.Interval),
+		epochs.CommitmentDelay(Parameters.CommitmentDelay),
+		epochs.StartEpoch(epochs.ID(Parameters.StartEpoch)),
+	)
+}
+
+func configure(plugin *node.Plugin) {
+	if Parameters.Interval <= 0 {
+		plugin.Panicf("the epoch interval has to be positive: %s", Parameters.Interval)
+	}
+	if Parameters.CommitmentDelay < tangle.MaxReattachmentTimeMin {
+		plugin.Panicf("the commitment delay has to be at least the maximum reattachment time of %s: %s", tangle.MaxReattachmentTimeMin, Parameters.CommitmentDelay)
+	}
+	if nextEpochStart := deps.EpochManager.StartTime(deps.EpochManager.NextEpoch()); time.Since(nextEpochStart) > maxCatchUpDuration {
+		plugin.Panicf("the chain of commitments would have to catch up on the epochs since %s (more than %s), set the start epoch to the epoch of the snapshot of the network", nextEpochStart, maxCatchUpDuration)
+	}
+
+	deps.EpochManager.Events.EpochCommitted.Attach(events.NewClosure(func(commitment *epochs.Commitment) {
+		plugin.LogDebugf("committed %s with root %s", commitment.EpochID(), commitment.Root().Base58())
+	}))
+	deps.EpochManager.Events.Error.Attach(events.NewClosure(func(err error) {
+		plugin.LogErrorf("failed to persist the epoch state: %s", err)
+	}))
+	database.RegisterCachedObjectsExporter(deps.EpochManager.ExportCachedObjects)
+	deps.EpochManager.Setup()
+}
+
+func run(*node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+		deps.EpochManager.Shutdown()
+	}, shutdown.PriorityEpochs); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
@@ -0,0 +1,187 @@
+package faucet
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+
+	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// coloredSupplyAddressIndexFlag marks the address indices holding the supplies of colored tokens. They never collide
+// with the indices of the funding outputs, which grow from MaxFaucetOutputsCount.
+const coloredSupplyAddressIndexFlag = uint64(1) << 63
+
+// ColoredSupplyAddressIndex returns the index of the address holding the supply of tokens of the given color. Tokens
+// sent to this address are dispensed by the faucet.
+func ColoredSupplyAddressIndex(color ledgerstate.Color) uint64 {
+	return coloredSupplyAddressIndexFlag | binary.LittleEndian.Uint64(color[:8])
+}
+
+// coloredSupply manages the tokens of a single color the faucet dispenses. All the tokens of the color are kept in a
+// single supply output, which is spent by every funding transaction and replaced by its remainder.
+type coloredSupply struct {
+	color        ledgerstate.Color
+	maxAmount    uint64
+	addressIndex uint64
+	address      ledgerstate.Address
+	seed         *walletseed.Seed
+
+	supplyOutputID ledgerstate.OutputID
+	balances       map[ledgerstate.Color]uint64
+	mutex          sync.Mutex
+}
+
+func newColoredSupply(color ledgerstate.Color, maxAmount uint64, seed *walletseed.Seed) *coloredSupply {
+	addressIndex := ColoredSupplyAddressIndex(color)
+	return &coloredSupply{
+		color:        color,
+		maxAmount:    maxAmount,
+		addressIndex: addressIndex,
+		address:      seed.Address(addressIndex).Address(),
+		seed:         seed,
+	}
+}
+
+// Balance returns the amount of tokens of the color left in the supply output.
+func (c *coloredSupply) Balance() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.balances[c.color]
+}
+
+// Prepare merges all the unspent outputs holding tokens of the color on the supply address into a single supply
+// output. It picks up the tokens deposited to the faucet since the last preparation.
+func (c *coloredSupply) Prepare(issueTx func(*ledgerstate.Transaction) (*tangle.Message, error)) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.prepare(issueTx)
+}
+
+// Fund sends the given amount of tokens of the color to the given address.
+func (c *coloredSupply) Fund(destAddr ledgerstate.Address, amount uint64, accessManaPledgeID, consensusManaPledgeID identity.ID,
+	issueTx func(*ledgerstate.Transaction) (*tangle.Message, error)) (*tangle.Message, *ledgerstate.Transaction, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.balances[c.color] < amount {
+		if err := c.prepare(issueTx); err != nil {
+			return nil, nil, err
+		}
+		if c.balances[c.color] < amount {
+			return nil, nil, errors.Errorf("%w: %d tokens of color %s left", ErrNotEnoughFunds, c.balances[c.color], c.color.Base58())
+		}
+	}
+
+	remainder := make(map[ledgerstate.Color]uint64, len(c.balances))
+	for color, balance := range c.balances {
+		remainder[color] = balance
+	}
+	if remainder[c.color] -= amount; remainder[c.color] == 0 {
+		delete(remainder, c.color)
+	}
+
+	outputs := ledgerstate.Outputs{
+		ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{c.color: amount}), destAddr),
+	}
+	if len(remainder) > 0 {
+		outputs = append(outputs, ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(remainder), c.address))
+	}
+
+	tx := c.transaction(ledgerstate.Inputs{ledgerstate.NewUTXOInput(c.supplyOutputID)}, outputs, accessManaPledgeID, consensusManaPledgeID)
+	msg, err := issueTx(tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.setSupplyOutput(tx, remainder)
+	return msg, tx, nil
+}
+
+// prepare merges the unspent outputs on the supply address. It must be called while holding the mutex.
+func (c *coloredSupply) prepare(issueTx func(*ledgerstate.Transaction) (*tangle.Message, error)) error {
+	var inputs ledgerstate.Inputs
+	var outputIDs []ledgerstate.OutputID
+	balances := make(map[ledgerstate.Color]uint64)
+	deps.Tangle.LedgerState.CachedOutputsOnAddress(c.address).Consume(func(output ledgerstate.Output) {
+		if len(inputs) == ledgerstate.MaxInputCount {
+			return
+		}
+		if _, hasColor := output.Balances().Get(c.color); !hasColor {
+			return
+		}
+		deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+			if outputMetadata.ConsumerCount() > 0 {
+				return
+			}
+			inputs = append(inputs, ledgerstate.NewUTXOInput(output.ID()))
+			outputIDs = append(outputIDs, output.ID())
+			output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+				balances[color] += balance
+				return true
+			})
+		})
+	})
+
+	switch len(inputs) {
+	case 0:
+		c.supplyOutputID = ledgerstate.EmptyOutputID
+		c.balances = nil
+		return nil
+	case 1:
+		c.supplyOutputID = outputIDs[0]
+		c.balances = balances
+		return nil
+	}
+
+	tx := c.transaction(inputs, ledgerstate.Outputs{
+		ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(balances), c.address),
+	}, deps.Local.ID(), identity.ID{})
+	if _, err := issueTx(tx); err != nil {
+		return errors.Errorf("%w: failed to merge the supply of color %s: %w", ErrSupplyPreparationFailed, c.color.Base58(), err)
+	}
+
+	c.setSupplyOutput(tx, balances)
+	return nil
+}
+
+// setSupplyOutput sets the output of the given transaction sitting on the supply address as the supply output.
+func (c *coloredSupply) setSupplyOutput(tx *ledgerstate.Transaction, balances map[ledgerstate.Color]uint64) {
+	c.supplyOutputID = ledgerstate.EmptyOutputID
+	c.balances = nil
+	for _, output := range tx.Essence().Outputs() {
+		if output.Address().Equals(c.address) {
+			c.supplyOutputID = output.ID()
+			c.balances = balances
+			return
+		}
+	}
+}
+
+// transaction creates a transaction spending the given inputs, which all sit on the supply address.
+func (c *coloredSupply) transaction(inputs ledgerstate.Inputs, outputs ledgerstate.Outputs, accessManaPledgeID, consensusManaPledgeID identity.ID) *ledgerstate.Transaction {
+	essence := ledgerstate.NewTransactionEssence(
+		0,
+		clock.SyncedTime(),
+		accessManaPledgeID,
+		consensusManaPledgeID,
+		ledgerstate.NewInputs(inputs...),
+		ledgerstate.NewOutputs(outputs...),
+	)
+
+	w := wallet{keyPair: *c.seed.KeyPair(c.addressIndex)}
+	unlockBlocks := make(ledgerstate.UnlockBlocks, len(inputs))
+	unlockBlocks[0] = ledgerstate.NewSignatureUnlockBlock(w.sign(essence))
+	for i := 1; i < len(inputs); i++ {
+		unlockBlocks[i] = ledgerstate.NewReferenceUnlockBlock(0)
+	}
+
+	return ledgerstate.NewTransaction(essence, unlockBlocks)
+}
@@ -0,0 +1,73 @@
+package faucet
+
+import (
+	"sync"
+	"time"
+)
+
+// DifficultyController scales the PoW difficulty required for funding requests with the load of the faucet. Every
+// requestsPerStep requests accepted within the window increase the difficulty by one, up to the maximum difficulty.
+type DifficultyController struct {
+	baseDifficulty  int
+	maxDifficulty   int
+	requestsPerStep int
+	window          time.Duration
+	requests        []time.Time
+	mutex           sync.Mutex
+}
+
+// NewDifficultyController creates a new DifficultyController. If requestsPerStep is zero or the maximum difficulty is
+// not above the base difficulty, the difficulty is static.
+func NewDifficultyController(baseDifficulty, maxDifficulty, requestsPerStep int, window time.Duration) *DifficultyController {
+	if maxDifficulty < baseDifficulty {
+		maxDifficulty = baseDifficulty
+	}
+	return &DifficultyController{
+		baseDifficulty:  baseDifficulty,
+		maxDifficulty:   maxDifficulty,
+		requestsPerStep: requestsPerStep,
+		window:          window,
+	}
+}
+
+// Difficulty returns the PoW difficulty currently required for funding requests.
+func (d *DifficultyController) Difficulty(now time.Time) int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.requestsPerStep <= 0 {
+		return d.baseDifficulty
+	}
+
+	d.trim(now)
+	difficulty := d.baseDifficulty + len(d.requests)/d.requestsPerStep
+	if difficulty > d.maxDifficulty {
+		return d.maxDifficulty
+	}
+	return difficulty
+}
+
+// RecordRequest adds an accepted request to the load of the faucet.
+func (d *DifficultyController) RecordRequest(now time.Time) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.requestsPerStep <= 0 || d.maxDifficulty == d.baseDifficulty {
+		return
+	}
+
+	d.trim(now)
+	d.requests = append(d.requests, now)
+}
+
+// trim removes the requests that are outside of the window.
+func (d *DifficultyController) trim(now time.Time) {
+	windowStart := now.Add(-d.window)
+	for i, requestTime := range d.requests {
+		if requestTime.After(windowStart) {
+			d.requests = d.requests[i:]
+			return
+		}
+	}
+	d.requests = d.requests[:0]
+}
@@ -21,4 +21,8 @@ var (
 	ErrSplittingFundsFailed = errors.New("none of funding outputs has been confirmed during funds preparation")
 	// ErrNotEnoughSupplyOutputs if there are not enough supply outputs in the faucet.
 	ErrNotEnoughSupplyOutputs = errors.New("not enough supply outputs to prepare more funds in the faucet")
+	// ErrColorNotSupported is returned if tokens of a color are requested that the faucet does not dispense.
+	ErrColorNotSupported = errors.New("color not supported by the faucet")
+	// ErrAmountExceedsLimit is returned if more tokens are requested than the faucet dispenses per request.
+	ErrAmountExceedsLimit = errors.New("requested amount exceeds the limit per request")
 )
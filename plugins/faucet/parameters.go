@@ -11,19 +11,41 @@ type ParametersDefinition struct {
 	// Seed defines the base58 encoded seed the faucet uses.
 	Seed string `usage:"the base58 encoded seed of the faucet, must be defined if this faucet is enabled"`
 
-	// TokensPerRequest defines the amount of tokens the faucet should send for each request.
+	// TokensPerRequest defines the amount of tokens the faucet should send for each request. Requests for a custom
+	// amount of tokens may not exceed it.
 	TokensPerRequest int `default:"1000000" usage:"the amount of tokens the faucet should send for each request"`
 
+	// ColoredTokens defines the colored tokens the faucet dispenses in addition to IOTA.
+	ColoredTokens []string `usage:"the colored tokens the faucet dispenses, each given as <base58 color>:<max amount per request>"`
+
 	// MaxTransactionBookedAwaitTime defines the time to await for the transaction fulfilling a funding request
 	// to become booked in the value layer.
 	MaxTransactionBookedAwaitTime time.Duration `default:"5s" usage:"the max amount of time for a funding transaction to become booked in the value layer"`
 
-	// PowDifficulty defines the PoW difficulty for faucet payloads.
+	// PowDifficulty defines the PoW difficulty for faucet payloads when the faucet is idle.
 	PowDifficulty int `default:"22" usage:"defines the PoW difficulty for faucet payloads"`
 
-	// BlacklistCapacity holds the maximum amount the address blacklist holds.
-	// An address for which a funding was done in the past is added to the blacklist and eventually is removed from it.
-	BlacklistCapacity int `default:"10000" usage:"holds the maximum amount the address blacklist holds"`
+	// MaxPowDifficulty defines the PoW difficulty for faucet payloads when the faucet is under load.
+	MaxPowDifficulty int `default:"26" usage:"defines the maximum PoW difficulty for faucet payloads under load"`
+
+	// RequestsPerDifficultyStep defines how many requests per DifficultyWindow increase the PoW difficulty by one. The
+	// scaling is disabled by default, as clients that don't query faucet/difficulty mine with the base difficulty.
+	RequestsPerDifficultyStep int `default:"0" usage:"the number of requests per difficulty window that increase the PoW difficulty by one (0 disables scaling)"`
+
+	// DifficultyWindow defines the window in which the requests determining the load of the faucet are counted.
+	DifficultyWindow time.Duration `default:"1m" usage:"the window in which the requests determining the load of the faucet are counted"`
+
+	// Throttle defines the quotas of funding requests.
+	Throttle struct {
+		// Window defines the sliding window of the quotas.
+		Window time.Duration `default:"24h" usage:"the sliding window of the quotas"`
+		// AddressQuota defines the number of requests per window for the same address.
+		AddressQuota int `default:"1" usage:"the number of requests per window for the same address (0 disables the quota)"`
+		// IssuerQuota defines the number of requests per window issued by the same node.
+		IssuerQuota int `default:"1000" usage:"the number of requests per window issued by the same node (0 disables the quota)"`
+		// PledgeQuota defines the number of requests per window pledging mana to the same node.
+		PledgeQuota int `default:"100" usage:"the number of requests per window pledging mana to the same node (0 disables the quota)"`
+	}
 
 	// SupplyOutputsCount is the number of supply outputs, and splitting transactions accordingly, the faucet prepares.
 	SupplyOutputsCount int `default:"20" usage:"the number of supply outputs, and splitting transactions accordingly, the faucet prepares."`
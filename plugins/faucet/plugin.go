@@ -3,21 +3,25 @@ package faucet
 import (
 	"context"
 	"runtime"
-	"sync"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/datastructure/orderedmap"
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/iotaledger/hive.go/workerpool"
+	"github.com/labstack/echo"
 	"github.com/mr-tron/base58"
 	"go.uber.org/atomic"
 	"go.uber.org/dig"
 
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/database"
 	"github.com/iotaledger/goshimmer/packages/faucet"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
@@ -43,23 +47,25 @@ var (
 	preparingWorkerPool      *workerpool.NonBlockingQueuedWorkerPool
 	preparingWorkerCount     = runtime.GOMAXPROCS(0)
 	preparingWorkerQueueSize = MaxFaucetOutputsCount + 1
-	targetPoWDifficulty      int
-	// blacklist makes sure that an address might only request tokens once.
-	blacklist         *orderedmap.OrderedMap
-	blacklistCapacity int
-	blackListMutex    sync.RWMutex
+	// difficulty scales the PoW difficulty required for funding requests with the load of the faucet.
+	difficulty *DifficultyController
+	// throttle limits the number of funding requests per address, issuer and mana pledge node.
+	throttle *Throttle
 	// signals that the faucet has initialized itself and can start funding requests.
 	initDone atomic.Bool
 
-	waitForManaWindow = 5 * time.Second
-	deps              = new(dependencies)
+	waitForManaWindow     = 5 * time.Second
+	throttlePruneInterval = 10 * time.Minute
+	deps                  = new(dependencies)
 )
 
 type dependencies struct {
 	dig.In
 
-	Local  *peer.Local
-	Tangle *tangle.Tangle
+	Local   *peer.Local
+	Tangle  *tangle.Tangle
+	Storage kvstore.KVStore
+	Server  *echo.Echo `optional:"true"`
 }
 
 func init() {
@@ -90,6 +96,10 @@ func newFaucet() *StateManager {
 	if Parameters.GenesisTokenAmount <= 0 {
 		Plugin.LogFatalf("the total supply should be more than 0")
 	}
+	coloredTokens, err := parseColoredTokens(Parameters.ColoredTokens)
+	if err != nil {
+		Plugin.LogFatalf("invalid colored tokens: %s", err)
+	}
 	return NewStateManager(
 		uint64(Parameters.TokensPerRequest),
 		walletseed.NewSeed(seedBytes),
@@ -97,13 +107,42 @@ func newFaucet() *StateManager {
 		uint64(Parameters.SplittingMultiplier),
 
 		Parameters.MaxTransactionBookedAwaitTime,
+		coloredTokens,
 	)
 }
 
+// parseColoredTokens parses the colored tokens the faucet dispenses and their maximum amount per request.
+func parseColoredTokens(definitions []string) (coloredTokens map[ledgerstate.Color]uint64, err error) {
+	coloredTokens = make(map[ledgerstate.Color]uint64, len(definitions))
+	for _, definition := range definitions {
+		parts := strings.Split(definition, ":")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("%s is not of the form <base58 color>:<max amount per request>", definition)
+		}
+		color, colorErr := ledgerstate.ColorFromBase58EncodedString(parts[0])
+		if colorErr != nil {
+			return nil, errors.Errorf("failed to parse color of %s: %w", definition, colorErr)
+		}
+		if color == ledgerstate.ColorIOTA || color == ledgerstate.ColorMint {
+			return nil, errors.Errorf("%s does not define a colored token", definition)
+		}
+		maxAmount, amountErr := strconv.ParseUint(parts[1], 10, 64)
+		if amountErr != nil || maxAmount == 0 {
+			return nil, errors.Errorf("the max amount per request of %s must be more than 0", definition)
+		}
+		coloredTokens[color] = maxAmount
+	}
+	return coloredTokens, nil
+}
+
 func configure(plugin *node.Plugin) {
-	targetPoWDifficulty = Parameters.PowDifficulty
-	blacklist = orderedmap.New()
-	blacklistCapacity = Parameters.BlacklistCapacity
+	difficulty = NewDifficultyController(Parameters.PowDifficulty, Parameters.MaxPowDifficulty,
+		Parameters.RequestsPerDifficultyStep, Parameters.DifficultyWindow)
+	throttle = NewThrottle(deps.Storage.WithRealm([]byte{database.PrefixFaucet}), Parameters.Throttle.Window, map[ThrottleKind]int{
+		ThrottleAddress: Parameters.Throttle.AddressQuota,
+		ThrottleIssuer:  Parameters.Throttle.IssuerQuota,
+		ThrottlePledge:  Parameters.Throttle.PledgeQuota,
+	})
 	_faucet = newFaucet()
 
 	fundingWorkerPool = workerpool.NewNonBlockingQueuedWorkerPool(func(task workerpool.Task) {
@@ -121,6 +160,7 @@ func configure(plugin *node.Plugin) {
 		workerpool.WorkerCount(preparingWorkerCount), workerpool.QueueSize(preparingWorkerQueueSize))
 
 	configureEvents()
+	configureWebAPI()
 }
 
 func run(plugin *node.Plugin) {
@@ -154,8 +194,19 @@ func run(plugin *node.Plugin) {
 
 		initDone.Store(true)
 
-		<-ctx.Done()
-		plugin.LogInfof("Stopping %s ...", PluginName)
+		pruneTicker := time.NewTicker(throttlePruneInterval)
+		defer pruneTicker.Stop()
+		for {
+			select {
+			case <-pruneTicker.C:
+				if err := throttle.Prune(time.Now()); err != nil {
+					plugin.LogWarnf("failed to prune throttling state: %s", err)
+				}
+			case <-ctx.Done():
+				plugin.LogInfof("Stopping %s ...", PluginName)
+				return
+			}
+		}
 	}, shutdown.PriorityFaucet); err != nil {
 		plugin.Logger().Panicf("Failed to start daemon: %s", err)
 	}
@@ -235,59 +286,32 @@ func configureEvents() {
 				return
 			}
 
-			if leadingZeroes < targetPoWDifficulty {
+			now := time.Now()
+			if targetPoWDifficulty := difficulty.Difficulty(now); leadingZeroes < targetPoWDifficulty {
 				Plugin.LogInfof("funding request for address %s doesn't fulfill PoW requirement %d vs. %d", addr.Base58(), targetPoWDifficulty, leadingZeroes)
 				return
 			}
 
-			if IsAddressBlackListed(addr) {
-				Plugin.LogInfof("can't fund address %s since it is blacklisted", addr.Base58())
+			if err := _faucet.ValidateRequest(fundingRequest); err != nil {
+				Plugin.LogInfof("can't fund address %s: %s", addr.Base58(), err)
+				return
+			}
+
+			issuer := identity.NewID(message.IssuerPublicKey())
+			if err := throttle.Allow(fundingRequest, issuer, now); err != nil {
+				Plugin.LogInfof("can't fund address %s: %s", addr.Base58(), err)
 				return
 			}
 
 			// finally add it to the faucet to be processed
 			_, added := fundingWorkerPool.TrySubmit(message)
 			if !added {
-				RemoveAddressFromBlacklist(addr)
+				throttle.Release(fundingRequest, issuer)
 				Plugin.LogInfof("dropped funding request for address %s as queue is full", addr.Base58())
 				return
 			}
+			difficulty.RecordRequest(now)
 			Plugin.LogInfof("enqueued funding request for address %s", addr.Base58())
 		})
 	}))
 }
-
-// IsAddressBlackListed returns if an address is blacklisted.
-// adds the given address to the blacklist and removes the oldest blacklist entry if it would go over capacity.
-func IsAddressBlackListed(address ledgerstate.Address) bool {
-	blackListMutex.Lock()
-	defer blackListMutex.Unlock()
-
-	// see if it was already blacklisted
-	_, blacklisted := blacklist.Get(address.Base58())
-
-	if blacklisted {
-		return true
-	}
-
-	// add it to the blacklist
-	blacklist.Set(address.Base58(), true)
-	if blacklist.Size() > blacklistCapacity {
-		var headKey interface{}
-		blacklist.ForEach(func(key, value interface{}) bool {
-			headKey = key
-			return false
-		})
-		blacklist.Delete(headKey)
-	}
-
-	return false
-}
-
-// RemoveAddressFromBlacklist removes an address from the blacklist.
-func RemoveAddressFromBlacklist(address ledgerstate.Address) {
-	blackListMutex.Lock()
-	defer blackListMutex.Unlock()
-
-	blacklist.Delete(address.Base58())
-}
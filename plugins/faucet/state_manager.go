@@ -73,6 +73,9 @@ type StateManager struct {
 	// to become booked in the value layer
 	maxTxBookedAwaitTime time.Duration
 
+	// the supplies of the colored tokens the faucet dispenses
+	coloredSupplies map[ledgerstate.Color]*coloredSupply
+
 	// fundingState serves fundingOutputs and its mutex
 	fundingState *fundingState
 
@@ -93,6 +96,7 @@ func NewStateManager(
 	supplyOutputsCount uint64,
 	splittingMultiplier uint64,
 	maxTxBookedTime time.Duration,
+	coloredTokens map[ledgerstate.Color]uint64,
 ) *StateManager {
 	// the max number of outputs in a tx is 127, therefore, when creating the splitting tx, we can have at most
 	// 126 prepared outputs (+1 remainder output).
@@ -104,7 +108,7 @@ func NewStateManager(
 		splittingMultiplier = MaxFaucetOutputsCount
 	}
 
-	fState := newFundingState()
+	fState := newFundingState(tokensPerRequest)
 	pState := newPreparingState(seed)
 
 	res := &StateManager{
@@ -119,6 +123,10 @@ func NewStateManager(
 
 		fundingState:       fState,
 		replenishmentState: pState,
+		coloredSupplies:    make(map[ledgerstate.Color]*coloredSupply, len(coloredTokens)),
+	}
+	for color, maxAmount := range coloredTokens {
+		res.coloredSupplies[color] = newColoredSupply(color, maxAmount, seed)
 	}
 
 	return res
@@ -169,25 +177,60 @@ func (s *StateManager) DeriveStateFromTangle(ctx context.Context) (err error) {
 	Plugin.LogInfof("There are currently %d funding outputs available", s.fundingState.FundingOutputsCount())
 	Plugin.LogInfof("Remainder output %s has %d tokens available", s.replenishmentState.RemainderOutputID().Base58(), s.replenishmentState.RemainderOutputBalance())
 
+	for color, supply := range s.coloredSupplies {
+		if err = supply.Prepare(s.issueTx); err != nil {
+			return err
+		}
+		Plugin.LogInfof("Supply of color %s on address %s has %d tokens available", color.Base58(), supply.address.Base58(), supply.Balance())
+	}
+
+	return err
+}
+
+// ValidateRequest checks that the faucet dispenses the color of the given request and that the requested amount is
+// within the limit of the color.
+func (s *StateManager) ValidateRequest(faucetReq *faucet.Request) error {
+	_, err := s.requestedAmount(faucetReq)
 	return err
 }
 
+// requestedAmount returns the amount of tokens to send for the given request.
+func (s *StateManager) requestedAmount(faucetReq *faucet.Request) (uint64, error) {
+	maxAmount := s.tokensPerRequest
+	if faucetReq.Color() != ledgerstate.ColorIOTA {
+		supply, exists := s.coloredSupplies[faucetReq.Color()]
+		if !exists {
+			return 0, errors.Errorf("%w: %s", ErrColorNotSupported, faucetReq.Color().Base58())
+		}
+		maxAmount = supply.maxAmount
+	}
+
+	switch amount := faucetReq.Amount(); {
+	case amount == 0:
+		return maxAmount, nil
+	case amount > maxAmount:
+		return 0, errors.Errorf("%w: %d > %d", ErrAmountExceedsLimit, amount, maxAmount)
+	default:
+		return amount, nil
+	}
+}
+
+// ColoredSupplyAddresses returns the addresses the tokens of the colors the faucet dispenses are held on.
+func (s *StateManager) ColoredSupplyAddresses() map[ledgerstate.Color]ledgerstate.Address {
+	addresses := make(map[ledgerstate.Color]ledgerstate.Address, len(s.coloredSupplies))
+	for color, supply := range s.coloredSupplies {
+		addresses[color] = supply.address
+	}
+	return addresses
+}
+
 // FulFillFundingRequest fulfills a faucet request by spending the next funding output to the requested address.
 // Mana of the transaction is pledged to the requesting node.
 func (s *StateManager) FulFillFundingRequest(requestMsg *tangle.Message) (*tangle.Message, string, error) {
 	faucetReq := requestMsg.Payload().(*faucet.Request)
 
-	if s.replenishThresholdReached() {
-		// wait for replenishment to finish if there is no funding outputs prepared
-		waitForPreparation := s.fundingState.FundingOutputsCount() == 0
-		s.signalReplenishmentNeeded(waitForPreparation)
-	}
-
-	// get an output that we can spend
-	fundingOutput, fErr := s.fundingState.GetFundingOutput()
-	// we don't have funding outputs
-	if errors.Is(fErr, ErrNotEnoughFundingOutputs) {
-		err := errors.Errorf("failed to gather funding outputs: %w", fErr)
+	amount, err := s.requestedAmount(faucetReq)
+	if err != nil {
 		return nil, "", err
 	}
 
@@ -202,7 +245,29 @@ func (s *StateManager) FulFillFundingRequest(requestMsg *tangle.Message) (*tangl
 		consensusManaPledgeID = faucetReq.ConsensusManaPledgeID()
 	}
 
-	tx := s.prepareFaucetTransaction(faucetReq.Address(), fundingOutput, accessManaPledgeID, consensusManaPledgeID)
+	if faucetReq.Color() != ledgerstate.ColorIOTA {
+		m, tx, fundErr := s.coloredSupplies[faucetReq.Color()].Fund(faucetReq.Address(), amount, accessManaPledgeID, consensusManaPledgeID, s.issueTx)
+		if fundErr != nil {
+			return nil, "", fundErr
+		}
+		return m, tx.ID().Base58(), nil
+	}
+
+	if s.replenishThresholdReached() {
+		// wait for replenishment to finish if there is no funding outputs prepared
+		waitForPreparation := s.fundingState.FundingOutputsCount() == 0
+		s.signalReplenishmentNeeded(waitForPreparation)
+	}
+
+	// get an output that we can spend
+	fundingOutput, fErr := s.fundingState.GetFundingOutput(amount)
+	// we don't have funding outputs
+	if errors.Is(fErr, ErrNotEnoughFundingOutputs) {
+		err = errors.Errorf("failed to gather funding outputs: %w", fErr)
+		return nil, "", err
+	}
+
+	tx := s.prepareFaucetTransaction(faucetReq.Address(), fundingOutput, amount, accessManaPledgeID, consensusManaPledgeID)
 
 	// issue funding request
 	m, err := s.issueTx(tx)
@@ -211,6 +276,19 @@ func (s *StateManager) FulFillFundingRequest(requestMsg *tangle.Message) (*tangl
 	}
 	txID := tx.ID().Base58()
 
+	// the change of partially spent funding outputs is used for the next requests
+	for _, output := range tx.Essence().Outputs() {
+		if output.Address().Equals(fundingOutput.Address) {
+			changeBalance, _ := output.Balances().Get(ledgerstate.ColorIOTA)
+			s.fundingState.FundingOutputsPushFront(&FaucetOutput{
+				ID:           output.ID(),
+				Balance:      changeBalance,
+				Address:      fundingOutput.Address,
+				AddressIndex: fundingOutput.AddressIndex,
+			})
+		}
+	}
+
 	return m, txID, nil
 }
 
@@ -235,22 +313,26 @@ func (s *StateManager) signalReplenishmentNeeded(wait bool) {
 	}
 }
 
-// prepareFaucetTransaction prepares a funding faucet transaction that spends fundingOutput to destAddr and pledges
-// mana to pledgeID.
-func (s *StateManager) prepareFaucetTransaction(destAddr ledgerstate.Address, fundingOutput *FaucetOutput, accessManaPledgeID, consensusManaPledgeID identity.ID) (tx *ledgerstate.Transaction) {
+// prepareFaucetTransaction prepares a funding faucet transaction that spends amount tokens of fundingOutput to
+// destAddr and pledges mana to pledgeID. The change is sent back to the address of the funding output, unless it is
+// less than the minimum output deposit, in which case it is added to the payout.
+func (s *StateManager) prepareFaucetTransaction(destAddr ledgerstate.Address, fundingOutput *FaucetOutput, amount uint64, accessManaPledgeID, consensusManaPledgeID identity.ID) (tx *ledgerstate.Transaction) {
 	inputs := ledgerstate.NewInputs(ledgerstate.NewUTXOInput(fundingOutput.ID))
+	timestamp := clock.SyncedTime()
 
-	outputs := ledgerstate.NewOutputs(ledgerstate.NewSigLockedColoredOutput(
-		ledgerstate.NewColoredBalances(
-			map[ledgerstate.Color]uint64{
-				ledgerstate.ColorIOTA: s.tokensPerRequest,
-			}),
-		destAddr,
-	))
+	change := fundingOutput.Balance - amount
+	if change > 0 && change < deps.Tangle.LedgerState.UTXODAG.ProtocolParameters().MinimumOutputDepositAt(timestamp) {
+		amount, change = fundingOutput.Balance, 0
+	}
+
+	outputs := ledgerstate.Outputs{s.createOutput(destAddr, amount)}
+	if change > 0 {
+		outputs = append(outputs, s.createOutput(fundingOutput.Address, change))
+	}
 
 	essence := ledgerstate.NewTransactionEssence(
 		0,
-		clock.SyncedTime(),
+		timestamp,
 		accessManaPledgeID,
 		consensusManaPledgeID,
 		ledgerstate.NewInputs(inputs...),
@@ -296,10 +378,11 @@ func (s *StateManager) findFundingOutputs() []*FaucetOutput {
 			deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
 				if outputMetadata.ConsumerCount() < 1 {
 					iotaBalance, colorExist := output.Balances().Get(ledgerstate.ColorIOTA)
-					if !colorExist {
+					if !colorExist || output.Balances().Size() != 1 {
 						return
 					}
-					if iotaBalance == s.tokensPerRequest {
+					// partially spent funding outputs hold less tokens
+					if iotaBalance <= s.tokensPerRequest {
 						// we found a prepared output
 						foundPreparedOutputs = append(foundPreparedOutputs, &FaucetOutput{
 							ID:           output.ID(),
@@ -776,19 +859,23 @@ func (s *splittingEnv) AddIssuedTxID(txID ledgerstate.TransactionID) {
 type fundingState struct {
 	// ordered list of available outputs to fund faucet requests
 	fundingOutputs *list.List
+	// the amount of tokens an output needs to hold to fund a request for the default amount
+	tokensPerRequest uint64
 
 	sync.RWMutex
 }
 
-func newFundingState() *fundingState {
+func newFundingState(tokensPerRequest uint64) *fundingState {
 	state := &fundingState{
-		fundingOutputs: list.New(),
+		fundingOutputs:   list.New(),
+		tokensPerRequest: tokensPerRequest,
 	}
 
 	return state
 }
 
-// FundingOutputsCount returns the number of available outputs that can be used to fund a request.
+// FundingOutputsCount returns the number of available outputs that can be used to fund a request for the default
+// amount. Partially spent outputs holding less than that are not counted, as they only serve smaller requests.
 func (f *fundingState) FundingOutputsCount() int {
 	f.RLock()
 	defer f.RUnlock()
@@ -804,20 +891,34 @@ func (f *fundingState) FundingOutputsAdd(fundingOutput *FaucetOutput) {
 	f.fundingOutputs.PushBack(fundingOutput)
 }
 
-// GetFundingOutput returns the first funding output in the list.
-func (f *fundingState) GetFundingOutput() (fundingOutput *FaucetOutput, err error) {
+// FundingOutputsPushFront adds FaucetOutput to the front of the fundingOutputs list, so it is used next.
+func (f *fundingState) FundingOutputsPushFront(fundingOutput *FaucetOutput) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.fundingOutputs.PushFront(fundingOutput)
+}
+
+// GetFundingOutput returns the first funding output in the list that holds at least the given amount of tokens.
+func (f *fundingState) GetFundingOutput(amount uint64) (fundingOutput *FaucetOutput, err error) {
 	f.Lock()
 	defer f.Unlock()
 
-	if f.fundingOutputsCount() < 1 {
-		return nil, ErrNotEnoughFundingOutputs
+	for element := f.fundingOutputs.Front(); element != nil; element = element.Next() {
+		if element.Value.(*FaucetOutput).Balance >= amount {
+			return f.fundingOutputs.Remove(element).(*FaucetOutput), nil
+		}
 	}
-	fundingOutput = f.fundingOutputs.Remove(f.fundingOutputs.Front()).(*FaucetOutput)
-	return
+	return nil, ErrNotEnoughFundingOutputs
 }
 
-func (f *fundingState) fundingOutputsCount() int {
-	return f.fundingOutputs.Len()
+func (f *fundingState) fundingOutputsCount() (count int) {
+	for element := f.fundingOutputs.Front(); element != nil; element = element.Next() {
+		if element.Value.(*FaucetOutput).Balance >= f.tokensPerRequest {
+			count++
+		}
+	}
+	return count
 }
 
 // replenishmentState keeps all variables and related methods used to track faucet state during replenishment.
@@ -0,0 +1,75 @@
+package faucet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/faucet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestParseColoredTokens(t *testing.T) {
+	color := ledgerstate.Color{1, 2, 3}
+	coloredTokens, err := parseColoredTokens([]string{color.Base58() + ":50"})
+	require.NoError(t, err)
+	assert.Equal(t, map[ledgerstate.Color]uint64{color: 50}, coloredTokens)
+
+	_, err = parseColoredTokens([]string{color.Base58()})
+	assert.Error(t, err)
+	_, err = parseColoredTokens([]string{color.Base58() + ":0"})
+	assert.Error(t, err)
+	_, err = parseColoredTokens([]string{ledgerstate.ColorIOTA.Base58() + ":10"})
+	assert.Error(t, err)
+}
+
+func TestStateManager_RequestedAmount(t *testing.T) {
+	color := ledgerstate.Color{1, 2, 3}
+	stateManager := NewStateManager(100, walletseed.NewSeed(), 1, 1, time.Second, map[ledgerstate.Color]uint64{color: 10})
+	address := randomAddress()
+
+	amount, err := stateManager.requestedAmount(faucet.NewRequest(address, identity.ID{}, identity.ID{}, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), amount)
+
+	amount, err = stateManager.requestedAmount(faucet.NewColoredRequest(address, ledgerstate.ColorIOTA, 40, identity.ID{}, identity.ID{}, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(40), amount)
+
+	amount, err = stateManager.requestedAmount(faucet.NewColoredRequest(address, color, 0, identity.ID{}, identity.ID{}, 0))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), amount)
+
+	_, err = stateManager.requestedAmount(faucet.NewColoredRequest(address, color, 11, identity.ID{}, identity.ID{}, 0))
+	assert.ErrorIs(t, err, ErrAmountExceedsLimit)
+
+	_, err = stateManager.requestedAmount(faucet.NewColoredRequest(address, ledgerstate.Color{4}, 1, identity.ID{}, identity.ID{}, 0))
+	assert.ErrorIs(t, err, ErrColorNotSupported)
+}
+
+func TestFundingState_GetFundingOutput(t *testing.T) {
+	state := newFundingState(100)
+	state.FundingOutputsAdd(&FaucetOutput{Balance: 100, AddressIndex: 1})
+	state.FundingOutputsPushFront(&FaucetOutput{Balance: 30, AddressIndex: 2})
+
+	// only the outputs that can fund a request for the default amount are counted
+	assert.Equal(t, 1, state.FundingOutputsCount())
+
+	// partially spent outputs are used first if they hold enough tokens
+	output, err := state.GetFundingOutput(20)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), output.AddressIndex)
+
+	state.FundingOutputsPushFront(&FaucetOutput{Balance: 10, AddressIndex: 2})
+	output, err = state.GetFundingOutput(50)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), output.AddressIndex)
+
+	_, err = state.GetFundingOutput(50)
+	assert.ErrorIs(t, err, ErrNotEnoughFundingOutputs)
+	assert.Zero(t, state.FundingOutputsCount())
+}
@@ -0,0 +1,303 @@
+package faucet
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/faucet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+var (
+	// ErrThrottled is returned if a funding request exceeds one of the quotas of the faucet.
+	ErrThrottled = errors.New("funding request throttled")
+	// ErrUnknownThrottleKind is returned if the name of a ThrottleKind is not known.
+	ErrUnknownThrottleKind = errors.New("unknown throttle kind")
+)
+
+// region ThrottleKind /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ThrottleKind defines what the requests counted by a quota have in common.
+type ThrottleKind uint8
+
+const (
+	// ThrottleAddress counts the requests funding the same address.
+	ThrottleAddress ThrottleKind = iota
+	// ThrottleIssuer counts the requests issued by the same node.
+	ThrottleIssuer
+	// ThrottlePledge counts the requests pledging mana to the same node.
+	ThrottlePledge
+)
+
+// ThrottleKinds contains all the kinds of quotas.
+var ThrottleKinds = []ThrottleKind{ThrottleAddress, ThrottleIssuer, ThrottlePledge}
+
+var throttleKindNames = []string{"address", "issuer", "pledge"}
+
+// ThrottleKindFromString parses the name of a ThrottleKind.
+func ThrottleKindFromString(name string) (ThrottleKind, error) {
+	for i, kindName := range throttleKindNames {
+		if strings.EqualFold(kindName, name) {
+			return ThrottleKind(i), nil
+		}
+	}
+	return 0, errors.Errorf("%w: %s", ErrUnknownThrottleKind, name)
+}
+
+// String returns the name of the ThrottleKind.
+func (k ThrottleKind) String() string {
+	if int(k) < len(throttleKindNames) {
+		return throttleKindNames[k]
+	}
+	return "unknown"
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Throttle /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ThrottleEntry contains the requests counted against the quota of a single address or node.
+type ThrottleEntry struct {
+	Kind     ThrottleKind
+	Key      string
+	Requests []time.Time
+}
+
+// Throttle limits the number of funding requests per address, issuing node and mana pledge node within a sliding
+// window. Its state is persisted, so it survives restarts of the node.
+type Throttle struct {
+	store  kvstore.KVStore
+	window time.Duration
+	quotas map[ThrottleKind]int
+	mutex  sync.Mutex
+}
+
+// NewThrottle creates a Throttle that allows quotas[kind] requests per key of the given kind within the window. A
+// quota of zero disables the limit of the kind.
+func NewThrottle(store kvstore.KVStore, window time.Duration, quotas map[ThrottleKind]int) *Throttle {
+	return &Throttle{
+		store:  store,
+		window: window,
+		quotas: quotas,
+	}
+}
+
+// Allow checks the quotas of the given request issued by the given node. If none of them is exceeded, the request is
+// counted against all of them.
+func (t *Throttle) Allow(request *faucet.Request, issuer identity.ID, now time.Time) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	entries := make([]*ThrottleEntry, 0, len(ThrottleKinds))
+	for _, entry := range t.entriesOfRequest(request, issuer) {
+		quota := t.quotas[entry.Kind]
+		if quota <= 0 {
+			continue
+		}
+
+		entry.Requests = t.load(entry.Kind, entry.Key, now)
+		if len(entry.Requests) >= quota {
+			return errors.Errorf("%w: %s %s exceeded the quota of %d requests per %s", ErrThrottled, entry.Kind, entry.Key, quota, t.window)
+		}
+		entries = append(entries, entry)
+	}
+
+	for _, entry := range entries {
+		entry.Requests = append(entry.Requests, now)
+		if err := t.save(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Release removes the most recent request of the given request's keys, e.g. because it could not be processed.
+func (t *Throttle) Release(request *faucet.Request, issuer identity.ID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	for _, entry := range t.entriesOfRequest(request, issuer) {
+		if t.quotas[entry.Kind] <= 0 {
+			continue
+		}
+		if entry.Requests = t.load(entry.Kind, entry.Key, now); len(entry.Requests) == 0 {
+			continue
+		}
+		entry.Requests = entry.Requests[:len(entry.Requests)-1]
+		_ = t.save(entry)
+	}
+}
+
+// Entries returns the throttling state of all the keys of the given kind.
+func (t *Throttle) Entries(kind ThrottleKind) (entries []*ThrottleEntry, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	err = t.store.Iterate([]byte{byte(kind)}, func(key kvstore.Key, value kvstore.Value) bool {
+		requests, parseErr := requestsFromBytes(value)
+		if parseErr != nil {
+			err = parseErr
+			return false
+		}
+		if requests = t.trim(requests, now); len(requests) > 0 {
+			entries = append(entries, &ThrottleEntry{Kind: kind, Key: string(key[1:]), Requests: requests})
+		}
+		return true
+	})
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries, err
+}
+
+// Clear removes the throttling state of the given key of the given kind.
+func (t *Throttle) Clear(kind ThrottleKind, key string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.store.Delete(throttleStorageKey(kind, key))
+}
+
+// ClearAll removes the throttling state of all keys.
+func (t *Throttle) ClearAll() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.store.Clear()
+}
+
+// Prune removes the requests that are outside of the window.
+func (t *Throttle) Prune(now time.Time) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var expiredKeys, updatedKeys, updatedValues [][]byte
+	if err := t.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		requests, err := requestsFromBytes(value)
+		if err != nil {
+			expiredKeys = append(expiredKeys, key)
+			return true
+		}
+		switch trimmed := t.trim(requests, now); {
+		case len(trimmed) == 0:
+			expiredKeys = append(expiredKeys, key)
+		case len(trimmed) < len(requests):
+			updatedKeys = append(updatedKeys, key)
+			updatedValues = append(updatedValues, requestsBytes(trimmed))
+		}
+		return true
+	}); err != nil {
+		return errors.Wrap(err, "failed to iterate throttling state")
+	}
+
+	for _, key := range expiredKeys {
+		if err := t.store.Delete(key); err != nil {
+			return errors.Wrap(err, "failed to delete throttling state")
+		}
+	}
+	for i, key := range updatedKeys {
+		if err := t.store.Set(key, updatedValues[i]); err != nil {
+			return errors.Wrap(err, "failed to update throttling state")
+		}
+	}
+	return nil
+}
+
+// entriesOfRequest returns the (not yet loaded) entries of all keys the given request is counted against.
+func (t *Throttle) entriesOfRequest(request *faucet.Request, issuer identity.ID) (entries []*ThrottleEntry) {
+	// the quota of an address applies to each color separately
+	addressKey := request.Address().Base58()
+	if request.Color() != ledgerstate.ColorIOTA {
+		addressKey += ":" + request.Color().Base58()
+	}
+	entries = append(entries,
+		&ThrottleEntry{Kind: ThrottleAddress, Key: addressKey},
+		&ThrottleEntry{Kind: ThrottleIssuer, Key: base58.Encode(issuer.Bytes())},
+	)
+
+	// the pledge quota only applies to the pledge IDs that are set, the issuer is already covered by its own quota
+	pledgeIDs := make(map[identity.ID]struct{})
+	for _, pledgeID := range []identity.ID{request.AccessManaPledgeID(), request.ConsensusManaPledgeID()} {
+		if pledgeID != (identity.ID{}) {
+			pledgeIDs[pledgeID] = struct{}{}
+		}
+	}
+	for pledgeID := range pledgeIDs {
+		entries = append(entries, &ThrottleEntry{Kind: ThrottlePledge, Key: base58.Encode(pledgeID.Bytes())})
+	}
+	return entries
+}
+
+// load returns the requests of the given key that are inside the window.
+func (t *Throttle) load(kind ThrottleKind, key string, now time.Time) []time.Time {
+	value, err := t.store.Get(throttleStorageKey(kind, key))
+	if err != nil {
+		return nil
+	}
+	requests, err := requestsFromBytes(value)
+	if err != nil {
+		return nil
+	}
+	return t.trim(requests, now)
+}
+
+// save persists the requests of the given entry.
+func (t *Throttle) save(entry *ThrottleEntry) error {
+	if len(entry.Requests) == 0 {
+		return t.store.Delete(throttleStorageKey(entry.Kind, entry.Key))
+	}
+	if err := t.store.Set(throttleStorageKey(entry.Kind, entry.Key), requestsBytes(entry.Requests)); err != nil {
+		return errors.Wrapf(err, "failed to persist throttling state of %s %s", entry.Kind, entry.Key)
+	}
+	return nil
+}
+
+// trim removes the requests that are outside of the window.
+func (t *Throttle) trim(requests []time.Time, now time.Time) []time.Time {
+	windowStart := now.Add(-t.window)
+	for i, requestTime := range requests {
+		if requestTime.After(windowStart) {
+			return requests[i:]
+		}
+	}
+	return nil
+}
+
+func throttleStorageKey(kind ThrottleKind, key string) []byte {
+	return append([]byte{byte(kind)}, key...)
+}
+
+func requestsBytes(requests []time.Time) []byte {
+	marshalUtil := marshalutil.New().WriteUint16(uint16(len(requests)))
+	for _, requestTime := range requests {
+		marshalUtil.WriteTime(requestTime)
+	}
+	return marshalUtil.Bytes()
+}
+
+func requestsFromBytes(data []byte) (requests []time.Time, err error) {
+	marshalUtil := marshalutil.New(data)
+	count, err := marshalUtil.ReadUint16()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse request count")
+	}
+	requests = make([]time.Time, count)
+	for i := range requests {
+		if requests[i], err = marshalUtil.ReadTime(); err != nil {
+			return nil, errors.Wrap(err, "failed to parse request time")
+		}
+	}
+	return requests, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
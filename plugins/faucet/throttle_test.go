@@ -0,0 +1,97 @@
+package faucet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/faucet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestThrottle(t *testing.T) {
+	store := mapdb.NewMapDB()
+	quotas := map[ThrottleKind]int{ThrottleAddress: 1, ThrottleIssuer: 2, ThrottlePledge: 0}
+	throttle := NewThrottle(store, time.Hour, quotas)
+
+	issuer := identity.GenerateIdentity().ID()
+	now := time.Now()
+
+	// the address quota is exceeded by the second request for the same address
+	request := faucet.NewRequest(randomAddress(), identity.ID{}, identity.ID{}, 0)
+	require.NoError(t, throttle.Allow(request, issuer, now))
+	assert.ErrorIs(t, throttle.Allow(request, issuer, now), ErrThrottled)
+
+	// the issuer quota is exceeded by the third request of the same issuer
+	require.NoError(t, throttle.Allow(faucet.NewRequest(randomAddress(), identity.ID{}, identity.ID{}, 0), issuer, now))
+	assert.ErrorIs(t, throttle.Allow(faucet.NewRequest(randomAddress(), identity.ID{}, identity.ID{}, 0), issuer, now), ErrThrottled)
+
+	// the state survives a restart
+	throttle = NewThrottle(store, time.Hour, quotas)
+	entries, err := throttle.Entries(ThrottleIssuer)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, base58.Encode(issuer.Bytes()), entries[0].Key)
+	assert.Len(t, entries[0].Requests, 2)
+
+	// released requests no longer count against the quotas
+	throttle.Release(request, issuer)
+	require.NoError(t, throttle.Allow(faucet.NewRequest(randomAddress(), identity.ID{}, identity.ID{}, 0), issuer, now))
+
+	// requests outside of the window are pruned
+	require.NoError(t, throttle.Prune(now.Add(time.Hour)))
+	for _, kind := range ThrottleKinds {
+		entries, err = throttle.Entries(kind)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	}
+
+	require.NoError(t, throttle.Allow(request, issuer, now))
+	require.NoError(t, throttle.Clear(ThrottleAddress, request.Address().Base58()))
+	require.NoError(t, throttle.Allow(request, issuer, now))
+}
+
+func TestThrottle_PledgeQuota(t *testing.T) {
+	quotas := map[ThrottleKind]int{ThrottleAddress: 0, ThrottleIssuer: 0, ThrottlePledge: 1}
+	throttle := NewThrottle(mapdb.NewMapDB(), time.Hour, quotas)
+
+	issuer := identity.GenerateIdentity().ID()
+	pledgeID := identity.GenerateIdentity().ID()
+	now := time.Now()
+
+	// requests without pledge IDs are not counted against the pledge quota of the issuer
+	require.NoError(t, throttle.Allow(faucet.NewRequest(randomAddress(), identity.ID{}, identity.ID{}, 0), issuer, now))
+	require.NoError(t, throttle.Allow(faucet.NewRequest(randomAddress(), identity.ID{}, identity.ID{}, 0), issuer, now))
+	entries, err := throttle.Entries(ThrottlePledge)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// requests pledging to the same node share its quota
+	require.NoError(t, throttle.Allow(faucet.NewRequest(randomAddress(), pledgeID, identity.ID{}, 0), issuer, now))
+	assert.ErrorIs(t, throttle.Allow(faucet.NewRequest(randomAddress(), identity.ID{}, pledgeID, 0), issuer, now), ErrThrottled)
+}
+
+func TestDifficultyController(t *testing.T) {
+	controller := NewDifficultyController(10, 12, 2, time.Minute)
+
+	now := time.Now()
+	for i := 0; i < 6; i++ {
+		controller.RecordRequest(now)
+	}
+	assert.Equal(t, 12, controller.Difficulty(now))
+
+	controller = NewDifficultyController(10, 12, 2, time.Minute)
+	controller.RecordRequest(now)
+	controller.RecordRequest(now)
+	assert.Equal(t, 11, controller.Difficulty(now))
+	assert.Equal(t, 10, controller.Difficulty(now.Add(time.Minute)))
+}
+
+func randomAddress() ledgerstate.Address {
+	return ledgerstate.NewED25519Address(identity.GenerateIdentity().PublicKey())
+}
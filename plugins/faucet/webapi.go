@@ -0,0 +1,116 @@
+package faucet
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/apitoken"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+)
+
+const (
+	// RouteDifficulty defines the HTTP path of the endpoint returning the current PoW difficulty of the faucet.
+	RouteDifficulty = "faucet/difficulty"
+	// RouteColors defines the HTTP path of the endpoint returning the tokens dispensed by the faucet.
+	RouteColors = "faucet/colors"
+	// RouteThrottle defines the HTTP path of the endpoints inspecting and clearing the throttling state of the faucet.
+	RouteThrottle = "faucet/throttle"
+)
+
+func configureWebAPI() {
+	if deps.Server == nil {
+		return
+	}
+
+	webapi.RequireScope(apitoken.ScopeRead, http.MethodGet, RouteDifficulty, RouteColors)
+	webapi.RequireScope(apitoken.ScopeAdmin, "", RouteThrottle)
+
+	deps.Server.GET(RouteDifficulty, getDifficultyHandler)
+	deps.Server.GET(RouteColors, getColorsHandler)
+	deps.Server.GET(RouteThrottle, getThrottleHandler)
+	deps.Server.DELETE(RouteThrottle, clearThrottleHandler)
+}
+
+// getDifficultyHandler returns the PoW difficulty currently required for funding requests.
+func getDifficultyHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.FaucetDifficultyResponse{Difficulty: difficulty.Difficulty(time.Now())})
+}
+
+// getColorsHandler returns the tokens dispensed by the faucet, their limit per request and the addresses holding their
+// supply, to which more tokens can be deposited.
+func getColorsHandler(c echo.Context) error {
+	response := jsonmodels.FaucetColorsResponse{Colors: []jsonmodels.FaucetColor{{
+		Color:     ledgerstate.ColorIOTA.String(),
+		MaxAmount: uint64(Parameters.TokensPerRequest),
+	}}}
+	for color, supply := range _faucet.coloredSupplies {
+		response.Colors = append(response.Colors, jsonmodels.FaucetColor{
+			Color:         color.Base58(),
+			MaxAmount:     supply.maxAmount,
+			SupplyAddress: supply.address.Base58(),
+			Balance:       supply.Balance(),
+		})
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// getThrottleHandler returns the throttling state of all keys, or only of the kind given by the kind query parameter.
+func getThrottleHandler(c echo.Context) error {
+	kinds := ThrottleKinds
+	if kindName := c.QueryParam("kind"); kindName != "" {
+		kind, err := ThrottleKindFromString(kindName)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.FaucetThrottleResponse{Error: err.Error()})
+		}
+		kinds = []ThrottleKind{kind}
+	}
+
+	response := jsonmodels.FaucetThrottleResponse{Entries: make([]jsonmodels.FaucetThrottleEntry, 0)}
+	for _, kind := range kinds {
+		entries, err := throttle.Entries(kind)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, jsonmodels.FaucetThrottleResponse{Error: err.Error()})
+		}
+		for _, entry := range entries {
+			requests := make([]int64, len(entry.Requests))
+			for i, requestTime := range entry.Requests {
+				requests[i] = requestTime.Unix()
+			}
+			response.Entries = append(response.Entries, jsonmodels.FaucetThrottleEntry{
+				Kind:     entry.Kind.String(),
+				Key:      entry.Key,
+				Requests: requests,
+			})
+		}
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// clearThrottleHandler clears the throttling state of the key given by the kind and key query parameters, or of all
+// keys if no kind is given.
+func clearThrottleHandler(c echo.Context) error {
+	kindName := c.QueryParam("kind")
+	if kindName == "" {
+		if err := throttle.ClearAll(); err != nil {
+			return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+		}
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	kind, err := ThrottleKindFromString(kindName)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	key := c.QueryParam("key")
+	if key == "" {
+		return c.JSON(http.StatusBadRequest, jsonmodels.ErrorResponse{Error: "key must be given together with kind"})
+	}
+	if err := throttle.Clear(kind, key); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -1,11 +1,17 @@
 package manualpeering
 
-import "github.com/iotaledger/hive.go/configuration"
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
 
 // ParametersDefinition contains the definition of the parameters used by the manualPeering plugin.
 type ParametersDefinition struct {
 	// KnownPeers defines the map of peers to be used as known peers.
 	KnownPeers string `usage:"map of peers that will be used as known peers"`
+	// ResolveInterval defines the interval in which the DNS names of known peers are resolved again.
+	ResolveInterval time.Duration `default:"1m" usage:"the interval in which the DNS names of known peers are resolved again"`
 }
 
 // Parameters contains the configuration used by the manualPeering plugin.
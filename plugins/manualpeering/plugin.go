@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
@@ -45,8 +46,11 @@ func init() {
 	}))
 }
 
-func newManager(lPeer *peer.Local, gossipMgr *gossip.Manager) *manualpeering.Manager {
-	return manualpeering.NewManager(gossipMgr, lPeer, logger.NewLogger(PluginName))
+func newManager(lPeer *peer.Local, gossipMgr *gossip.Manager, store kvstore.KVStore) *manualpeering.Manager {
+	return manualpeering.NewManager(gossipMgr, lPeer, logger.NewLogger(PluginName),
+		manualpeering.WithStore(store),
+		manualpeering.WithResolveInterval(Parameters.ResolveInterval),
+	)
 }
 
 func configure(_ *node.Plugin) {
@@ -7,6 +7,7 @@ import (
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/labstack/echo"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/manualpeering"
 	"github.com/iotaledger/goshimmer/plugins/webapi"
@@ -16,6 +17,8 @@ import (
 const RouteManualPeers = "manualpeering/peers"
 
 func configureWebAPI() {
+	webapi.RequireScope(apitoken.ScopePeering, "", RouteManualPeers)
+
 	deps.Server.POST(RouteManualPeers, addPeersHandler)
 	deps.Server.DELETE(RouteManualPeers, removePeersHandler)
 	deps.Server.GET(RouteManualPeers, getPeersHandler)
@@ -27,6 +30,11 @@ An example of the HTTP JSON request:
     {
         "publicKey": "EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP",
         "address": "172.19.0.3:14666"
+    },
+    {
+        "publicKey": "CHfU1NUf6ZvUKDQHTG2df53GR7CvuMFtyt7YymJ6DwS3",
+        "address": "node.example.com:14666",
+        "alias": "cluster-node-2"
     }
 ].
 */
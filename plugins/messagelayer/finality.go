@@ -1,20 +1,65 @@
 package messagelayer
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/finality"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
-var finalityGadget finality.Gadget
+var (
+	finalityGadget        finality.Gadget
+	branchFinalityPolicy  *finality.Policy
+	messageFinalityPolicy *finality.Policy
+)
 
 // FinalityGadget is the finality gadget instance.
 func FinalityGadget() finality.Gadget {
 	return finalityGadget
 }
 
+// FinalityPolicies returns the policies the finality gadget uses to derive the grade of finality of branches and
+// messages.
+func FinalityPolicies() (branchPolicy, messagePolicy *finality.Policy) {
+	return branchFinalityPolicy, messageFinalityPolicy
+}
+
+// newFinalityGadget creates the finality gadget with the policies defined in the config.
+func newFinalityGadget(tangleInstance *tangle.Tangle) finality.Gadget {
+	branchFinalityPolicy = finalityPolicy("branch", FinalityParameters.Branch)
+	messageFinalityPolicy = finalityPolicy("message", FinalityParameters.Message)
+
+	return finality.NewSimpleFinalityGadget(tangleInstance,
+		finality.WithBranchPolicy(branchFinalityPolicy),
+		finality.WithMessagePolicy(messageFinalityPolicy),
+	)
+}
+
+func finalityPolicy(name string, parameters FinalityPolicyParametersDefinition) *finality.Policy {
+	policy := &finality.Policy{
+		Thresholds: finality.Thresholds{
+			Low:    parameters.LowThreshold,
+			Medium: parameters.MediumThreshold,
+			High:   parameters.HighThreshold,
+		},
+		MinDurations: make(map[gof.GradeOfFinality]time.Duration),
+	}
+	if parameters.MinLowDuration > 0 {
+		policy.MinDurations[gof.Low] = parameters.MinLowDuration
+	}
+	if parameters.MinMediumDuration > 0 {
+		policy.MinDurations[gof.Medium] = parameters.MinMediumDuration
+	}
+	if err := policy.Validate(); err != nil {
+		Plugin.Panicf("invalid %s finality policy: %s", name, err)
+	}
+	return policy
+}
+
 func configureFinality() {
 	deps.Tangle.ApprovalWeightManager.Events.MarkerWeightChanged.Attach(events.NewClosure(func(e *tangle.MarkerWeightChangedEvent) {
 		if err := finalityGadget.HandleMarker(e.Marker, e.Weight); err != nil {
@@ -8,6 +8,8 @@ import (
 	"sort"
 	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/datastructure/set"
 	"github.com/iotaledger/hive.go/events"
@@ -23,6 +25,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/database"
 )
 
 const (
@@ -58,6 +61,14 @@ func init() {
 	}))
 }
 
+// storagePrefixes contains the storage prefixes of the mana vector types.
+var storagePrefixes = map[mana.Type]byte{
+	mana.AccessMana:        mana.PrefixAccess,
+	mana.ConsensusMana:     mana.PrefixConsensus,
+	mana.ResearchAccess:    mana.PrefixAccessResearch,
+	mana.ResearchConsensus: mana.PrefixConsensusResearch,
+}
+
 func configureManaPlugin(*node.Plugin) {
 	manaLogger = logger.NewLogger(PluginName)
 
@@ -74,12 +85,13 @@ func configureManaPlugin(*node.Plugin) {
 	storages = make(map[mana.Type]*objectstorage.ObjectStorage)
 	store := deps.Storage
 	osFactory = objectstorage.NewFactory(store, db_pkg.PrefixMana)
-	storages[mana.AccessMana] = osFactory.New(mana.PrefixAccess, mana.FromObjectStorage)
-	storages[mana.ConsensusMana] = osFactory.New(mana.PrefixConsensus, mana.FromObjectStorage)
+	storages[mana.AccessMana] = osFactory.New(storagePrefixes[mana.AccessMana], mana.FromObjectStorage)
+	storages[mana.ConsensusMana] = osFactory.New(storagePrefixes[mana.ConsensusMana], mana.FromObjectStorage)
 	if ManaParameters.EnableResearchVectors {
-		storages[mana.ResearchAccess] = osFactory.New(mana.PrefixAccessResearch, mana.FromObjectStorage)
-		storages[mana.ResearchConsensus] = osFactory.New(mana.PrefixConsensusResearch, mana.FromObjectStorage)
+		storages[mana.ResearchAccess] = osFactory.New(storagePrefixes[mana.ResearchAccess], mana.FromObjectStorage)
+		storages[mana.ResearchConsensus] = osFactory.New(storagePrefixes[mana.ResearchConsensus], mana.FromObjectStorage)
 	}
+	database.RegisterCachedObjectsExporter(exportManaCachedObjects)
 	// consensusEventsLogStorage = osFactory.New(mana.PrefixEventStorage, mana.FromEventObjectStorage)
 	// consensusEventsLogsStorageSize.Store(getConsensusEventLogsStorageSize())
 	// manaLogger.Infof("read %d mana events from storage", consensusEventsLogsStorageSize.Load())
@@ -265,6 +277,28 @@ func storeManaVectors() {
 	}
 }
 
+// exportManaCachedObjects adds the cached objects of the mana storages and the current mana vectors to the given
+// CachedObjects. The mana vectors are only stored when the node shuts down, so they are added directly to let a
+// checkpoint contain the mana of its ledger state.
+func exportManaCachedObjects(cachedObjects *db_pkg.CachedObjects) (err error) {
+	for vectorType, storage := range storages {
+		if err = db_pkg.ExportCachedObjects(cachedObjects, []byte{db_pkg.PrefixMana, storagePrefixes[vectorType]}, storage); err != nil {
+			return err
+		}
+	}
+
+	for vectorType, baseManaVector := range baseManaVectors {
+		for _, persistable := range baseManaVector.ToPersistables() {
+			key := byteutils.ConcatBytes([]byte{db_pkg.PrefixMana, storagePrefixes[vectorType]}, persistable.ObjectStorageKey())
+			if err = cachedObjects.Set(key, persistable.ObjectStorageValue()); err != nil {
+				return errors.Errorf("failed to export %s mana vector: %w", vectorType, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func pruneStorages() {
 	for vectorType := range baseManaVectors {
 		_ = storages[vectorType].Prune()
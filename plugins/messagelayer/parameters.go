@@ -93,6 +93,37 @@ type AdversaryParametersDefinition struct {
 	OrphanageEnabled bool `default:"false" usage:"defines if the adversary mode for orphanage attack is enabled"`
 }
 
+// ConsensusParametersDefinition contains the definition of the parameters used by the consensus mechanism.
+type ConsensusParametersDefinition struct {
+	// Mechanism defines the consensus mechanism that is used to form opinions about conflicting branches.
+	Mechanism string `default:"otv" usage:"the consensus mechanism used to form opinions about conflicting branches (otv, firstSeen, hysteresis)"`
+	// HysteresisDelta defines the weight margin a branch needs over the liked branch to flip the opinion when the
+	// hysteresis mechanism is used.
+	HysteresisDelta float64 `default:"0.1" usage:"the weight margin a branch needs over the liked branch to flip the opinion (hysteresis mechanism only)"`
+}
+
+// FinalityParametersDefinition contains the definition of the parameters used by the finality gadget.
+type FinalityParametersDefinition struct {
+	// Branch contains the finality policy of branches.
+	Branch FinalityPolicyParametersDefinition
+	// Message contains the finality policy of messages.
+	Message FinalityPolicyParametersDefinition
+}
+
+// FinalityPolicyParametersDefinition contains the definition of the parameters of a finality policy.
+type FinalityPolicyParametersDefinition struct {
+	// LowThreshold defines the approval weight needed to reach GoF low.
+	LowThreshold float64 `default:"0.25" usage:"the approval weight needed to reach GoF low"`
+	// MediumThreshold defines the approval weight needed to reach GoF medium.
+	MediumThreshold float64 `default:"0.45" usage:"the approval weight needed to reach GoF medium"`
+	// HighThreshold defines the approval weight needed to reach GoF high.
+	HighThreshold float64 `default:"0.67" usage:"the approval weight needed to reach GoF high"`
+	// MinLowDuration defines the minimum time an object has to stay at GoF low before it is promoted.
+	MinLowDuration time.Duration `default:"0s" usage:"the minimum time an object has to stay at GoF low before it is promoted"`
+	// MinMediumDuration defines the minimum time an object has to stay at GoF medium before it is promoted.
+	MinMediumDuration time.Duration `default:"0s" usage:"the minimum time an object has to stay at GoF medium before it is promoted"`
+}
+
 // Parameters contains the general configuration used by the messagelayer plugin.
 var Parameters = &ParametersDefinition{}
 
@@ -114,6 +145,12 @@ var TipManagerParameters = &TipManagerParametersDefinition{}
 // AdversaryParameters contains the tip manager configuration used by the adversary mode.
 var AdversaryParameters = &AdversaryParametersDefinition{}
 
+// ConsensusParameters contains the consensus mechanism configuration used by the messagelayer plugin.
+var ConsensusParameters = &ConsensusParametersDefinition{}
+
+// FinalityParameters contains the finality gadget configuration used by the messagelayer plugin.
+var FinalityParameters = &FinalityParametersDefinition{}
+
 func init() {
 	configuration.BindParameters(Parameters, "messageLayer")
 	configuration.BindParameters(ManaParameters, "mana")
@@ -122,4 +159,6 @@ func init() {
 	configuration.BindParameters(SolidifierParameters, "solidifier")
 	configuration.BindParameters(TipManagerParameters, "tipManager")
 	configuration.BindParameters(AdversaryParameters, "adversary")
+	configuration.BindParameters(ConsensusParameters, "consensus")
+	configuration.BindParameters(FinalityParameters, "finality")
 }
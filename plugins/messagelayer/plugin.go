@@ -18,7 +18,9 @@ import (
 
 	"github.com/iotaledger/goshimmer/plugins/remotelog"
 
-	"github.com/iotaledger/goshimmer/packages/consensus/finality"
+	"github.com/iotaledger/goshimmer/packages/consensus"
+	"github.com/iotaledger/goshimmer/packages/consensus/firstseen"
+	"github.com/iotaledger/goshimmer/packages/consensus/hysteresis"
 	"github.com/iotaledger/goshimmer/packages/consensus/otv"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
@@ -83,6 +85,8 @@ func configure(plugin *node.Plugin) {
 		plugin.LogError(err)
 	}))
 
+	database.RegisterCachedObjectsExporter(deps.Tangle.ExportCachedObjects)
+
 	// Messages created by the node need to pass through the normal flow.
 	deps.Tangle.MessageFactory.Events.MessageConstructed.Attach(events.NewClosure(func(message *tangle.Message) {
 		deps.Tangle.ProcessGossipMessage(message.Bytes(), deps.Local.Peer)
@@ -160,6 +164,7 @@ func configure(plugin *node.Plugin) {
 func run(*node.Plugin) {
 	if err := daemon.BackgroundWorker("Tangle", func(ctx context.Context) {
 		<-ctx.Done()
+		finalityGadget.Shutdown()
 		deps.Tangle.Shutdown()
 	}, shutdown.PriorityTangle); err != nil {
 		Plugin.Panicf("Failed to start as daemon: %s", err)
@@ -207,15 +212,43 @@ func newTangle(deps tangledeps) *tangle.Tangle {
 
 	tangleInstance.Scheduler = tangle.NewScheduler(tangleInstance)
 	tangleInstance.WeightProvider = tangle.NewCManaWeightProvider(GetCMana, tangleInstance.TimeManager.Time, deps.Storage)
-	tangleInstance.OTVConsensusManager = tangle.NewOTVConsensusManager(otv.NewOnTangleVoting(tangleInstance.LedgerState.BranchDAG, tangleInstance.ApprovalWeightManager.WeightOfBranch))
+	mechanism := consensusMechanism(tangleInstance)
+	tangleInstance.OTVConsensusManager = tangle.NewOTVConsensusManager(mechanism)
 
-	finalityGadget = finality.NewSimpleFinalityGadget(tangleInstance)
+	finalityGadget = newFinalityGadget(tangleInstance)
 	tangleInstance.ConfirmationOracle = finalityGadget
+	if weightThreshold, ok := mechanism.(*hysteresis.WeightThreshold); ok {
+		tangleInstance.ApprovalWeightManager.Events.BranchWeightChanged.Attach(events.NewClosure(func(event *tangle.BranchWeightChangedEvent) {
+			weightThreshold.UpdateIncumbents(event.BranchID)
+		}))
+		finalityGadget.Events().BranchConfirmed.Attach(events.NewClosure(weightThreshold.PruneConflicts))
+	}
 
 	tangleInstance.Setup()
 	return tangleInstance
 }
 
+// consensusMechanism returns the consensus.Mechanism selected in the config.
+func consensusMechanism(tangleInstance *tangle.Tangle) consensus.Mechanism {
+	branchDAG := tangleInstance.LedgerState.BranchDAG
+	switch ConsensusParameters.Mechanism {
+	case "", "otv":
+		return otv.NewOnTangleVoting(branchDAG, tangleInstance.ApprovalWeightManager.WeightOfBranch)
+	case "firstSeen":
+		return firstseen.NewFirstSeenWins(branchDAG, func(branchID ledgerstate.BranchID) (seenTime time.Time) {
+			tangleInstance.LedgerState.TransactionMetadata(ledgerstate.TransactionID(branchID)).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+				seenTime = transactionMetadata.SolidificationTime()
+			})
+			return seenTime
+		})
+	case "hysteresis":
+		return hysteresis.NewWeightThreshold(branchDAG, tangleInstance.ApprovalWeightManager.WeightOfBranch, ConsensusParameters.HysteresisDelta)
+	default:
+		Plugin.Panicf("unknown consensus mechanism: %s", ConsensusParameters.Mechanism)
+		return nil
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region Scheduler ///////////////////////////////////////////////////////////////////////////////////////////
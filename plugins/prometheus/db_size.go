@@ -1,9 +1,6 @@
 package prometheus
 
 import (
-	"os"
-	"path/filepath"
-
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/iotaledger/goshimmer/plugins/database"
@@ -25,22 +22,8 @@ func registerDBMetrics() {
 }
 
 func collectDBSize() {
-	size, err := directorySize(database.Parameters.Directory)
+	size, err := database.Size()
 	if err == nil {
 		dbSize.Set(float64(size))
 	}
 }
-
-func directorySize(path string) (int64, error) {
-	var size int64
-	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if !info.IsDir() {
-			size += info.Size()
-		}
-		return err
-	})
-	return size, err
-}
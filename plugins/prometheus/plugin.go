@@ -18,6 +18,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/net"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tracing"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
 )
 
@@ -42,6 +43,7 @@ type dependencies struct {
 	Local                 *peer.Local
 	GossipMgr             *gossip.Manager `optional:"true"`
 	AutoPeeringConnMetric *net.ConnMetric `optional:"true"`
+	Tracer                *tracing.Tracer `optional:"true"`
 }
 
 func configure(plugin *node.Plugin) {
@@ -70,6 +72,10 @@ func configure(plugin *node.Plugin) {
 		registerManaMetrics()
 	}
 
+	if deps.Tracer != nil {
+		registerTracingMetrics()
+	}
+
 	if metrics.Parameters.Global {
 		registerClientsMetrics()
 	}
@@ -0,0 +1,41 @@
+package prometheus
+
+import (
+	"github.com/iotaledger/hive.go/events"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/goshimmer/packages/tracing"
+)
+
+var (
+	messageStageLatency *prometheus.HistogramVec
+	messageTotalLatency *prometheus.HistogramVec
+)
+
+func registerTracingMetrics() {
+	messageStageLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "tangle_message_stage_latency_seconds",
+			Help:    "latency of the traced messages between the previous and the given stage",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 18),
+		}, []string{
+			"stage",
+		})
+
+	messageTotalLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "tangle_message_total_latency_seconds",
+			Help:    "latency of the traced messages between entering the tangle and the given stage",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 18),
+		}, []string{
+			"stage",
+		})
+
+	registry.MustRegister(messageStageLatency)
+	registry.MustRegister(messageTotalLatency)
+
+	deps.Tracer.Events.StageCompleted.Attach(events.NewClosure(func(event *tracing.StageCompletedEvent) {
+		messageStageLatency.WithLabelValues(string(event.Stage)).Observe(event.Latency.Seconds())
+		messageTotalLatency.WithLabelValues(string(event.Stage)).Observe(event.Total.Seconds())
+	}))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/prometheus"
 	"github.com/iotaledger/goshimmer/plugins/remotelog"
 	"github.com/iotaledger/goshimmer/plugins/remotemetrics"
+	"github.com/iotaledger/goshimmer/plugins/tracing"
 	"github.com/iotaledger/goshimmer/plugins/txstream"
 )
 
@@ -22,6 +23,7 @@ var Research = node.Plugins(
 	analysisclient.Plugin,
 	analysisdashboard.Plugin,
 	prometheus.Plugin,
+	tracing.Plugin,
 	remotemetrics.Plugin,
 	networkdelay.App(),
 	txstream.Plugin,
@@ -9,9 +9,11 @@ import (
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/spammer"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
 )
 
 var messageSpammer *spammer.Spammer
@@ -41,6 +43,8 @@ func configure(_ *node.Plugin) {
 	log = logger.NewLogger(PluginName)
 
 	messageSpammer = spammer.New(deps.Tangle.IssuePayload, log, deps.Tangle.Options.TipManagerParams.MaxParentsCount)
+	webapi.RequireScope(apitoken.ScopeAdmin, "", "spammer")
+
 	deps.Server.GET("spammer", handleRequest)
 }
 
@@ -0,0 +1,26 @@
+package tracing
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the tracing plugin.
+type ParametersDefinition struct {
+	// SampleRate defines the fraction of messages that are traced.
+	SampleRate float64 `default:"0.01" usage:"the fraction of messages that are traced"`
+	// MaxAge defines the time after which the trace of a message that was not confirmed is dropped. It must be positive.
+	MaxAge time.Duration `default:"10m" usage:"the time after which the trace of an unconfirmed message is dropped"`
+	// ExportFile defines the file the spans are written to in the OTLP/JSON format.
+	ExportFile string `default:"" usage:"the file the spans are written to in the OTLP/JSON format (disabled if empty)"`
+	// CollectorEndpoint defines the OTLP/HTTP endpoint of the OpenTelemetry collector the spans are sent to.
+	CollectorEndpoint string `default:"" usage:"the OTLP/HTTP endpoint the spans are sent to, e.g. http://localhost:4318/v1/traces (disabled if empty)"`
+}
+
+// Parameters contains the configuration used by the tracing plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "tracing")
+}
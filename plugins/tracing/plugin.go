@@ -0,0 +1,84 @@
+// Package tracing is a plugin that traces a fraction of the messages through the components of the Tangle. The latency
+// of every stage is exposed by the prometheus plugin and the spans can be exported in the OpenTelemetry format.
+package tracing
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tracing"
+)
+
+// PluginName is the name of the tracing plugin.
+const PluginName = "Tracing"
+
+var (
+	// Plugin is the plugin instance of the tracing plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tracer *tracing.Tracer
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newTracer); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func newTracer(tangleInstance *tangle.Tangle) *tracing.Tracer {
+	options := []tracing.Option{
+		tracing.WithSampleRate(Parameters.SampleRate),
+		tracing.WithMaxAge(Parameters.MaxAge),
+	}
+
+	switch {
+	case Parameters.CollectorEndpoint != "":
+		options = append(options, tracing.WithExporter(tracing.NewOTLPHTTPExporter(Parameters.CollectorEndpoint)))
+	case Parameters.ExportFile != "":
+		exporter, err := tracing.NewFileExporter(Parameters.ExportFile)
+		if err != nil {
+			Plugin.LogFatal(err)
+		}
+		options = append(options, tracing.WithExporter(exporter))
+	}
+
+	return tracing.NewTracer(tangleInstance, options...)
+}
+
+func configure(plugin *node.Plugin) {
+	if Parameters.MaxAge <= 0 {
+		plugin.Panicf("invalid tracing.maxAge %s: must be positive", Parameters.MaxAge)
+	}
+	if Parameters.SampleRate < 0 || Parameters.SampleRate > 1 {
+		plugin.Panicf("invalid tracing.sampleRate %.2f: must be between 0 and 1", Parameters.SampleRate)
+	}
+
+	deps.Tracer.Events.Error.Attach(events.NewClosure(func(err error) {
+		plugin.LogWarnf("failed to export spans: %s", err)
+	}))
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker("Tracing", func(ctx context.Context) {
+		plugin.LogInfof("Tracing %.2f%% of the messages ...", Parameters.SampleRate*100)
+		deps.Tracer.Run(ctx.Done())
+		plugin.LogInfo("Stopping Tracing ... done")
+	}, shutdown.PriorityTracing); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
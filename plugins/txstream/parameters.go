@@ -1,6 +1,8 @@
 package txstream
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/configuration"
 )
 
@@ -8,6 +10,31 @@ import (
 type ParametersDefinition struct {
 	// BindAddress defines the bind address for the txStream server.
 	BindAddress string `default:"0.0.0.0:5000" usage:"the bind address for the txStream plugin"`
+
+	// TLS
+	TLS struct {
+		// Enabled defines whether the txStream server only accepts TLS connections.
+		Enabled bool `default:"false" usage:"whether to enable TLS for the txStream server"`
+		// CertificatePath defines the path to the PEM encoded certificate of the server.
+		CertificatePath string `usage:"path to the PEM encoded TLS certificate of the txStream server"`
+		// KeyPath defines the path to the PEM encoded private key of the server.
+		KeyPath string `usage:"path to the PEM encoded TLS private key of the txStream server"`
+		// ClientCAPath defines the path to the PEM encoded CA certificates used to verify client certificates.
+		ClientCAPath string `usage:"path to the PEM encoded CA certificates to verify client certificates (enables mutual TLS)"`
+	}
+
+	// AllowedClients defines the public keys of the clients that are allowed to connect.
+	AllowedClients []string `usage:"list of base58 encoded ed25519 public keys of the clients allowed to connect (empty disables authentication)"`
+
+	// MaxSubscriptions defines the maximum number of addresses and transactions a single client can subscribe to.
+	MaxSubscriptions int `default:"0" usage:"the maximum number of addresses and transactions a single client can subscribe to across all of its connections (0 means unlimited)"`
+
+	// MaxPostedTransactions defines the maximum number of transactions a single client (identified by its public key or
+	// its host) can post per interval.
+	MaxPostedTransactions int `default:"0" usage:"the maximum number of transactions a single client can post per interval (0 means unlimited)"`
+
+	// PostedTransactionsInterval defines the interval used to limit the posted transactions.
+	PostedTransactionsInterval time.Duration `default:"1m" usage:"the interval used to limit the number of posted transactions"`
 }
 
 // Parameters contains the configuration used by the txStream plugin.
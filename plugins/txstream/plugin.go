@@ -2,7 +2,12 @@ package txstream
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
 
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
@@ -23,6 +28,8 @@ var (
 	Plugin *node.Plugin
 	deps   = new(dependencies)
 	log    *logger.Logger
+
+	options []server.Option
 )
 
 type dependencies struct {
@@ -36,6 +43,11 @@ func init() {
 
 func configure(_ *node.Plugin) {
 	log = logger.NewLogger(pluginName)
+
+	var err error
+	if options, err = serverOptions(); err != nil {
+		log.Fatalf("Invalid TXStream configuration: %s", err)
+	}
 }
 
 func run(_ *node.Plugin) {
@@ -44,7 +56,7 @@ func run(_ *node.Plugin) {
 	bindAddress := Parameters.BindAddress
 	log.Debugf("starting TXStream Plugin on %s", bindAddress)
 	err := daemon.BackgroundWorker("TXStream worker", func(ctx context.Context) {
-		err := server.Listen(ledger, bindAddress, log, ctx.Done())
+		err := server.Listen(ledger, bindAddress, log, ctx.Done(), options...)
 		if err != nil {
 			log.Errorf("failed to start TXStream server: %w", err)
 		}
@@ -54,3 +66,62 @@ func run(_ *node.Plugin) {
 		log.Errorf("failed to start TXStream daemon: %w", err)
 	}
 }
+
+// serverOptions builds the options of the txstream server from the plugin configuration.
+func serverOptions() (options []server.Option, err error) {
+	if Parameters.TLS.Enabled {
+		tlsConfig, err := tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, server.WithTLSConfig(tlsConfig))
+	}
+
+	allowedClients := make([]ed25519.PublicKey, 0, len(Parameters.AllowedClients))
+	for _, allowedClient := range Parameters.AllowedClients {
+		if allowedClient == "" {
+			continue
+		}
+		publicKey, err := ed25519.PublicKeyFromString(allowedClient)
+		if err != nil {
+			return nil, errors.Errorf("invalid allowed client public key %s: %w", allowedClient, err)
+		}
+		allowedClients = append(allowedClients, publicKey)
+	}
+	if len(allowedClients) > 0 {
+		options = append(options, server.WithAllowedClients(allowedClients...))
+	}
+
+	return append(options,
+		server.WithMaxSubscriptions(Parameters.MaxSubscriptions),
+		server.WithMaxPostedTransactions(Parameters.MaxPostedTransactions, Parameters.PostedTransactionsInterval),
+	), nil
+}
+
+// tlsConfig loads the TLS configuration of the txstream server. If a client CA is configured, client certificates are
+// required and verified (mutual TLS).
+func tlsConfig() (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(Parameters.TLS.CertificatePath, Parameters.TLS.KeyPath)
+	if err != nil {
+		return nil, errors.Errorf("failed to load TLS certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if Parameters.TLS.ClientCAPath != "" {
+		caBytes, err := ioutil.ReadFile(Parameters.TLS.ClientCAPath)
+		if err != nil {
+			return nil, errors.Errorf("failed to read client CA file: %w", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caBytes) {
+			return nil, errors.New("failed to parse client CA certificates")
+		}
+		config.ClientCAs = clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/webapi"
 	"github.com/iotaledger/goshimmer/plugins/webapi/autopeering"
 	"github.com/iotaledger/goshimmer/plugins/webapi/data"
+	"github.com/iotaledger/goshimmer/plugins/webapi/database"
 	"github.com/iotaledger/goshimmer/plugins/webapi/drng"
+	"github.com/iotaledger/goshimmer/plugins/webapi/epochs"
 	"github.com/iotaledger/goshimmer/plugins/webapi/faucet"
 	"github.com/iotaledger/goshimmer/plugins/webapi/healthz"
 	"github.com/iotaledger/goshimmer/plugins/webapi/info"
@@ -35,4 +37,6 @@ var WebAPI = node.Plugins(
 	ledgerstate.Plugin,
 	snapshot.Plugin,
 	weightprovider.Plugin,
+	epochs.Plugin,
+	database.Plugin,
 )
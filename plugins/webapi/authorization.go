@@ -0,0 +1,133 @@
+package webapi
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/apitoken"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	// authorizationHeader is the header carrying the API token.
+	authorizationHeader = "Authorization"
+	// bearerPrefix is the prefix of the API token in the authorization header.
+	bearerPrefix = "Bearer "
+	// claimsContextKey is the key of the claims of the API token in the echo context.
+	claimsContextKey = "apiTokenClaims"
+)
+
+var (
+	// ErrMissingScope is returned if a request is not granted the scope required by the route.
+	ErrMissingScope = errors.New("missing scope")
+	// ErrRateLimitExceeded is returned if the rate limit of an API token is exceeded.
+	ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+	// the tools routes are served by several plugins, so their scope is declared once for all of them.
+	routeScopes = []routeScope{
+		{pathPrefix: "/tools", scope: apitoken.ScopeAdmin},
+	}
+	routeScopesMutex sync.RWMutex
+)
+
+// routeScope is the scope required to access a group of routes.
+type routeScope struct {
+	method     string
+	pathPrefix string
+	scope      apitoken.Scope
+}
+
+// RequireScope declares the scope required to access the routes with the given HTTP method (an empty method matches
+// all methods) whose path starts with one of the given prefixes. Routes that are not covered by any declaration
+// require the read scope for GET and HEAD requests and the issue scope otherwise. If multiple declarations match a
+// route, the one with the longest path prefix applies.
+func RequireScope(scope apitoken.Scope, method string, pathPrefixes ...string) {
+	routeScopesMutex.Lock()
+	defer routeScopesMutex.Unlock()
+
+	for _, pathPrefix := range pathPrefixes {
+		routeScopes = append(routeScopes, routeScope{
+			method:     method,
+			pathPrefix: "/" + strings.Trim(pathPrefix, "/"),
+			scope:      scope,
+		})
+	}
+}
+
+// requiredScope returns the scope required to access the route with the given method and path.
+func requiredScope(method, path string) apitoken.Scope {
+	routeScopesMutex.RLock()
+	defer routeScopesMutex.RUnlock()
+
+	var bestMatch *routeScope
+	for i, declaration := range routeScopes {
+		if declaration.method != "" && declaration.method != method {
+			continue
+		}
+		if path != declaration.pathPrefix && !strings.HasPrefix(path, declaration.pathPrefix+"/") {
+			continue
+		}
+		if bestMatch == nil || len(declaration.pathPrefix) > len(bestMatch.pathPrefix) ||
+			(len(declaration.pathPrefix) == len(bestMatch.pathPrefix) && declaration.method != "") {
+			bestMatch = &routeScopes[i]
+		}
+	}
+	if bestMatch != nil {
+		return bestMatch.scope
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return apitoken.ScopeRead
+	}
+	return apitoken.ScopeIssue
+}
+
+// TokenClaims returns the claims of the API token used for the request, or nil if no token was used.
+func TokenClaims(c echo.Context) *apitoken.Claims {
+	claims, _ := c.Get(claimsContextKey).(*apitoken.Claims)
+	return claims
+}
+
+// tokenAuthorization returns a middleware that authenticates the API token of the request and checks that it grants
+// the scope required by the route. Requests without token are granted the given public scope.
+func tokenAuthorization(secret []byte, publicScope apitoken.Scope) echo.MiddlewareFunc {
+	rateLimiter := apitoken.NewRateLimiter()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// CORS preflight requests never carry credentials
+			if c.Request().Method == http.MethodOptions {
+				return next(c)
+			}
+
+			grantedScope := publicScope
+			if header := c.Request().Header.Get(authorizationHeader); strings.HasPrefix(header, bearerPrefix) {
+				token := strings.TrimPrefix(header, bearerPrefix)
+				claims, err := apitoken.Verify(secret, token)
+				if err != nil {
+					return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(err))
+				}
+				if !rateLimiter.Allow(token, claims.RateLimit) {
+					return c.JSON(http.StatusTooManyRequests, jsonmodels.NewErrorResponse(ErrRateLimitExceeded))
+				}
+
+				c.Set(claimsContextKey, claims)
+				grantedScope |= claims.Scope
+			}
+
+			if required := requiredScope(c.Request().Method, c.Path()); !grantedScope.Allows(required) {
+				err := errors.Errorf("%w: %s", ErrMissingScope, required)
+				if TokenClaims(c) == nil {
+					return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(err))
+				}
+				return c.JSON(http.StatusForbidden, jsonmodels.NewErrorResponse(err))
+			}
+
+			return next(c)
+		}
+	}
+}
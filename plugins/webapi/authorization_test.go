@@ -0,0 +1,64 @@
+package webapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/apitoken"
+)
+
+func TestTokenAuthorization(t *testing.T) {
+	secret := []byte("secret")
+	server := echo.New()
+	server.Use(tokenAuthorization(secret, apitoken.ScopeRead))
+
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	server.GET("ledgerstate/transactions/:transactionID", ok)
+	server.POST("ledgerstate/transactions", ok)
+	server.POST("ledgerstate/addresses/unspentOutputs", ok)
+	server.GET("manualpeering/peers", ok)
+	server.GET("tools/diagnostic/tips", ok)
+
+	RequireScope(apitoken.ScopeIssue, http.MethodPost, "ledgerstate/transactions")
+	RequireScope(apitoken.ScopeRead, http.MethodPost, "ledgerstate/addresses/unspentOutputs")
+	RequireScope(apitoken.ScopePeering, "", "manualpeering")
+
+	issueToken, err := apitoken.Issue(secret, apitoken.Claims{Subject: "wallet", Scope: apitoken.ScopeIssue, RateLimit: 2})
+	require.NoError(t, err)
+	adminToken, err := apitoken.Issue(secret, apitoken.Claims{Subject: "operator", Scope: apitoken.ScopeAdmin})
+	require.NoError(t, err)
+	forgedToken, err := apitoken.Issue([]byte("other"), apitoken.Claims{Subject: "attacker", Scope: apitoken.ScopeAdmin})
+	require.NoError(t, err)
+
+	request := func(method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set(authorizationHeader, bearerPrefix+token)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// public routes
+	assert.Equal(t, http.StatusOK, request(http.MethodGet, "/ledgerstate/transactions/abc", ""))
+	assert.Equal(t, http.StatusOK, request(http.MethodPost, "/ledgerstate/addresses/unspentOutputs", ""))
+
+	// protected routes
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodPost, "/ledgerstate/transactions", ""))
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodPost, "/ledgerstate/transactions", forgedToken))
+	assert.Equal(t, http.StatusOK, request(http.MethodPost, "/ledgerstate/transactions", issueToken))
+	assert.Equal(t, http.StatusForbidden, request(http.MethodGet, "/manualpeering/peers", issueToken))
+	assert.Equal(t, http.StatusOK, request(http.MethodGet, "/manualpeering/peers", adminToken))
+	assert.Equal(t, http.StatusUnauthorized, request(http.MethodGet, "/tools/diagnostic/tips", ""))
+	assert.Equal(t, http.StatusOK, request(http.MethodGet, "/tools/diagnostic/tips", adminToken))
+
+	// rate limit of the token
+	assert.Equal(t, http.StatusTooManyRequests, request(http.MethodPost, "/ledgerstate/transactions", issueToken))
+	assert.Equal(t, http.StatusOK, request(http.MethodPost, "/ledgerstate/transactions", adminToken))
+}
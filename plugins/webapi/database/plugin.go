@@ -0,0 +1,52 @@
+package database
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/apitoken"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	databasePlugin "github.com/iotaledger/goshimmer/plugins/database"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+)
+
+var (
+	// Plugin is the plugin instance of the web API database endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server *echo.Echo
+}
+
+func init() {
+	Plugin = node.NewPlugin("WebAPIDatabaseEndpoint", deps, node.Enabled, configure)
+}
+
+func configure(_ *node.Plugin) {
+	webapi.RequireScope(apitoken.ScopeAdmin, "", "database")
+
+	deps.Server.POST("database/checkpoint", CreateCheckpoint)
+}
+
+// CreateCheckpoint is the handler for the /database/checkpoint endpoint. It creates a checkpoint of the running
+// database in the checkpoint directory of the node.
+func CreateCheckpoint(c echo.Context) (err error) {
+	directory, err := databasePlugin.CreateCheckpoint()
+	if err != nil {
+		if errors.Is(err, database.ErrCheckpointNotSupported) {
+			return c.JSON(http.StatusBadRequest, jsonmodels.DatabaseCheckpointResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, jsonmodels.DatabaseCheckpointResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.DatabaseCheckpointResponse{Directory: directory})
+}
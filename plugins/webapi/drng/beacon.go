@@ -0,0 +1,69 @@
+package drng
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/drng"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// maxBeaconsRange defines the maximum number of rounds that can be requested at once.
+const maxBeaconsRange = 1000
+
+// beaconHandler returns the beacon of the given round of the given DRNG instance.
+func beaconHandler(c echo.Context) error {
+	instanceID, err := strconv.ParseUint(c.Param("instanceID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BeaconResponse{Error: err.Error()})
+	}
+	round, err := strconv.ParseUint(c.Param("round"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BeaconResponse{Error: err.Error()})
+	}
+
+	beacon, err := deps.DrngInstance.BeaconStore().Beacon(uint32(instanceID), round)
+	if err != nil {
+		if errors.Is(err, drng.ErrBeaconNotFound) {
+			return c.JSON(http.StatusNotFound, jsonmodels.BeaconResponse{Error: err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, jsonmodels.BeaconResponse{Error: err.Error()})
+	}
+
+	jsonBeacon := jsonmodels.NewBeacon(beacon)
+	return c.JSON(http.StatusOK, jsonmodels.BeaconResponse{Beacon: &jsonBeacon})
+}
+
+// beaconsHandler returns the beacons of the given DRNG instance in the range of rounds given by the from and to query
+// parameters.
+func beaconsHandler(c echo.Context) error {
+	instanceID, err := strconv.ParseUint(c.Param("instanceID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BeaconsResponse{Error: err.Error()})
+	}
+	from, err := strconv.ParseUint(c.QueryParam("from"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BeaconsResponse{Error: err.Error()})
+	}
+	to, err := strconv.ParseUint(c.QueryParam("to"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BeaconsResponse{Error: err.Error()})
+	}
+	if to < from || to-from >= maxBeaconsRange {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BeaconsResponse{Error: "invalid range of rounds: at most " + strconv.Itoa(maxBeaconsRange) + " rounds can be requested"})
+	}
+
+	beacons, err := deps.DrngInstance.BeaconStore().Beacons(uint32(instanceID), from, to)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.BeaconsResponse{Error: err.Error()})
+	}
+
+	jsonBeacons := make([]jsonmodels.Beacon, len(beacons))
+	for i, beacon := range beacons {
+		jsonBeacons[i] = jsonmodels.NewBeacon(beacon)
+	}
+	return c.JSON(http.StatusOK, jsonmodels.BeaconsResponse{Beacons: jsonBeacons})
+}
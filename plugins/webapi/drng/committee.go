@@ -20,6 +20,7 @@ func committeeHandler(c echo.Context) error {
 			Threshold:     state.Committee().Threshold,
 			Identities:    identitiesToString(state.Committee().Identities),
 			DistributedPK: hex.EncodeToString(state.Committee().DistributedPK),
+			BeaconType:    state.BeaconType(),
 		})
 	}
 	return c.JSON(http.StatusOK, jsonmodels.CommitteeResponse{
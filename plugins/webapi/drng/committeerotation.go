@@ -0,0 +1,30 @@
+package drng
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/drng"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// committeeRotationHandler issues a message containing the given committee rotation.
+func committeeRotationHandler(c echo.Context) error {
+	var request jsonmodels.CommitteeRotationRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.CommitteeRotationResponse{Error: err.Error()})
+	}
+
+	parsedPayload, err := drng.CommitteeRotationPayloadFromMarshalUtil(marshalutil.New(request.Payload))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.CommitteeRotationResponse{Error: err.Error()})
+	}
+
+	msg, err := deps.Tangle.IssuePayload(parsedPayload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.CommitteeRotationResponse{Error: err.Error()})
+	}
+	return c.JSON(http.StatusOK, jsonmodels.CommitteeRotationResponse{ID: msg.ID().Base58()})
+}
@@ -1,12 +1,16 @@
 package drng
 
 import (
+	"net/http"
+
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	"github.com/iotaledger/goshimmer/packages/drng"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
 )
 
 // PluginName is the name of the web API DRNG endpoint plugin.
@@ -34,7 +38,16 @@ func configure(_ *node.Plugin) {
 	if deps.DrngInstance == nil {
 		return
 	}
+	webapi.RequireScope(apitoken.ScopeIssue, http.MethodPost, "drng/collectiveBeacon", "drng/committeeRotation")
+
 	deps.Server.POST("drng/collectiveBeacon", collectiveBeaconHandler)
+	deps.Server.POST("drng/committeeRotation", committeeRotationHandler)
 	deps.Server.GET("drng/info/committee", committeeHandler)
 	deps.Server.GET("drng/info/randomness", randomnessHandler)
+	deps.Server.GET("drng/info/randomness/:instanceID", randomnessHistoryHandler)
+
+	if deps.DrngInstance.BeaconStore() != nil {
+		deps.Server.GET("drng/info/beacon/:instanceID/:round", beaconHandler)
+		deps.Server.GET("drng/info/beacons/:instanceID", beaconsHandler)
+	}
 }
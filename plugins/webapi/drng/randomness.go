@@ -1,7 +1,9 @@
 package drng
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo"
 
@@ -25,3 +27,32 @@ func randomnessHandler(c echo.Context) error {
 		Randomness: randomness,
 	})
 }
+
+// randomnessHistoryHandler returns the past randomness values of the given DRNG instance.
+func randomnessHistoryHandler(c echo.Context) error {
+	instanceID, err := strconv.ParseUint(c.Param("instanceID"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.RandomnessHistoryResponse{Error: err.Error()})
+	}
+
+	state := deps.DrngInstance.LoadState(uint32(instanceID))
+	if state == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.RandomnessHistoryResponse{Error: fmt.Sprintf("unknown instanceID %d", instanceID)})
+	}
+
+	history := state.History()
+	randomness := make([]jsonmodels.Randomness, len(history))
+	for i, r := range history {
+		randomness[i] = jsonmodels.Randomness{
+			InstanceID: uint32(instanceID),
+			Round:      r.Round,
+			Randomness: r.Randomness,
+			Timestamp:  r.Timestamp,
+		}
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.RandomnessHistoryResponse{
+		InstanceID: uint32(instanceID),
+		Randomness: randomness,
+	})
+}
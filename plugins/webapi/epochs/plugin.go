@@ -0,0 +1,75 @@
+package epochs
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+var (
+	// Plugin is the plugin instance of the web API epochs endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server       *echo.Echo
+	EpochManager *epochs.Manager `optional:"true"`
+}
+
+func init() {
+	Plugin = node.NewPlugin("WebAPIEpochsEndpoint", deps, node.Enabled, configure)
+}
+
+func configure(_ *node.Plugin) {
+	deps.Server.GET("epochs/latest", GetLatestEpochCommitment)
+	deps.Server.GET("epochs/:epochID", GetEpochCommitment)
+}
+
+// GetLatestEpochCommitment is the handler for the /epochs/latest endpoint.
+func GetLatestEpochCommitment(c echo.Context) (err error) {
+	if deps.EpochManager == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("epochs plugin is disabled")))
+	}
+
+	commitment, exists := deps.EpochManager.LatestCommitment()
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("no epoch has been committed yet")))
+	}
+
+	return c.JSON(http.StatusOK, newEpochCommitment(commitment))
+}
+
+// GetEpochCommitment is the handler for the /epochs/:epochID endpoint.
+func GetEpochCommitment(c echo.Context) (err error) {
+	if deps.EpochManager == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("epochs plugin is disabled")))
+	}
+
+	epochID, err := epochs.IDFromString(c.Param("epochID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if deps.EpochManager.Commitment(epochID).Consume(func(commitment *epochs.Commitment) {
+		err = c.JSON(http.StatusOK, newEpochCommitment(commitment))
+	}) {
+		return
+	}
+
+	return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(fmt.Errorf("%s has not been committed yet", epochID)))
+}
+
+// newEpochCommitment returns the JSON model of the given Commitment.
+func newEpochCommitment(commitment *epochs.Commitment) *jsonmodels.EpochCommitment {
+	return jsonmodels.NewEpochCommitment(commitment, deps.EpochManager.StartTime(commitment.EpochID()), deps.EpochManager.EndTime(commitment.EpochID()))
+}
@@ -9,11 +9,13 @@ import (
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	faucetpkg "github.com/iotaledger/goshimmer/packages/faucet"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
 )
 
 var (
@@ -35,6 +37,8 @@ func init() {
 }
 
 func configure(_ *node.Plugin) {
+	webapi.RequireScope(apitoken.ScopeIssue, "", "faucet")
+
 	deps.Server.POST("faucet", requestFunds)
 }
 
@@ -72,6 +76,15 @@ func requestFunds(c echo.Context) error {
 	}
 
 	faucetPayload := faucetpkg.NewRequest(addr, accessManaPledgeID, consensusManaPledgeID, request.Nonce)
+	if request.Color != "" || request.Amount != 0 {
+		color := ledgerstate.ColorIOTA
+		if request.Color != "" && request.Color != "IOTA" {
+			if color, err = ledgerstate.ColorFromBase58EncodedString(request.Color); err != nil {
+				return c.JSON(http.StatusBadRequest, jsonmodels.FaucetResponse{Error: "Invalid color"})
+			}
+		}
+		faucetPayload = faucetpkg.NewColoredRequest(addr, color, request.Amount, accessManaPledgeID, consensusManaPledgeID, request.Nonce)
+	}
 
 	msg, err := deps.Tangle.MessageFactory.IssuePayload(faucetPayload)
 	if err != nil {
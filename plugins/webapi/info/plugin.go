@@ -44,6 +44,19 @@ func init() {
 
 func configure(_ *node.Plugin) {
 	deps.Server.GET("info", getInfo)
+	deps.Server.GET("info/finality", getFinalityPolicy)
+}
+
+// getFinalityPolicy returns the policies used to derive the grade of finality of branches and messages.
+func getFinalityPolicy(c echo.Context) error {
+	branchPolicy, messagePolicy := messagelayer.FinalityPolicies()
+	if branchPolicy == nil || messagePolicy == nil {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.FinalityPolicyResponse{Error: "finality gadget is not initialized"})
+	}
+	return c.JSON(http.StatusOK, jsonmodels.FinalityPolicyResponse{
+		Branch:  jsonmodels.NewFinalityPolicy(branchPolicy),
+		Message: jsonmodels.NewFinalityPolicy(messagePolicy),
+	})
 }
 
 // getInfo returns the info of the node
@@ -143,6 +156,7 @@ func getInfo(c echo.Context) error {
 		Mana:                    nodeMana,
 		ManaDelegationAddress:   delegationAddressString,
 		ManaDecay:               mana.Decay,
+		MinimumOutputDeposit:    deps.Tangle.LedgerState.UTXODAG.ProtocolParameters().MinimumOutputDepositAt(time.Now()),
 		Scheduler: jsonmodels.Scheduler{
 			Running:        deps.Tangle.Scheduler.Running(),
 			Rate:           deps.Tangle.Scheduler.Rate().String(),
@@ -15,13 +15,17 @@ import (
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
 )
 
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -84,6 +88,9 @@ func run(*node.Plugin) {
 	}
 
 	// register endpoints
+	webapi.RequireScope(apitoken.ScopeIssue, http.MethodPost, "ledgerstate/transactions")
+	webapi.RequireScope(apitoken.ScopeRead, http.MethodPost, "ledgerstate/addresses/unspentOutputs")
+
 	deps.Server.GET("ledgerstate/addresses/:address", GetAddress)
 	deps.Server.GET("ledgerstate/addresses/:address/unspentOutputs", GetAddressUnspentOutputs)
 	deps.Server.POST("ledgerstate/addresses/unspentOutputs", PostAddressUnspentOutputs)
@@ -93,8 +100,10 @@ func run(*node.Plugin) {
 	deps.Server.GET("ledgerstate/branches/:branchID/supporters", GetBranchSupporters)
 	deps.Server.GET("ledgerstate/outputs/:outputID/consumers", GetOutputConsumers)
 	deps.Server.GET("ledgerstate/outputs/:outputID/metadata", GetOutputMetadata)
+	deps.Server.GET("ledgerstate/tokens/:color", GetTokenSupply)
 	deps.Server.GET("ledgerstate/transactions/:transactionID", GetTransaction)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/metadata", GetTransactionMetadata)
+	deps.Server.GET("ledgerstate/transactions/:transactionID/inclusionProof", GetTransactionInclusionProof)
 	deps.Server.POST("ledgerstate/transactions", PostTransaction)
 }
 
@@ -228,8 +237,11 @@ func GetBranch(c echo.Context) (err error) {
 
 	if deps.Tangle.LedgerState.BranchDAG.Branch(branchID).Consume(func(branch ledgerstate.Branch) {
 		branchGoF, _ := deps.Tangle.LedgerState.UTXODAG.BranchGradeOfFinality(branch.ID())
+		likedBranchIDs, _, _ := deps.Tangle.OTVConsensusManager.Opinion(ledgerstate.NewBranchIDs(branchID))
 
-		err = c.JSON(http.StatusOK, jsonmodels.NewBranch(branch, branchGoF, deps.Tangle.ApprovalWeightManager.WeightOfBranch(branchID)))
+		jsonBranch := jsonmodels.NewBranch(branch, branchGoF, deps.Tangle.ApprovalWeightManager.WeightOfBranch(branchID))
+		jsonBranch.Liked = likedBranchIDs.Contains(branchID)
+		err = c.JSON(http.StatusOK, jsonBranch)
 	}) {
 		return
 	}
@@ -365,6 +377,43 @@ func GetOutputMetadata(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetTokenSupply ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetTokenSupply is the handler for the /ledgerstate/tokens/:color endpoint. It returns the circulating supply of a
+// token that is controlled by a FoundryOutput.
+func GetTokenSupply(c echo.Context) (err error) {
+	color, err := ledgerstate.ColorFromBase58EncodedString(c.Param("color"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	cachedOutputs := deps.Tangle.LedgerState.CachedFoundriesOfToken(color)
+	defer cachedOutputs.Release()
+
+	// the foundry of a token forms a chain, so there is only one unspent FoundryOutput unless the chain is forked
+	var foundry *ledgerstate.FoundryOutput
+	var foundryGradeOfFinality gof.GradeOfFinality
+	for _, output := range cachedOutputs.Unwrap() {
+		castedOutput, isFoundry := output.(*ledgerstate.FoundryOutput)
+		if !isFoundry {
+			continue
+		}
+		deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+			if outputMetadata.ConsumerCount() == 0 && (foundry == nil || outputMetadata.GradeOfFinality() > foundryGradeOfFinality) {
+				foundry = castedOutput
+				foundryGradeOfFinality = outputMetadata.GradeOfFinality()
+			}
+		})
+	}
+	if foundry == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load FoundryOutput of token with %s", color)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetTokenSupplyResponse(foundry))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetTransaction ///////////////////////////////////////////////////////////////////////////////////////////////
 
 // GetTransaction is the handler for the /ledgerstate/transactions/:transactionID endpoint.
@@ -407,6 +456,29 @@ func GetTransactionMetadata(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetTransactionInclusionProof ////////////////////////////////////////////////////////////////////////////////
+
+// GetTransactionInclusionProof is the handler for the ledgerstate/transactions/:transactionID/inclusionProof endpoint.
+// It returns a Proof that can be verified by light clients without access to the Tangle.
+func GetTransactionInclusionProof(c echo.Context) (err error) {
+	transactionID, err := ledgerstate.TransactionIDFromBase58(c.Param("transactionID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	proof, err := inclusionproof.NewProver(deps.Tangle).TransactionProof(transactionID)
+	if err != nil {
+		if errors.Is(err, inclusionproof.ErrNotFound) || errors.Is(err, inclusionproof.ErrNoAnchor) {
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+		}
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetInclusionProofResponse(proof))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetTransactionAttachments ////////////////////////////////////////////////////////////////////////////////////
 
 // GetTransactionAttachments is the handler for the ledgerstate/transactions/:transactionID/attachments endpoint.
@@ -495,8 +567,8 @@ func PostTransaction(c echo.Context) error {
 		}
 	}
 
-	// check transaction validity
-	if transactionErr := deps.Tangle.LedgerState.CheckTransaction(tx); transactionErr != nil {
+	// check transaction validity and that it did not expire
+	if transactionErr := deps.Tangle.LedgerState.CheckTransactionAt(tx, clock.SyncedTime()); transactionErr != nil {
 		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: transactionErr.Error()})
 	}
 
@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/inclusionproof"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
@@ -39,6 +41,7 @@ func init() {
 func configure(_ *node.Plugin) {
 	deps.Server.GET("messages/:messageID", GetMessage)
 	deps.Server.GET("messages/:messageID/metadata", GetMessageMetadata)
+	deps.Server.GET("messages/:messageID/inclusionProof", GetMessageInclusionProof)
 	deps.Server.POST("messages/payload", PostPayload)
 
 	deps.Server.GET("messages/sequences/:sequenceID/markerindexbranchidmapping", GetMarkerIndexBranchIDMapping)
@@ -150,6 +153,29 @@ func NewMessageMetadata(metadata *tangle.MessageMetadata) jsonmodels.MessageMeta
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetMessageInclusionProof ////////////////////////////////////////////////////////////////////////////////////
+
+// GetMessageInclusionProof is the handler for the /messages/:messageID/inclusionProof endpoint. It returns a Proof that
+// can be verified by light clients without access to the Tangle.
+func GetMessageInclusionProof(c echo.Context) (err error) {
+	messageID, err := messageIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	proof, err := inclusionproof.NewProver(deps.Tangle).MessageProof(messageID)
+	if err != nil {
+		if errors.Is(err, inclusionproof.ErrNotFound) || errors.Is(err, inclusionproof.ErrNoAnchor) {
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+		}
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetInclusionProofResponse(proof))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region PostPayload //////////////////////////////////////////////////////////////////////////////////////////////////
 
 // PostPayload is the handler for the /messages/payload endpoint.
@@ -18,6 +18,16 @@ type ParametersDefinition struct {
 		// Password defines the password used by the basic HTTP authentication.
 		Password string `default:"goshimmer" usage:"HTTP basic auth password"`
 	}
+
+	// TokenAuth
+	TokenAuth struct {
+		// Enabled defines whether the routes are protected by scoped API tokens.
+		Enabled bool `default:"false" usage:"whether to enable the authorization with API tokens"`
+		// Secret defines the secret used to sign and verify the API tokens.
+		Secret string `usage:"the secret used to sign and verify the API tokens"`
+		// PublicScopes defines the scopes granted to the requests without API token.
+		PublicScopes []string `default:"read" usage:"the scopes granted to requests without API token (read, issue, peering, admin)"`
+	}
 }
 
 // Parameters contains the configuration used by the webAPI plugin.
@@ -15,6 +15,7 @@ import (
 	"github.com/labstack/echo/middleware"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 )
 
@@ -57,6 +58,11 @@ func newServer() *echo.Echo {
 		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete},
 	}))
 
+	// basic-auth and API tokens are both sent in the Authorization header, so a request can only carry one of them
+	if Parameters.BasicAuth.Enabled && Parameters.TokenAuth.Enabled {
+		Plugin.Panic("basic-auth and the authorization with API tokens can not be enabled at the same time")
+	}
+
 	// if enabled, configure basic-auth
 	if Parameters.BasicAuth.Enabled {
 		server.Use(middleware.BasicAuth(func(username, password string, c echo.Context) (bool, error) {
@@ -68,6 +74,18 @@ func newServer() *echo.Echo {
 		}))
 	}
 
+	// if enabled, configure the authorization with API tokens
+	if Parameters.TokenAuth.Enabled {
+		if Parameters.TokenAuth.Secret == "" {
+			Plugin.Panic("the secret of the API tokens must be set")
+		}
+		publicScope, err := apitoken.ParseScope(Parameters.TokenAuth.PublicScopes...)
+		if err != nil {
+			Plugin.Panicf("invalid public scopes: %s", err)
+		}
+		server.Use(tokenAuthorization([]byte(Parameters.TokenAuth.Secret), publicScope))
+	}
+
 	server.HTTPErrorHandler = func(err error, c echo.Context) {
 		log.Warnf("Request failed: %s", err)
 
@@ -130,7 +148,7 @@ func worker(ctx context.Context) {
 	stopped := make(chan struct{})
 	bindAddr := Parameters.BindAddress
 	go func() {
-		log.Infof("%s started, bind-address=%s, basic-auth=%v", PluginName, bindAddr, Parameters.BasicAuth.Enabled)
+		log.Infof("%s started, bind-address=%s, basic-auth=%v, token-auth=%v", PluginName, bindAddr, Parameters.BasicAuth.Enabled, Parameters.TokenAuth.Enabled)
 		if err := deps.Server.Start(bindAddr); err != nil {
 			if !errors.Is(err, http.ErrServerClosed) {
 				log.Errorf("Error serving: %s", err)
@@ -5,10 +5,12 @@ import (
 
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/apitoken"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
 
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/node"
@@ -40,6 +42,8 @@ func init() {
 }
 
 func configure(_ *node.Plugin) {
+	webapi.RequireScope(apitoken.ScopeAdmin, "", "snapshot")
+
 	deps.Server.GET("snapshot", DumpCurrentLedger)
 }
 
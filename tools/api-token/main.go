@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/apitoken"
+)
+
+func main() {
+	secret := flag.String("secret", "", "the secret configured in webAPI.tokenAuth.secret")
+	subject := flag.String("subject", "", "the holder of the token")
+	scopes := flag.String("scopes", "read", "comma separated list of scopes (read, issue, peering, admin)")
+	validity := flag.Duration("validity", 0, "the validity of the token (0 means no expiration)")
+	rateLimit := flag.Int("rateLimit", 0, "the maximum number of requests per minute (0 means unlimited)")
+	flag.Parse()
+
+	if *secret == "" {
+		fmt.Println("the secret must be set")
+		os.Exit(1)
+	}
+
+	scope, err := apitoken.ParseScope(strings.Split(*scopes, ",")...)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	claims := apitoken.Claims{
+		Subject:   *subject,
+		Scope:     scope,
+		RateLimit: *rateLimit,
+	}
+	if *validity > 0 {
+		claims.ExpiresAt = time.Now().Add(*validity)
+	}
+
+	token, err := apitoken.Issue([]byte(*secret), claims)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	fmt.Println(token)
+}
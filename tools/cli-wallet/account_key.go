@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+)
+
+func execAccountKeyCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	command.Usage = func() {
+		printUsage(command)
+	}
+
+	helpPtr := command.Bool("help", false, "show this help screen")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	accountPublicKey, err := cliWallet.AccountPublicKey()
+	if err != nil {
+		printUsage(command, "the wallet does not use an account: please create it with \"init -account <index>\"")
+	}
+
+	fmt.Println()
+	if account := cliWallet.Account(); account != nil {
+		fmt.Printf("Account index:      %d\n", account.Index())
+	}
+	if cliWallet.WatchOnly() {
+		fmt.Println("Mode:               watch-only")
+	}
+	fmt.Println("Account public key: " + accountPublicKey.Base58())
+}
@@ -11,9 +11,11 @@ import (
 type configuration struct {
 	WebAPI               string           `json:"WebAPI,omitempty"`
 	BasicAuth            client.BasicAuth `json:"basicAuth,omitempty"`
+	APIToken             string           `json:"apiToken,omitempty"`
 	ReuseAddresses       bool             `json:"reuse_addresses"`
 	FaucetPowDifficulty  int              `json:"faucetPowDifficulty"`
 	AssetRegistryNetwork string           `json:"assetRegistryNetwork"`
+	TargetGoF            string           `json:"targetGoF,omitempty"`
 }
 
 // internal variable that holds the config
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/burntokensoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createfoundryoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/minttokensoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execCreateFoundryCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	nftIDPtr := command.String("id", "", "unique identifier of the nft (alias) that controls the foundry")
+	maximumSupplyPtr := command.Int64("max-supply", 0, "the maximum amount of tokens that can be minted by the foundry")
+	metadataPtr := command.String("metadata", "", "(optional) immutable metadata of the token, e.g. its name and symbol")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *nftIDPtr == "" {
+		printUsage(command, "id of the nft that controls the foundry should be given")
+	}
+	if *maximumSupplyPtr <= 0 {
+		printUsage(command, "max-supply has to be set and be bigger than 0")
+	}
+
+	fmt.Println("Creating foundry...")
+	_, tokenColor, err := cliWallet.CreateFoundry(
+		createfoundryoptions.Alias(*nftIDPtr),
+		createfoundryoptions.MaximumSupply(uint64(*maximumSupplyPtr)),
+		createfoundryoptions.TokenMetadata([]byte(*metadataPtr)),
+		createfoundryoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		createfoundryoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Token color: ", tokenColor.Base58())
+	fmt.Println()
+	fmt.Println("Creating foundry... [DONE]")
+}
+
+func execMintTokensCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	colorPtr := command.String("color", "", "color of the tokens to mint")
+	amountPtr := command.Int64("amount", 0, "the amount of tokens that are supposed to be minted")
+	addressPtr := command.String("dest-addr", "", "(optional) address that receives the minted tokens")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *colorPtr == "" {
+		printUsage(command, "color must be set")
+	}
+	if *amountPtr <= 0 {
+		printUsage(command, "amount has to be set and be bigger than 0")
+	}
+
+	color, err := ledgerstate.ColorFromBase58EncodedString(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	options := []minttokensoptions.MintTokensOption{
+		minttokensoptions.Color(color),
+		minttokensoptions.Amount(uint64(*amountPtr)),
+		minttokensoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		minttokensoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	}
+	if *addressPtr != "" {
+		destinationAddress, parseErr := ledgerstate.AddressFromBase58EncodedString(*addressPtr)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+		options = append(options, minttokensoptions.ToAddress(destinationAddress))
+	}
+
+	fmt.Println("Minting tokens...")
+	_, err = cliWallet.MintTokens(options...)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	fmt.Println("Minting tokens... [DONE]")
+}
+
+func execBurnTokensCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	colorPtr := command.String("color", "", "color of the tokens to burn")
+	amountPtr := command.Int64("amount", 0, "the amount of tokens that are supposed to be burned")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *colorPtr == "" {
+		printUsage(command, "color must be set")
+	}
+	if *amountPtr <= 0 {
+		printUsage(command, "amount has to be set and be bigger than 0")
+	}
+
+	color, err := ledgerstate.ColorFromBase58EncodedString(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println("Burning tokens...")
+	_, err = cliWallet.BurnTokens(
+		burntokensoptions.Color(color),
+		burntokensoptions.Amount(uint64(*amountPtr)),
+		burntokensoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		burntokensoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	fmt.Println("Burning tokens... [DONE]")
+}
+
+func execTokenInfoCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	colorPtr := command.String("color", "", "color of the token to be checked")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *colorPtr == "" {
+		printUsage(command, "color must be set")
+	}
+
+	color, err := ledgerstate.ColorFromBase58EncodedString(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	foundry, err := cliWallet.TokenFoundry(color)
+	if err != nil {
+		printUsage(command, fmt.Sprintf("failed to fetch token info: %s", err.Error()))
+	}
+
+	fmt.Println()
+	fmt.Println("Token Info")
+	fmt.Println()
+	// initialize tab writer
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 2, '\t', 0)
+	_, _ = fmt.Fprintf(w, "%s\t%s\n", "PROPERTY", "VALUE")
+	_, _ = fmt.Fprintf(w, "%s\t%s\n", "-----------------------", "--------------------------------------------")
+	_, _ = fmt.Fprintf(w, "%s\t%s\n", "Color", foundry.TokenColor().Base58())
+	_, _ = fmt.Fprintf(w, "%s\t%d\n", "Circulating Supply", foundry.CirculatingSupply())
+	_, _ = fmt.Fprintf(w, "%s\t%d\n", "Maximum Supply", foundry.MaximumSupply())
+	_, _ = fmt.Fprintf(w, "%s\t%s\n", "Metadata", string(foundry.TokenMetadata()))
+	_, _ = fmt.Fprintf(w, "%s\t%s\n", "Controlling NFT", foundry.AliasAddress().Base58())
+	_, _ = fmt.Fprintf(w, "%s\t%s\n", "Foundry", foundry.ID().Base58())
+
+	_ = w.Flush()
+}
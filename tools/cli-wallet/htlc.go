@@ -0,0 +1,245 @@
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/claimhtlcoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/createhtlcoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/refundhtlcoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execCreateHTLCCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	addressPtr := command.String("dest-addr", "", "address of the counterparty that can claim the funds by revealing the secret")
+	amountPtr := command.Int64("amount", 0, "the amount of tokens that are supposed to be locked")
+	colorPtr := command.String("color", "IOTA", "(optional) color of the tokens to lock")
+	hashPtr := command.String("hash", "", "(optional) base58 encoded hash lock of the counterparty, a new secret is generated if not set")
+	deadlinePtr := command.Duration("deadline", 24*time.Hour, "(optional) duration after which the funds can be refunded if they were not claimed")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *addressPtr == "" {
+		printUsage(command, "dest-addr has to be set")
+	}
+	if *amountPtr <= 0 {
+		printUsage(command, "amount has to be set and be bigger than 0")
+	}
+	if *deadlinePtr <= 0 {
+		printUsage(command, "deadline has to be bigger than 0")
+	}
+
+	destinationAddress, err := ledgerstate.AddressFromBase58EncodedString(*addressPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	color, err := parseColor(*colorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	// the initiator of a swap generates the secret, the counterparty locks its funds with the same hash
+	var preimage *ledgerstate.Preimage
+	var hashLock ledgerstate.HashLock
+	if *hashPtr == "" {
+		preimage = &ledgerstate.Preimage{}
+		if _, err = rand.Read(preimage[:]); err != nil {
+			printUsage(command, err.Error())
+		}
+		hashLock = preimage.HashLock()
+	} else if hashLock, err = ledgerstate.HashLockFromBase58EncodedString(*hashPtr); err != nil {
+		printUsage(command, err.Error())
+	}
+
+	deadline := time.Now().Add(*deadlinePtr)
+	fmt.Println("Creating hash time lock...")
+	_, outputID, err := cliWallet.CreateHashTimeLock(
+		createhtlcoptions.Destination(destinationAddress, uint64(*amountPtr), color),
+		createhtlcoptions.HashLock(hashLock),
+		createhtlcoptions.Deadline(deadline),
+		createhtlcoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		createhtlcoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Output ID: ", outputID.Base58())
+	fmt.Println("Hash:      ", hashLock.Base58())
+	fmt.Println("Deadline:  ", deadline.Truncate(time.Second).String())
+	if preimage != nil {
+		fmt.Println("Secret:    ", preimage.Base58())
+		fmt.Println()
+		fmt.Println("Keep the secret private until the counterparty locked its funds with the same hash.")
+	}
+	fmt.Println()
+	fmt.Println("Creating hash time lock... [DONE]")
+}
+
+func execClaimHTLCCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	outputIDPtr := command.String("id", "", "ID of the hash time locked output to claim")
+	secretPtr := command.String("secret", "", "base58 encoded secret that unlocks the hash lock")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *outputIDPtr == "" {
+		printUsage(command, "id has to be set")
+	}
+	if *secretPtr == "" {
+		printUsage(command, "secret has to be set")
+	}
+	preimage, err := ledgerstate.PreimageFromBase58EncodedString(*secretPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println("Claiming hash time lock...")
+	_, err = cliWallet.ClaimHashTimeLock(
+		claimhtlcoptions.OutputID(*outputIDPtr),
+		claimhtlcoptions.Preimage(preimage),
+		claimhtlcoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		claimhtlcoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Claiming hash time lock... [DONE]")
+}
+
+func execRefundHTLCCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	outputIDPtr := command.String("id", "", "ID of the expired hash time locked output to refund")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *outputIDPtr == "" {
+		printUsage(command, "id has to be set")
+	}
+
+	fmt.Println("Refunding hash time lock...")
+	_, err = cliWallet.RefundHashTimeLock(
+		refundhtlcoptions.OutputID(*outputIDPtr),
+		refundhtlcoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		refundhtlcoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Refunding hash time lock... [DONE]")
+}
+
+func execHTLCInfoCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	outputIDPtr := command.String("id", "", "(optional) ID of a claimed hash time locked output to look up the revealed secret for")
+	hashPtr := command.String("hash", "", "(optional) base58 encoded hash lock of the output given with -id")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *outputIDPtr != "" || *hashPtr != "" {
+		if *outputIDPtr == "" || *hashPtr == "" {
+			printUsage(command, "please provide both id and hash to look up a revealed secret")
+		}
+		outputID, parseErr := ledgerstate.OutputIDFromBase58(*outputIDPtr)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+		hashLock, parseErr := ledgerstate.HashLockFromBase58EncodedString(*hashPtr)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+		preimage, revealErr := cliWallet.RevealedPreimage(outputID, hashLock)
+		if revealErr != nil {
+			printUsage(command, revealErr.Error())
+		}
+
+		fmt.Println()
+		fmt.Println("Secret: ", preimage.Base58())
+		return
+	}
+
+	if err = cliWallet.Refresh(true); err != nil {
+		printUsage(command, err.Error())
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 0, 8, 2, '\t', 0)
+	fmt.Println()
+	fmt.Println("Hash Time Locks")
+	fmt.Println()
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "STATUS", "OUTPUT ID", "ROLE", "HASH", "DEADLINE", "BALANCES")
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "------", "--------------------------------------------", "--------", "--------------------------------------------", "-------------------------", "---------------")
+
+	now := time.Now()
+	printed := 0
+	for addr, outputs := range cliWallet.UnspentHashTimeLockedOutputs(true) {
+		for outputID, output := range outputs {
+			casted := output.Object.(*ledgerstate.HashTimeLockedOutput)
+			status := "[PEND]"
+			if output.GradeOfFinalityReached {
+				status = "[ OK ]"
+			}
+			role := "claim"
+			if addr.Address().Equals(casted.FallbackAddress()) {
+				role = "refund"
+			}
+			if casted.Expired(now) {
+				role += " (expired)"
+			}
+			casted.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d %s\n", status, outputID.Base58(), role, casted.HashLock().Base58(), casted.Deadline().String(), balance, cliWallet.AssetRegistry().Symbol(color))
+				return true
+			})
+			printed++
+		}
+	}
+	if printed == 0 {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "<EMPTY>", "<EMPTY>", "<EMPTY>", "<EMPTY>", "<EMPTY>", "<EMPTY>")
+	}
+	_ = w.Flush()
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"unsafe"
@@ -16,6 +18,7 @@ import (
 	"github.com/iotaledger/goshimmer/client"
 	"github.com/iotaledger/goshimmer/client/wallet"
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 )
 
 // Exit should be used inside panic intead of os.Exit(). This will allow to call deferred statements.
@@ -26,7 +29,7 @@ func printBanner() {
 }
 
 func loadWallet() *wallet.Wallet {
-	seed, lastAddressIndex, spentAddresses, assetRegistry, err := importWalletStateFile("wallet.dat")
+	keys, lastAddressIndex, spentAddresses, assetRegistry, err := importWalletStateFile("wallet.dat")
 	if err != nil {
 		panic(err)
 	}
@@ -36,6 +39,9 @@ func loadWallet() *wallet.Wallet {
 	if config.BasicAuth.IsEnabled() {
 		options = append(options, client.WithBasicAuth(config.BasicAuth.Credentials()))
 	}
+	if config.APIToken != "" {
+		options = append(options, client.WithAPIToken(config.APIToken))
+	}
 
 	if assetRegistry != nil {
 		// we do have an asset registry parsed
@@ -49,9 +55,22 @@ func loadWallet() *wallet.Wallet {
 		assetRegistry = wallet.NewAssetRegistry(config.AssetRegistryNetwork)
 	}
 
+	pendingTransactions, err := importPendingTransactionsFile("pending.dat")
+	if err != nil {
+		panic(err)
+	}
+
 	walletOptions := []wallet.Option{
 		wallet.WebAPI(config.WebAPI, options...),
-		wallet.Import(seed, lastAddressIndex, spentAddresses, assetRegistry),
+		keys.importOption(lastAddressIndex, spentAddresses, assetRegistry),
+		wallet.ImportPendingTransactions(pendingTransactions...),
+	}
+	if config.TargetGoF != "" {
+		targetGoF, err := gof.ParseGradeOfFinality(config.TargetGoF)
+		if err != nil {
+			panic(err)
+		}
+		walletOptions = append(walletOptions, wallet.ConfirmationTargetGoF(targetGoF))
 	}
 	if config.ReuseAddresses {
 		walletOptions = append(walletOptions, wallet.ReusableAddress(true))
@@ -62,7 +81,27 @@ func loadWallet() *wallet.Wallet {
 	return wallet.New(walletOptions...)
 }
 
-func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *wallet.AssetRegistry, err error) {
+// walletKeys contains the keys of the wallet restored from the wallet state file.
+type walletKeys struct {
+	seed             *walletseed.Seed
+	account          bool
+	accountIndex     uint32
+	accountPublicKey *walletseed.AccountPublicKey
+}
+
+// importOption returns the wallet.Option that restores a wallet with the given keys.
+func (w *walletKeys) importOption(lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *wallet.AssetRegistry) wallet.Option {
+	switch {
+	case w.accountPublicKey != nil:
+		return wallet.ImportWatchOnly(w.accountPublicKey, lastAddressIndex, spentAddresses, assetRegistry)
+	case w.account:
+		return wallet.ImportAccount(w.seed, w.accountIndex, lastAddressIndex, spentAddresses, assetRegistry)
+	default:
+		return wallet.Import(w.seed, lastAddressIndex, spentAddresses, assetRegistry)
+	}
+}
+
+func importWalletStateFile(filename string) (keys *walletKeys, lastAddressIndex uint64, spentAddresses []bitmask.BitMask, assetRegistry *wallet.AssetRegistry, err error) {
 	walletStateBytes, err := os.ReadFile(filename)
 	if err != nil {
 		if !os.IsNotExist(err) {
@@ -73,20 +112,12 @@ func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressI
 			printUsage(nil, "no wallet file (wallet.dat) found: please call \""+filepath.Base(os.Args[0])+" init\"")
 		}
 
-		seed = walletseed.NewSeed()
+		keys, err = initWalletKeys()
+		if err != nil {
+			return
+		}
 		lastAddressIndex = 0
 		spentAddresses = []bitmask.BitMask{}
-		err = nil
-
-		fmt.Println("GENERATING NEW WALLET ...                                 [DONE]")
-		fmt.Println()
-		fmt.Println("================================================================")
-		fmt.Println("!!!            PLEASE CREATE A BACKUP OF YOUR SEED           !!!")
-		fmt.Println("!!!                                                          !!!")
-		fmt.Println("!!!       " + base58.Encode(seed.Bytes()) + "       !!!")
-		fmt.Println("!!!                                                          !!!")
-		fmt.Println("!!!            PLEASE CREATE A BACKUP OF YOUR SEED           !!!")
-		fmt.Println("================================================================")
 
 		return
 	}
@@ -97,10 +128,47 @@ func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressI
 
 	marshalUtil := marshalutil.New(walletStateBytes)
 
-	seedBytes, err := marshalUtil.ReadBytes(ed25519.SeedSize)
-	seed = walletseed.NewSeed(seedBytes)
-	if err != nil {
-		return
+	keys = &walletKeys{}
+	if bytes.HasPrefix(walletStateBytes, wallet.AccountStateMarker) {
+		marshalUtil.ReadSeek(len(wallet.AccountStateMarker))
+		stateType, typeErr := marshalUtil.ReadUint8()
+		if typeErr != nil {
+			err = typeErr
+			return
+		}
+
+		switch wallet.AccountStateType(stateType) {
+		case wallet.AccountStateSeed:
+			seedBytes, seedErr := marshalUtil.ReadBytes(ed25519.SeedSize)
+			if seedErr != nil {
+				err = seedErr
+				return
+			}
+			keys.seed = walletseed.NewSeed(seedBytes)
+			keys.account = true
+			if keys.accountIndex, err = marshalUtil.ReadUint32(); err != nil {
+				return
+			}
+		case wallet.AccountStateWatchOnly:
+			accountPublicKeyBytes, keyErr := marshalUtil.ReadBytes(walletseed.AccountPublicKeyLength)
+			if keyErr != nil {
+				err = keyErr
+				return
+			}
+			if keys.accountPublicKey, err = walletseed.AccountPublicKeyFromBytes(accountPublicKeyBytes); err != nil {
+				return
+			}
+		default:
+			err = fmt.Errorf("unsupported account state type in %s: %d", filename, stateType)
+			return
+		}
+	} else {
+		seedBytes, seedErr := marshalUtil.ReadBytes(ed25519.SeedSize)
+		keys.seed = walletseed.NewSeed(seedBytes)
+		if seedErr != nil {
+			err = seedErr
+			return
+		}
 	}
 
 	lastAddressIndex, err = marshalUtil.ReadUint64()
@@ -116,6 +184,81 @@ func importWalletStateFile(filename string) (seed *walletseed.Seed, lastAddressI
 	return
 }
 
+// initWalletKeys creates the keys of a new wallet according to the flags of the init command.
+func initWalletKeys() (keys *walletKeys, err error) {
+	command := flag.NewFlagSet("init", flag.ExitOnError)
+	accountPtr := command.Int("account", -1, "derive the addresses from the account with the given index of the seed")
+	watchPtr := command.String("watch", "", "create a watch-only wallet for the given base58 encoded account public key")
+	helpPtr := command.Bool("help", false, "show this help screen")
+
+	if err = command.Parse(os.Args[2:]); err != nil {
+		return
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+	if *accountPtr >= 0 && *watchPtr != "" {
+		printUsage(command, "the -account and -watch flags can not be combined")
+	}
+	if *accountPtr > math.MaxUint32 {
+		printUsage(command, "invalid account index")
+	}
+
+	fmt.Println("GENERATING NEW WALLET ...                                 [DONE]")
+	fmt.Println()
+
+	if *watchPtr != "" {
+		keys = &walletKeys{}
+		if keys.accountPublicKey, err = walletseed.AccountPublicKeyFromBase58(*watchPtr); err != nil {
+			return nil, err
+		}
+
+		fmt.Println("Watch-only wallet created. Transactions of this wallet need to be signed by the holder of the seed.")
+
+		return keys, nil
+	}
+
+	keys = &walletKeys{seed: walletseed.NewSeed()}
+	if *accountPtr >= 0 {
+		keys.account = true
+		keys.accountIndex = uint32(*accountPtr)
+	}
+
+	fmt.Println("================================================================")
+	fmt.Println("!!!            PLEASE CREATE A BACKUP OF YOUR SEED           !!!")
+	fmt.Println("!!!                                                          !!!")
+	fmt.Println("!!!       " + base58.Encode(keys.seed.Bytes()) + "       !!!")
+	fmt.Println("!!!                                                          !!!")
+	fmt.Println("!!!            PLEASE CREATE A BACKUP OF YOUR SEED           !!!")
+	fmt.Println("================================================================")
+	if keys.account {
+		fmt.Println()
+		fmt.Printf("Account index: %d\n", keys.accountIndex)
+		fmt.Println("Account public key: " + keys.seed.Account(keys.accountIndex).PublicKey().Base58())
+	}
+
+	return keys, nil
+}
+
+func importPendingTransactionsFile(filename string) (pendingTransactions []*wallet.PendingTransaction, err error) {
+	pendingTransactionsBytes, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return
+	}
+
+	return wallet.PendingTransactionsFromBytes(pendingTransactionsBytes)
+}
+
+func writePendingTransactionsFile(wallet *wallet.Wallet, filename string) {
+	err := os.WriteFile(filename, wallet.ExportPendingTransactions(), 0o644)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func writeWalletStateFile(wallet *wallet.Wallet, filename string) {
 	var skipRename bool
 	info, err := os.Stat(filename)
@@ -159,10 +302,34 @@ func printUsage(command *flag.FlagSet, optionalErrorMessage ...string) {
 		fmt.Println("        show the balances held by this wallet")
 		fmt.Println("  send-funds")
 		fmt.Println("        initiate a value transfer")
+		fmt.Println("  send-batch")
+		fmt.Println("        pay all the recipients of a CSV file, using as many transactions as needed")
 		fmt.Println("  consolidate-funds")
 		fmt.Println("        consolidate available funds under one wallet address")
 		fmt.Println("  claim-conditional")
 		fmt.Println("        claim (move) conditionally owned funds into the wallet")
+		fmt.Println("  create-htlc")
+		fmt.Println("        lock funds for a counterparty until it reveals a secret (atomic swap)")
+		fmt.Println("  claim-htlc")
+		fmt.Println("        claim hash time locked funds by revealing the secret")
+		fmt.Println("  refund-htlc")
+		fmt.Println("        take back hash time locked funds after the deadline")
+		fmt.Println("  htlc-info")
+		fmt.Println("        list the hash time locks of the wallet or look up a revealed secret")
+		fmt.Println("  create-foundry")
+		fmt.Println("        create a foundry controlled by an nft that can mint and burn a new token")
+		fmt.Println("  mint-tokens")
+		fmt.Println("        mint tokens with a foundry controlled by the wallet")
+		fmt.Println("  burn-tokens")
+		fmt.Println("        burn tokens with a foundry controlled by the wallet")
+		fmt.Println("  token-info")
+		fmt.Println("        show the circulating supply and metadata of a foundry token")
+		fmt.Println("  swap-offer")
+		fmt.Println("        offer tokens in exchange for tokens of another color")
+		fmt.Println("  swap-accept")
+		fmt.Println("        pay for and sign a swap offer of a counterparty")
+		fmt.Println("  swap-complete")
+		fmt.Println("        sign and issue a swap offer accepted by a counterparty")
 		fmt.Println("  request-funds")
 		fmt.Println("        request funds from the testnet-faucet")
 		fmt.Println("  create-asset")
@@ -190,13 +357,17 @@ func printUsage(command *flag.FlagSet, optionalErrorMessage ...string) {
 		fmt.Println("  address")
 		fmt.Println("        start the address manager of this wallet")
 		fmt.Println("  init")
-		fmt.Println("        generate a new wallet using a random seed")
+		fmt.Println("        generate a new wallet using a random seed (or a watch-only wallet of an account)")
 		fmt.Println("  server-status")
 		fmt.Println("        display the server status")
 		fmt.Println("  pledge-id")
 		fmt.Println("        query allowed mana pledge nodeIDs")
 		fmt.Println("  pending-mana")
 		fmt.Println("        display current pending mana of all outputs in the wallet grouped by address")
+		fmt.Println("  pending")
+		fmt.Println("        display the status of the transactions issued by this wallet that are not confirmed yet")
+		fmt.Println("  account-key")
+		fmt.Println("        display the account public key that allows to create a watch-only wallet")
 		fmt.Println("  help")
 		fmt.Println("        display this help screen")
 
@@ -51,6 +51,7 @@ func main() {
 	// load wallet
 	wallet := loadWallet()
 	defer writeWalletStateFile(wallet, "wallet.dat")
+	defer writePendingTransactionsFile(wallet, "pending.dat")
 
 	// check if parameters potentially include sub commands
 	if len(os.Args) < 2 {
@@ -60,8 +61,20 @@ func main() {
 	// define sub commands
 	balanceCommand := flag.NewFlagSet("balance", flag.ExitOnError)
 	sendFundsCommand := flag.NewFlagSet("send-funds", flag.ExitOnError)
+	sendBatchCommand := flag.NewFlagSet("send-batch", flag.ExitOnError)
 	consolidateFundsCommand := flag.NewFlagSet("consolidate-funds", flag.ExitOnError)
 	claimConditionalFundsCommand := flag.NewFlagSet("claim-conditional", flag.ExitOnError)
+	createHTLCCommand := flag.NewFlagSet("create-htlc", flag.ExitOnError)
+	claimHTLCCommand := flag.NewFlagSet("claim-htlc", flag.ExitOnError)
+	refundHTLCCommand := flag.NewFlagSet("refund-htlc", flag.ExitOnError)
+	htlcInfoCommand := flag.NewFlagSet("htlc-info", flag.ExitOnError)
+	createFoundryCommand := flag.NewFlagSet("create-foundry", flag.ExitOnError)
+	mintTokensCommand := flag.NewFlagSet("mint-tokens", flag.ExitOnError)
+	burnTokensCommand := flag.NewFlagSet("burn-tokens", flag.ExitOnError)
+	tokenInfoCommand := flag.NewFlagSet("token-info", flag.ExitOnError)
+	swapOfferCommand := flag.NewFlagSet("swap-offer", flag.ExitOnError)
+	swapAcceptCommand := flag.NewFlagSet("swap-accept", flag.ExitOnError)
+	swapCompleteCommand := flag.NewFlagSet("swap-complete", flag.ExitOnError)
 	createAssetCommand := flag.NewFlagSet("create-asset", flag.ExitOnError)
 	assetInfoCommand := flag.NewFlagSet("asset-info", flag.ExitOnError)
 	delegateFundsCommand := flag.NewFlagSet("delegate-funds", flag.ExitOnError)
@@ -78,6 +91,8 @@ func main() {
 	serverStatusCommand := flag.NewFlagSet("server-status", flag.ExitOnError)
 	allowedPledgeIDCommand := flag.NewFlagSet("pledge-id", flag.ExitOnError)
 	pendingManaCommand := flag.NewFlagSet("pending-mana", flag.ExitOnError)
+	pendingCommand := flag.NewFlagSet("pending", flag.ExitOnError)
+	accountKeyCommand := flag.NewFlagSet("account-key", flag.ExitOnError)
 
 	// switch logic according to provided sub command
 	switch os.Args[1] {
@@ -87,10 +102,34 @@ func main() {
 		execAddressCommand(addressCommand, wallet)
 	case "send-funds":
 		execSendFundsCommand(sendFundsCommand, wallet)
+	case "send-batch":
+		execSendBatchCommand(sendBatchCommand, wallet)
 	case "consolidate-funds":
 		execConsolidateFundsCommand(consolidateFundsCommand, wallet)
 	case "claim-conditional":
 		execClaimConditionalCommand(claimConditionalFundsCommand, wallet)
+	case "create-htlc":
+		execCreateHTLCCommand(createHTLCCommand, wallet)
+	case "claim-htlc":
+		execClaimHTLCCommand(claimHTLCCommand, wallet)
+	case "refund-htlc":
+		execRefundHTLCCommand(refundHTLCCommand, wallet)
+	case "htlc-info":
+		execHTLCInfoCommand(htlcInfoCommand, wallet)
+	case "create-foundry":
+		execCreateFoundryCommand(createFoundryCommand, wallet)
+	case "mint-tokens":
+		execMintTokensCommand(mintTokensCommand, wallet)
+	case "burn-tokens":
+		execBurnTokensCommand(burnTokensCommand, wallet)
+	case "token-info":
+		execTokenInfoCommand(tokenInfoCommand, wallet)
+	case "swap-offer":
+		execSwapOfferCommand(swapOfferCommand, wallet)
+	case "swap-accept":
+		execSwapAcceptCommand(swapAcceptCommand, wallet)
+	case "swap-complete":
+		execSwapCompleteCommand(swapCompleteCommand, wallet)
 	case "create-asset":
 		execCreateAssetCommand(createAssetCommand, wallet)
 	case "asset-info":
@@ -119,6 +158,10 @@ func main() {
 		execAllowedPledgeNodeIDsCommand(allowedPledgeIDCommand, wallet)
 	case "pending-mana":
 		execPendingMana(pendingManaCommand, wallet)
+	case "pending":
+		execPendingCommand(pendingCommand, wallet)
+	case "account-key":
+		execAccountKeyCommand(accountKeyCommand, wallet)
 	case "init":
 		fmt.Println()
 		fmt.Println("CREATING WALLET STATE FILE (wallet.dat) ...               [DONE]")
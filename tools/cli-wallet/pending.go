@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execPendingCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	command.Usage = func() {
+		printUsage(command)
+	}
+
+	helpPtr := command.Bool("help", false, "show this help screen")
+	reissuePtr := command.String("reissue", "", "ID of a disliked, rejected or expired transaction whose funds should be sent again")
+	reissueAllPtr := command.Bool("reissue-all", false, "send the funds of all disliked, rejected and expired transactions again")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	pendingTransactions, err := cliWallet.UpdatePendingTransactions()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "\nWARNING: %s\n", err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Pending Transactions")
+	fmt.Println()
+	fmt.Printf("%-10s\t%-44s\t%-44s\t%-11s\t%-9s\t%s\n", "STATE", "TRANSACTION ID", "BRANCH ID", "GOF", "CONFLICTS", "ISSUED")
+	fmt.Printf("%-10s\t%-44s\t%-44s\t%-11s\t%-9s\t%s\n", "----------", "--------------------------------------------", "--------------------------------------------", "-----------", "---------", "-------------------")
+	for _, pendingTx := range pendingTransactions {
+		fmt.Printf("%-10s\t%-44s\t%-44s\t%-11s\t%-9d\t%s\n",
+			pendingTx.State,
+			pendingTx.Transaction.ID().Base58(),
+			pendingTx.BranchID.Base58(),
+			pendingTx.GradeOfFinality,
+			len(pendingTx.Conflicts),
+			pendingTx.IssuingTime.Format("2006-01-02 15:04:05"),
+		)
+	}
+	if len(pendingTransactions) == 0 {
+		fmt.Println("<EMPTY>")
+	}
+	fmt.Println()
+
+	var txIDs []ledgerstate.TransactionID
+	switch {
+	case *reissueAllPtr:
+		for _, pendingTx := range pendingTransactions {
+			if pendingTx.RolledBack {
+				txIDs = append(txIDs, pendingTx.Transaction.ID())
+			}
+		}
+	case *reissuePtr != "":
+		txID, parseErr := ledgerstate.TransactionIDFromBase58(*reissuePtr)
+		if parseErr != nil {
+			printUsage(command, parseErr.Error())
+		}
+		txIDs = append(txIDs, txID)
+	default:
+		for _, pendingTx := range pendingTransactions {
+			if pendingTx.RolledBack {
+				fmt.Println("Some transactions were rolled back and their inputs are available again. Use -reissue <TRANSACTION ID> or -reissue-all to send their funds again.")
+				fmt.Println()
+				break
+			}
+		}
+		return
+	}
+
+	for _, txID := range txIDs {
+		fmt.Printf("Re-issuing transaction %s...\n", txID.Base58())
+		tx, reissueErr := cliWallet.ReissueTransaction(txID)
+		if reissueErr != nil {
+			printUsage(command, reissueErr.Error())
+		}
+		if tx == nil {
+			fmt.Println("\tNo funds left the wallet, nothing to re-issue.")
+			continue
+		}
+		fmt.Printf("\tRe-issued as transaction %s\n", tx.ID().Base58())
+	}
+	fmt.Println()
+	fmt.Println("Re-issuing transactions... [DONE]")
+}
@@ -6,19 +6,38 @@ import (
 	"os"
 
 	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
 
 func execRequestFundsCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	command.Usage = func() {
+		printUsage(command)
+	}
+
+	helpPtr := command.Bool("help", false, "show this help screen")
+	colorPtr := command.String("color", "IOTA", "(optional) color of the tokens to request")
+	amountPtr := command.Uint64("amount", 0, "(optional) amount of tokens to request, defaults to the amount the faucet dispenses per request")
+
 	err := command.Parse(os.Args[2:])
 	if err != nil {
-		printUsage(nil, err.Error())
+		printUsage(command, err.Error())
+	}
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	color := ledgerstate.ColorIOTA
+	if *colorPtr != "IOTA" {
+		if color, err = ledgerstate.ColorFromBase58EncodedString(*colorPtr); err != nil {
+			printUsage(command, err.Error())
+		}
 	}
 
 	fmt.Println()
 	fmt.Println("Requesting funds from faucet ... [PERFORMING POW]          (this can take a while)")
 
 	// request funds
-	err = cliWallet.RequestFaucetFunds()
+	err = cliWallet.RequestColoredFaucetFunds(color, *amountPtr)
 	if err != nil {
 		panic(err)
 	}
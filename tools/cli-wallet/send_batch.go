@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/sendbatchoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execSendBatchCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	csvPtr := command.String("csv", "", "CSV file with one payment per line: address,amount[,color]")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *csvPtr == "" {
+		printUsage(command, "csv has to be set")
+	}
+
+	file, err := os.Open(*csvPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	defer file.Close()
+
+	payments, err := readPaymentsCSV(file)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	options := []sendbatchoptions.SendBatchOption{
+		sendbatchoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		sendbatchoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+		sendbatchoptions.UsePendingOutputs(false),
+		sendbatchoptions.Progress(func(issued, total int, tx *ledgerstate.Transaction) {
+			fmt.Printf("Issued transaction %d of %d: %s\n", issued, total, tx.ID().Base58())
+		}),
+	}
+	for _, payment := range payments {
+		options = append(options, sendbatchoptions.Destination(payment.Address, payment.Amount, payment.Color))
+	}
+
+	fmt.Printf("Sending %d payments...\n", len(payments))
+	txs, err := cliWallet.SendBatch(options...)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "\nERROR: %s\n", err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Issued transactions:")
+	for _, tx := range txs {
+		fmt.Println("  " + tx.ID().Base58())
+	}
+	if err != nil {
+		panic(Exit{1})
+	}
+
+	fmt.Println()
+	fmt.Println("Sending batch ... [DONE]")
+}
+
+// readPaymentsCSV parses the payments of a batch. Empty lines, lines starting with # and a header line are skipped.
+func readPaymentsCSV(reader io.Reader) (payments []*sendbatchoptions.Payment, err error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, errors.Errorf("failed to read CSV file: %w", err)
+	}
+
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, errors.Errorf("line %d: expected address,amount[,color] but got %d fields", i+1, len(record))
+		}
+
+		destinationAddress, addressErr := ledgerstate.AddressFromBase58EncodedString(strings.TrimSpace(record[0]))
+		if addressErr != nil {
+			return nil, errors.Errorf("line %d: invalid address: %w", i+1, addressErr)
+		}
+		amount, amountErr := strconv.ParseUint(strings.TrimSpace(record[1]), 10, 64)
+		if amountErr != nil || amount == 0 {
+			return nil, errors.Errorf("line %d: amount has to be a number bigger than 0", i+1)
+		}
+		color := ledgerstate.ColorIOTA
+		if len(record) == 3 {
+			if color, err = parseColor(strings.TrimSpace(record[2])); err != nil {
+				return nil, errors.Errorf("line %d: %w", i+1, err)
+			}
+		}
+
+		payments = append(payments, &sendbatchoptions.Payment{
+			Address: address.Address{AddressBytes: destinationAddress.Array()},
+			Amount:  amount,
+			Color:   color,
+		})
+	}
+
+	if len(payments) == 0 {
+		return nil, errors.New("the CSV file does not contain any payments")
+	}
+
+	return payments, nil
+}
+
+// parseColor parses the color of a payment (IOTA, NEW or a base58 encoded color).
+func parseColor(colorString string) (color ledgerstate.Color, err error) {
+	switch colorString {
+	case "", "IOTA":
+		return ledgerstate.ColorIOTA, nil
+	case "NEW":
+		return ledgerstate.ColorMint, nil
+	default:
+		colorBytes, parseErr := base58.Decode(colorString)
+		if parseErr != nil {
+			return color, errors.Errorf("invalid color %s: %w", colorString, parseErr)
+		}
+		if color, _, err = ledgerstate.ColorFromBytes(colorBytes); err != nil {
+			return color, errors.Errorf("invalid color %s: %w", colorString, err)
+		}
+		return color, nil
+	}
+}
@@ -22,6 +22,7 @@ func execSendFundsCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	timelockPtr := command.Int64("lock-until", 0, "(optional) unix timestamp until which time the sent funds are locked from spending")
 	fallbackAddressPtr := command.String("fallb-addr", "", "(optional) fallback address that can claim back the (unspent) sent funds after fallback deadline")
 	fallbackDeadlinePtr := command.Int64("fallb-deadline", 0, "(optional) unix timestamp after which only the fallback address can claim the funds back")
+	validForPtr := command.Duration("valid-for", 0, "(optional) duration after which the transaction can no longer be booked, e.g. 10m")
 	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
 	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
 
@@ -99,6 +100,12 @@ func execSendFundsCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 		}
 		options = append(options, sendoptions.Fallback(fAddy, fDeadline))
 	}
+	if *validForPtr < 0 {
+		printUsage(command, "valid-for has to be bigger than 0")
+	}
+	if *validForPtr > 0 {
+		options = append(options, sendoptions.ValidUntil(nowis.Add(*validForPtr)))
+	}
 	// set pending outputs explicitly to false (even though it should be false by default)
 	options = append(options, sendoptions.UsePendingOutputs(false))
 	fmt.Println("Sending funds...")
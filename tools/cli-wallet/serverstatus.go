@@ -25,4 +25,5 @@ func execServerStatusCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	fmt.Println("Server Synced: ", status.Synced)
 	fmt.Println("Server Version: ", status.Version)
 	fmt.Println("Delegation Address: ", status.DelegationAddress)
+	fmt.Println("Minimum Output Deposit: ", status.MinimumOutputDeposit)
 }
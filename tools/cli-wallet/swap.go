@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/partialtx"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execSwapOfferCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	giveAmountPtr := command.Int64("give-amount", 0, "the amount of tokens that are offered")
+	giveColorPtr := command.String("give-color", "IOTA", "(optional) color of the offered tokens")
+	wantAmountPtr := command.Int64("want-amount", 0, "the amount of tokens that are asked for in return")
+	wantColorPtr := command.String("want-color", "IOTA", "(optional) color of the tokens that are asked for in return")
+	filePtr := command.String("file", "swap.offer", "(optional) file to write the offer to")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *giveAmountPtr <= 0 {
+		printUsage(command, "give-amount has to be set and be bigger than 0")
+	}
+	if *wantAmountPtr <= 0 {
+		printUsage(command, "want-amount has to be set and be bigger than 0")
+	}
+	giveColor, err := parseSwapColor(*giveColorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	wantColor, err := parseSwapColor(*wantColorPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if giveColor == wantColor {
+		printUsage(command, "give-color and want-color have to be different")
+	}
+
+	fmt.Println("Creating swap offer...")
+	offer, err := cliWallet.NewPartialTransaction(*accessManaPledgeIDPtr, *consensusManaPledgeIDPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if _, err = cliWallet.FundPartialTransaction(offer, map[ledgerstate.Color]uint64{giveColor: uint64(*giveAmountPtr)}); err != nil {
+		printUsage(command, err.Error())
+	}
+	wantedOutput := ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{wantColor: uint64(*wantAmountPtr)}), cliWallet.ReceiveAddress().Address())
+	if err = offer.AddOutput(wantedOutput); err != nil {
+		printUsage(command, err.Error())
+	}
+	writePartialTransactionFile(command, offer, *filePtr)
+
+	fmt.Println()
+	fmt.Println("Offer written to " + *filePtr + ". Keep a copy and send it to the counterparty, who accepts it with")
+	fmt.Println("swap-accept. Complete the accepted offer with swap-complete within a few minutes, as the node only accepts")
+	fmt.Println("transactions shortly after their timestamp.")
+	fmt.Println()
+	fmt.Println("Creating swap offer... [DONE]")
+}
+
+func execSwapAcceptCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	filePtr := command.String("file", "swap.offer", "(optional) file containing the offer of the counterparty")
+	outPtr := command.String("out", "swap.accepted", "(optional) file to write the accepted offer to")
+	yesPtr := command.Bool("yes", false, "(optional) sign without asking for confirmation")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	offer := readPartialTransactionFile(command, *filePtr)
+	if err = cliWallet.VerifyPartialTransaction(offer); err != nil {
+		printUsage(command, err.Error())
+	}
+	offered, asked := offer.Balances()
+	if len(offered) == 0 || len(asked) == 0 {
+		printUsage(command, "the offer has to both offer and ask for tokens")
+	}
+	fmt.Println("Offered: " + formatSwapBalances(cliWallet, offered))
+	fmt.Println("Asked:   " + formatSwapBalances(cliWallet, asked))
+	fmt.Println()
+
+	fmt.Println("Accepting swap offer...")
+	inputIDs, err := cliWallet.FundPartialTransaction(offer, asked)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if err = offer.AddOutput(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(offered), cliWallet.ReceiveAddress().Address())); err != nil {
+		printUsage(command, err.Error())
+	}
+	confirmBalanceChange(command, cliWallet, offer, *yesPtr)
+	if _, err = cliWallet.SignPartialTransaction(offer, inputIDs); err != nil {
+		printUsage(command, err.Error())
+	}
+	writePartialTransactionFile(command, offer, *outPtr)
+
+	fmt.Println()
+	fmt.Println("Accepted offer written to " + *outPtr + ". Send it back to the counterparty, who completes it with")
+	fmt.Println("swap-complete.")
+	fmt.Println()
+	fmt.Println("Accepting swap offer... [DONE]")
+}
+
+func execSwapCompleteCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	helpPtr := command.Bool("help", false, "show this help screen")
+	offerPtr := command.String("offer", "swap.offer", "(optional) file containing the own offer")
+	filePtr := command.String("file", "swap.accepted", "(optional) file containing the offer accepted by the counterparty")
+	yesPtr := command.Bool("yes", false, "(optional) sign without asking for confirmation")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	offer := readPartialTransactionFile(command, *offerPtr)
+	accepted := readPartialTransactionFile(command, *filePtr)
+	if !accepted.Contains(offer) {
+		printUsage(command, "the accepted offer does not contain the own offer")
+	}
+	if err = cliWallet.VerifyPartialTransaction(accepted); err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println("Completing swap...")
+	confirmBalanceChange(command, cliWallet, accepted, *yesPtr)
+	inputIDs := make([]ledgerstate.OutputID, 0)
+	for _, input := range offer.Inputs() {
+		inputIDs = append(inputIDs, input.ID())
+	}
+	if _, err = cliWallet.SignPartialTransaction(accepted, inputIDs); err != nil {
+		printUsage(command, err.Error())
+	}
+	tx, err := cliWallet.IssuePartialTransaction(accepted)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Println("Transaction ID: ", tx.ID().Base58())
+	fmt.Println()
+	fmt.Println("Completing swap... [DONE]")
+}
+
+func parseSwapColor(colorString string) (color ledgerstate.Color, err error) {
+	if color, err = parseColor(colorString); err != nil {
+		return
+	}
+	if color == ledgerstate.ColorMint {
+		return color, errors.New("tokens can not be minted by a swap")
+	}
+
+	return
+}
+
+func formatSwapBalances(cliWallet *wallet.Wallet, balances map[ledgerstate.Color]uint64) string {
+	formattedBalances := make([]string, 0, len(balances))
+	ledgerstate.NewColoredBalances(balances).ForEach(func(color ledgerstate.Color, balance uint64) bool {
+		formattedBalances = append(formattedBalances, fmt.Sprintf("%d %s (%s)", balance, cliWallet.AssetRegistry().Symbol(color), color.String()))
+		return true
+	})
+
+	return strings.Join(formattedBalances, ", ")
+}
+
+// confirmBalanceChange shows how the balances of the wallet change if the partially signed transaction is issued and,
+// unless skipped, asks the user to confirm it before anything is signed.
+func confirmBalanceChange(command *flag.FlagSet, cliWallet *wallet.Wallet, ptx *partialtx.Transaction, skip bool) {
+	balanceChange := cliWallet.PartialTransactionBalanceChange(ptx)
+	formattedChanges := make([]string, 0, len(balanceChange))
+	for color, change := range balanceChange {
+		formattedChanges = append(formattedChanges, fmt.Sprintf("%+d %s (%s)", change, cliWallet.AssetRegistry().Symbol(color), color.String()))
+	}
+	sort.Strings(formattedChanges)
+	fmt.Println()
+	fmt.Println("Balance change of the wallet: " + strings.Join(formattedChanges, ", "))
+	fmt.Println()
+	if skip {
+		return
+	}
+
+	fmt.Print("Sign the transaction? [y/N]: ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil || !strings.EqualFold(strings.TrimSpace(answer), "y") {
+		printUsage(command, "signing aborted")
+	}
+}
+
+func readPartialTransactionFile(command *flag.FlagSet, filename string) *partialtx.Transaction {
+	fileContent, err := os.ReadFile(filename)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	transaction, err := partialtx.FromBase58EncodedString(strings.TrimSpace(string(fileContent)))
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	return transaction
+}
+
+func writePartialTransactionFile(command *flag.FlagSet, transaction *partialtx.Transaction, filename string) {
+	if err := os.WriteFile(filename, []byte(transaction.Base58()), 0o644); err != nil {
+		printUsage(command, err.Error())
+	}
+}
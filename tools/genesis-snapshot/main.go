@@ -28,6 +28,9 @@ const (
 	cfgSnapshotFileName     = "snapshot-file"
 	cfgSnapshotGenesisSeed  = "seed"
 	defaultSnapshotFileName = "./snapshot.bin"
+
+	cfgMinimumOutputDeposit         = "minimum-output-deposit"
+	cfgDustProtectionActivationTime = "dust-protection-activation-time"
 )
 
 func must(err error) {
@@ -137,6 +140,8 @@ func init() {
 	// Most recent seed when checking ../integration-tests/assets :
 	flag.String(cfgSnapshotGenesisSeed, "7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih", "the genesis seed")
 	flag.Uint(cfgPledgeTokenAmount, 100000000000000, "the amount of tokens to pledge to defined nodes (other than genesis)")
+	flag.Uint64(cfgMinimumOutputDeposit, 0, "the amount of tokens every output has to hold at least (0 disables the dust protection)")
+	flag.Int64(cfgDustProtectionActivationTime, 0, "the time (Unix in seconds) from which on the minimum output deposit applies")
 }
 
 func main() {
@@ -159,6 +164,12 @@ func main() {
 
 	pledgeToDefinedNodes(genesis, viper.GetUint64(cfgPledgeTokenAmount), transactionsMap, accessManaMap)
 	newSnapshot := &ledgerstate.Snapshot{AccessManaByNode: accessManaMap, Transactions: transactionsMap}
+	if minimumOutputDeposit := viper.GetUint64(cfgMinimumOutputDeposit); minimumOutputDeposit > 0 {
+		newSnapshot.ProtocolParameters = &ledgerstate.ProtocolParameters{
+			MinimumOutputDeposit:         minimumOutputDeposit,
+			DustProtectionActivationTime: time.Unix(viper.GetInt64(cfgDustProtectionActivationTime), 0),
+		}
+	}
 	writeSnapshot(snapshotFileName, newSnapshot)
 	verifySnapshot(snapshotFileName)
 }
@@ -224,6 +235,10 @@ func verifySnapshot(snapshotFileName string) {
 		fmt.Println("===== key =", key)
 		fmt.Println(accessManaNode)
 	}
+	if readSnapshot.ProtocolParameters != nil {
+		fmt.Println("\n================= Snapshot Protocol Parameters ===============")
+		fmt.Println(readSnapshot.ProtocolParameters)
+	}
 }
 
 // pledges the amount of tokens given or genesis amount to defined nodes.
@@ -374,7 +389,7 @@ func newMockConnector(outputs ...*wallet.Output) (connector *mockConnector) {
 	return
 }
 
-func (connector *mockConnector) RequestFaucetFunds(addr address.Address, powTarget int) (err error) {
+func (connector *mockConnector) RequestFaucetFunds(addr address.Address, powTarget int, color ledgerstate.Color, amount uint64) (err error) {
 	// generate random transaction id
 	return
 }
@@ -392,6 +407,22 @@ func (connector *mockConnector) GetUnspentAliasOutput(addr *ledgerstate.AliasAdd
 	return
 }
 
+func (connector *mockConnector) GetRevealedPreimages(outputID ledgerstate.OutputID) (preimages []ledgerstate.Preimage, err error) {
+	return
+}
+
+func (connector *mockConnector) GetTokenFoundry(color ledgerstate.Color) (foundry *ledgerstate.FoundryOutput, err error) {
+	return
+}
+
+func (connector *mockConnector) GetMinimumOutputDeposit() (minimumOutputDeposit uint64, err error) {
+	return
+}
+
 func (connector *mockConnector) GetTransactionGoF(txID ledgerstate.TransactionID) (gradeOfFinality gof.GradeOfFinality, err error) {
 	return
 }
+
+func (connector *mockConnector) GetTransactionStatus(tx *ledgerstate.Transaction, confirmationTargetGoF gof.GradeOfFinality) (status *wallet.TransactionStatus, err error) {
+	return
+}
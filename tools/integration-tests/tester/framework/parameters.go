@@ -84,6 +84,7 @@ func PeerConfig() config.GoShimmer {
 	c.Faucet.Enabled = false
 	c.Faucet.Seed = base58.Encode(GenesisSeed)
 	c.Faucet.PowDifficulty = 1
+	c.Faucet.MaxPowDifficulty = 1
 	c.Faucet.SupplyOutputsCount = 4
 	c.Faucet.SplittingMultiplier = 4
 	c.Faucet.GenesisTokenAmount = 2500000000000000